@@ -31,6 +31,10 @@ func Bind(r *http.Request, dest interface{}) error {
 		fieldType := val.Type().Field(i)
 
 		tag := fieldType.Tag
+		if tag.Get("path") != "" {
+			// path parameters are bound by the handler
+			continue
+		}
 		formField := tag.Get("form")
 		if formField == "" {
 			formField = strings.ToLower(fieldType.Name)
@@ -68,6 +72,12 @@ func Bind(r *http.Request, dest interface{}) error {
 				return err
 			}
 			fieldValue.SetInt(v)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			v, err := strconv.ParseUint(formValue, 10, 64)
+			if err != nil {
+				return err
+			}
+			fieldValue.SetUint(v)
 		case reflect.Float32, reflect.Float64:
 			v, err := strconv.ParseFloat(formValue, 64)
 			if err != nil {
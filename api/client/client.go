@@ -0,0 +1,200 @@
+// Package client implements a typed client of the SSV API.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bloxapp/ssv/api"
+)
+
+// Error is returned for responses with a non-2xx status code.
+type Error struct {
+	StatusCode int    `json:"-"`
+	Status     string `json:"status"`
+	Message    string `json:"error"`
+}
+
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("%d %s", e.StatusCode, e.Status)
+	}
+	return fmt.Sprintf("%d %s: %s", e.StatusCode, e.Status, e.Message)
+}
+
+// IsNotFound returns whether the given error is a 404 response.
+func IsNotFound(err error) bool {
+	var e *Error
+	return errors.As(err, &e) && e.StatusCode == http.StatusNotFound
+}
+
+// Option defines Client configuration option.
+type Option func(*Client)
+
+// WithHTTPClient sets the http.Client that sends the requests.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// Client is a client of the SSV API.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// New creates a client of the SSV API served at the given base URL, e.g. http://localhost:16000.
+func New(baseURL string, opts ...Option) *Client {
+	c := &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: &http.Client{Timeout: 12 * time.Second},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+func (c *Client) Identity(ctx context.Context) (*Identity, error) {
+	var response Identity
+	return &response, c.do(ctx, http.MethodGet, "/v1/node/identity", nil, &response)
+}
+
+func (c *Client) Peers(ctx context.Context) ([]Peer, error) {
+	var response []Peer
+	return response, c.do(ctx, http.MethodGet, "/v1/node/peers", nil, &response)
+}
+
+func (c *Client) Topics(ctx context.Context) (*Topics, error) {
+	var response Topics
+	return &response, c.do(ctx, http.MethodGet, "/v1/node/topics", nil, &response)
+}
+
+func (c *Client) Bans(ctx context.Context) ([]*Ban, error) {
+	var response BansResponse
+	return response.Data, c.do(ctx, http.MethodGet, "/v1/node/bans", nil, &response)
+}
+
+// Validators returns a page of the validators that match the request, the request's Count is ignored.
+func (c *Client) Validators(ctx context.Context, request ValidatorsRequest) (*ValidatorsResponse, error) {
+	request.Count = false
+	var response ValidatorsResponse
+	return &response, c.do(ctx, http.MethodGet, "/v1/validators", request, &response)
+}
+
+// CountValidators returns the amount of validators that match the request, ignoring its pagination.
+func (c *Client) CountValidators(ctx context.Context, request ValidatorsRequest) (int, error) {
+	request.Count = true
+	var response Count
+	return response.Count, c.do(ctx, http.MethodGet, "/v1/validators", request, &response)
+}
+
+func (c *Client) Operators(ctx context.Context, request OperatorsRequest) ([]*Operator, error) {
+	var response OperatorsResponse
+	return response.Data, c.do(ctx, http.MethodGet, "/v1/operators", request, &response)
+}
+
+func (c *Client) Operator(ctx context.Context, id uint64) (*Operator, error) {
+	var response Operator
+	return &response, c.do(ctx, http.MethodGet, "/v1/operators/"+strconv.FormatUint(id, 10), nil, &response)
+}
+
+func (c *Client) Clusters(ctx context.Context, request ClustersRequest) ([]*Cluster, error) {
+	var response ClustersResponse
+	return response.Data, c.do(ctx, http.MethodGet, "/v1/clusters", request, &response)
+}
+
+func (c *Client) Recipients(ctx context.Context, request RecipientsRequest) ([]*Recipient, error) {
+	var response RecipientsResponse
+	return response.Data, c.do(ctx, http.MethodGet, "/v1/recipients", request, &response)
+}
+
+func (c *Client) Recipient(ctx context.Context, owner []byte) (*Recipient, error) {
+	var response Recipient
+	return &response, c.do(ctx, http.MethodGet, "/v1/recipients/"+api.Hex(owner).FormValue(), nil, &response)
+}
+
+// Effectiveness returns the on-chain effectiveness of the tracked duties of validators.
+func (c *Client) Effectiveness(ctx context.Context, request EffectivenessRequest) ([]*ValidatorEffectiveness, error) {
+	var response EffectivenessResponse
+	return response.Data, c.do(ctx, http.MethodGet, "/v1/validators/effectiveness", request, &response)
+}
+
+// OpenAPI returns the OpenAPI document of the API.
+func (c *Client) OpenAPI(ctx context.Context) (map[string]any, error) {
+	var response map[string]any
+	return response, c.do(ctx, http.MethodGet, "/v1/openapi.json", nil, &response)
+}
+
+// do sends the request, which is encoded in the query string for GET requests and as JSON otherwise,
+// and decodes the response into the given value.
+func (c *Client) do(ctx context.Context, method, path string, request any, response any) error {
+	u := c.baseURL + path
+	var body io.Reader
+	if request != nil {
+		if method == http.MethodGet {
+			values, err := api.Values(request)
+			if err != nil {
+				return errors.Wrap(err, "could not encode request")
+			}
+			if len(values) > 0 {
+				u += "?" + values.Encode()
+			}
+		} else {
+			raw, err := json.Marshal(request)
+			if err != nil {
+				return errors.Wrap(err, "could not encode request")
+			}
+			body = bytes.NewReader(raw)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return errors.Wrap(err, "could not create request")
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "could not send request to %s", redact(u))
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		apiErr := &Error{StatusCode: resp.StatusCode, Status: http.StatusText(resp.StatusCode)}
+		raw, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<16))
+		_ = json.Unmarshal(raw, apiErr)
+		return apiErr
+	}
+	if response == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return errors.Wrap(err, "could not decode response")
+	}
+	return nil
+}
+
+// redact removes the user info from the given URL.
+func redact(u string) string {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return u
+	}
+	return parsed.Redacted()
+}
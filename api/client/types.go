@@ -0,0 +1,219 @@
+package client
+
+import (
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/bloxapp/ssv/api"
+)
+
+// The request and response types of the SSV API, they're shared with the handlers
+// so that the client and the OpenAPI document can't drift from them.
+//
+// Request fields are bound from the query string (or a JSON body) by their form tags,
+// path parameters are tagged with path. The doc tag describes the field in the OpenAPI document.
+
+type Identity struct {
+	PeerID    peer.ID  `json:"peer_id"`
+	Addresses []string `json:"addresses"`
+	Subnets   string   `json:"subnets"`
+	Version   string   `json:"version"`
+}
+
+type Connection struct {
+	Address   string `json:"address"`
+	Direction string `json:"direction"`
+}
+
+type Peer struct {
+	ID            peer.ID      `json:"id"`
+	Addresses     []string     `json:"addresses"`
+	Connections   []Connection `json:"connections"`
+	Connectedness string       `json:"connectedness"`
+	Subnets       string       `json:"subnets"`
+	Version       string       `json:"version"`
+}
+
+type Topics struct {
+	AllPeers     []peer.ID    `json:"all_peers"`
+	PeersByTopic []TopicPeers `json:"peers_by_topic"`
+}
+
+type TopicPeers struct {
+	Topic string    `json:"topic"`
+	Peers []peer.ID `json:"peers"`
+}
+
+type Ban struct {
+	Target   string     `json:"target"`
+	Reason   string     `json:"reason"`
+	Created  time.Time  `json:"created"`
+	Expiry   *time.Time `json:"expiry,omitempty"`
+	Offenses int        `json:"offenses"`
+}
+
+type BansResponse struct {
+	Data []*Ban `json:"data"`
+}
+
+type AddBanRequest struct {
+	Target   string `json:"target" form:"target" doc:"Peer ID, IP address or CIDR to ban."`
+	Reason   string `json:"reason" form:"reason" doc:"Reason of the ban."`
+	Duration string `json:"duration" form:"duration" doc:"Duration of the ban (e.g. 1h30m), the ban is permanent if empty."`
+}
+
+type RemoveBanRequest struct {
+	Target string `json:"target" form:"target" doc:"Target of the ban to remove."`
+}
+
+// Validators sort orders
+const (
+	ValidatorsSortPubKey = "pubkey"
+	ValidatorsSortIndex  = "index"
+)
+
+type ValidatorsRequest struct {
+	Owners      api.HexSlice    `json:"owners" form:"owners" doc:"Comma-separated owner addresses."`
+	Operators   api.Uint64Slice `json:"operators" form:"operators" doc:"Comma-separated operator IDs, matches validators whose committee includes any of them."`
+	Clusters    api.Clusters    `json:"clusters" form:"clusters" doc:"Space-separated clusters of comma-separated operator IDs, matches validators of exactly these committees."`
+	Subclusters api.Clusters    `json:"subclusters" form:"subclusters" doc:"Like clusters, but matches committees that contain the operators consecutively."`
+	PubKeys     api.HexSlice    `json:"pubkeys" form:"pubkeys" doc:"Comma-separated validator public keys."`
+	Indices     api.Uint64Slice `json:"indices" form:"indices" doc:"Comma-separated validator indices."`
+
+	Sort   string  `json:"sort" form:"sort" doc:"Either pubkey (default) or index."`
+	Desc   bool    `json:"desc" form:"desc" doc:"Reverses the sort order."`
+	Limit  int     `json:"limit" form:"limit" doc:"Max amount of validators to return, all of them if 0."`
+	Cursor api.Hex `json:"cursor" form:"cursor" doc:"The next_cursor of the previous page."`
+	Count  bool    `json:"count" form:"count" doc:"Only returns the amount of matching validators."`
+}
+
+type Validator struct {
+	PubKey          api.Hex               `json:"public_key"`
+	Index           phase0.ValidatorIndex `json:"index"`
+	Status          string                `json:"status"`
+	ActivationEpoch phase0.Epoch          `json:"activation_epoch"`
+	Owner           api.Hex               `json:"owner"`
+	Committee       []uint64              `json:"committee"`
+	Quorum          uint64                `json:"quorum"`
+	PartialQuorum   uint64                `json:"partial_quorum"`
+	Graffiti        string                `json:"graffiti"`
+	Liquidated      bool                  `json:"liquidated"`
+}
+
+type Pagination struct {
+	Total      int     `json:"total"`
+	NextCursor api.Hex `json:"next_cursor,omitempty"`
+}
+
+type ValidatorsResponse struct {
+	Data       []*Validator `json:"data"`
+	Pagination Pagination   `json:"pagination"`
+}
+
+type Count struct {
+	Count int `json:"count"`
+}
+
+type OperatorsRequest struct {
+	IDs    api.Uint64Slice `json:"ids" form:"ids" doc:"Comma-separated operator IDs."`
+	Owners api.HexSlice    `json:"owners" form:"owners" doc:"Comma-separated owner addresses."`
+}
+
+type OperatorRequest struct {
+	ID uint64 `path:"id" doc:"Operator ID."`
+}
+
+type Operator struct {
+	ID         uint64  `json:"id"`
+	PublicKey  string  `json:"public_key"`
+	Owner      api.Hex `json:"owner"`
+	Validators int     `json:"validators"`
+}
+
+type OperatorsResponse struct {
+	Data []*Operator `json:"data"`
+}
+
+type ClustersRequest struct {
+	Owners     api.HexSlice    `json:"owners" form:"owners" doc:"Comma-separated owner addresses."`
+	Operators  api.Uint64Slice `json:"operators" form:"operators" doc:"Comma-separated operator IDs, matches clusters that include any of them."`
+	Clusters   api.Clusters    `json:"clusters" form:"clusters" doc:"Space-separated clusters of comma-separated operator IDs."`
+	Liquidated string          `json:"liquidated" form:"liquidated" doc:"Either true or false, matches all clusters if empty."`
+}
+
+type Cluster struct {
+	ID         api.Hex  `json:"id"`
+	Owner      api.Hex  `json:"owner"`
+	Operators  []uint64 `json:"operators"`
+	Validators int      `json:"validators"`
+	Liquidated bool     `json:"liquidated"`
+}
+
+type ClustersResponse struct {
+	Data []*Cluster `json:"data"`
+}
+
+type RecipientsRequest struct {
+	Owners api.HexSlice `json:"owners" form:"owners" doc:"Comma-separated owner addresses, required."`
+}
+
+type RecipientRequest struct {
+	Owner api.Hex `path:"owner" doc:"Owner address."`
+}
+
+type Recipient struct {
+	Owner        api.Hex `json:"owner"`
+	FeeRecipient api.Hex `json:"fee_recipient"`
+	Nonce        *uint16 `json:"nonce"`
+	NextNonce    uint16  `json:"next_nonce"`
+}
+
+type RecipientsResponse struct {
+	Data []*Recipient `json:"data"`
+}
+
+type EffectivenessRequest struct {
+	Indices api.Uint64Slice `json:"indices" form:"indices" doc:"Comma-separated validator indices, at least 1 and at most 1000."`
+	From    uint64          `json:"from" form:"from" doc:"First epoch to include."`
+	To      uint64          `json:"to" form:"to" doc:"Last epoch to include, no upper bound if 0."`
+}
+
+type AttestationEffectiveness struct {
+	Slot              phase0.Slot `json:"slot"`
+	Included          bool        `json:"included"`
+	InclusionSlot     phase0.Slot `json:"inclusion_slot,omitempty"`
+	InclusionDistance uint64      `json:"inclusion_distance,omitempty"`
+	SourceCorrect     bool        `json:"source_correct"`
+	TargetCorrect     bool        `json:"target_correct"`
+	HeadCorrect       bool        `json:"head_correct"`
+}
+
+type SyncCommitteeEffectiveness struct {
+	Expected     uint64 `json:"expected"`
+	Participated uint64 `json:"participated"`
+}
+
+type EpochEffectiveness struct {
+	Epoch         phase0.Epoch                `json:"epoch"`
+	Attestation   *AttestationEffectiveness   `json:"attestation,omitempty"`
+	SyncCommittee *SyncCommitteeEffectiveness `json:"sync_committee,omitempty"`
+}
+
+// ValidatorEffectiveness summarizes the effectiveness of a validator over the epochs.
+type ValidatorEffectiveness struct {
+	Index                    phase0.ValidatorIndex      `json:"index"`
+	Attestations             int                        `json:"attestations"`
+	IncludedAttestations     int                        `json:"included_attestations"`
+	CorrectSources           int                        `json:"correct_sources"`
+	CorrectTargets           int                        `json:"correct_targets"`
+	CorrectHeads             int                        `json:"correct_heads"`
+	AverageInclusionDistance float64                    `json:"average_inclusion_distance"`
+	SyncCommittee            SyncCommitteeEffectiveness `json:"sync_committee"`
+	Epochs                   []*EpochEffectiveness      `json:"epochs"`
+}
+
+type EffectivenessResponse struct {
+	Data []*ValidatorEffectiveness `json:"data"`
+}
@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/go-chi/chi/v5"
+	"github.com/pkg/errors"
+
+	"github.com/bloxapp/ssv/api"
+	"github.com/bloxapp/ssv/logging"
+	"github.com/bloxapp/ssv/storage/basedb"
+)
+
+// ValidatorsAdmin performs administrative operations on the validators of this node.
+type ValidatorsAdmin interface {
+	RefreshMetadata()
+	RestartValidator(pubKey []byte) error
+	ResyncValidator(pubKey []byte) error
+}
+
+type statusJSON struct {
+	Status string `json:"status"`
+}
+
+// Admin serves the mutating operations of the admin API.
+type Admin struct {
+	Validators ValidatorsAdmin
+	DB         basedb.GarbageCollector
+}
+
+// RefreshMetadata schedules an update of the beacon metadata of all validators.
+func (h *Admin) RefreshMetadata(w http.ResponseWriter, r *http.Request) error {
+	h.Validators.RefreshMetadata()
+	return api.Render(w, r, statusJSON{Status: "scheduled"})
+}
+
+// RestartValidator stops the validator and starts it with new runners.
+func (h *Admin) RestartValidator(w http.ResponseWriter, r *http.Request) error {
+	pubKey, err := validatorPubKeyParam(r)
+	if err != nil {
+		return err
+	}
+	if err := h.Validators.RestartValidator(pubKey); err != nil {
+		return api.Error(errors.Wrap(err, "could not restart validator"))
+	}
+	return api.Render(w, r, statusJSON{Status: "restarted"})
+}
+
+// ResyncValidator syncs the highest decided of all roles of the validator,
+// full nodes also sync the missing decided history.
+func (h *Admin) ResyncValidator(w http.ResponseWriter, r *http.Request) error {
+	pubKey, err := validatorPubKeyParam(r)
+	if err != nil {
+		return err
+	}
+	if err := h.Validators.ResyncValidator(pubKey); err != nil {
+		return api.Error(errors.Wrap(err, "could not resync validator"))
+	}
+	return api.Render(w, r, statusJSON{Status: "syncing"})
+}
+
+// LogLevel responds with the current log level.
+func (h *Admin) LogLevel(w http.ResponseWriter, r *http.Request) error {
+	var response struct {
+		Level string `json:"level"`
+	}
+	response.Level = logging.Level().String()
+	return api.Render(w, r, response)
+}
+
+// SetLogLevel changes the log level until the node is restarted.
+func (h *Admin) SetLogLevel(w http.ResponseWriter, r *http.Request) error {
+	var request struct {
+		Level string `json:"level" form:"level"`
+	}
+	if err := api.Bind(r, &request); err != nil {
+		return api.InvalidRequestError(err)
+	}
+	if err := logging.SetLevel(request.Level); err != nil {
+		return api.InvalidRequestError(errors.Wrap(err, "invalid level"))
+	}
+	return h.LogLevel(w, r)
+}
+
+// CollectGarbage runs a garbage collection cycle of the database, a full cycle may take a long time.
+func (h *Admin) CollectGarbage(w http.ResponseWriter, r *http.Request) error {
+	var request struct {
+		Full bool `json:"full" form:"full"`
+	}
+	if err := api.Bind(r, &request); err != nil {
+		return api.InvalidRequestError(err)
+	}
+
+	gc := h.DB.QuickGC
+	if request.Full {
+		gc = h.DB.FullGC
+	}
+	if err := gc(r.Context()); err != nil {
+		return api.Error(errors.Wrap(err, "could not collect garbage"))
+	}
+	return api.Render(w, r, statusJSON{Status: "collected"})
+}
+
+func validatorPubKeyParam(r *http.Request) ([]byte, error) {
+	var pubKey api.Hex
+	if err := pubKey.Bind(chi.URLParam(r, "pubkey")); err != nil || len(pubKey) != len(phase0.BLSPubKey{}) {
+		return nil, api.InvalidRequestError(errors.New("invalid validator public key"))
+	}
+	return pubKey, nil
+}
@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/pkg/errors"
+
+	"github.com/bloxapp/ssv/api"
+	"github.com/bloxapp/ssv/api/client"
+	networkpeers "github.com/bloxapp/ssv/network/peers"
+)
+
+type Bans struct {
+	BanList networkpeers.BanList
+	Network network.Network
+}
+
+func (h *Bans) List(w http.ResponseWriter, r *http.Request) error {
+	var response client.BansResponse
+	bans := h.BanList.Bans()
+	response.Data = make([]*client.Ban, len(bans))
+	for i, ban := range bans {
+		response.Data[i] = banFromBanList(ban)
+	}
+	return api.Render(w, r, response)
+}
+
+func (h *Bans) Add(w http.ResponseWriter, r *http.Request) error {
+	var request client.AddBanRequest
+	if err := api.Bind(r, &request); err != nil {
+		return api.InvalidRequestError(err)
+	}
+	if request.Target == "" {
+		return api.InvalidRequestError(errors.New("target is required"))
+	}
+	var duration time.Duration
+	if request.Duration != "" {
+		d, err := time.ParseDuration(request.Duration)
+		if err != nil {
+			return api.InvalidRequestError(errors.Wrap(err, "invalid duration"))
+		}
+		duration = d
+	}
+
+	ban, err := h.BanList.AddBan(request.Target, request.Reason, duration)
+	if err != nil {
+		return api.InvalidRequestError(err)
+	}
+
+	// disconnect the banned peers that are currently connected
+	for _, conn := range h.Network.Conns() {
+		ip, _ := manet.ToIP(conn.RemoteMultiaddr())
+		if ban.Matches(conn.RemotePeer(), ip) {
+			_ = conn.Close()
+		}
+	}
+
+	return api.Render(w, r, banFromBanList(ban))
+}
+
+func (h *Bans) Remove(w http.ResponseWriter, r *http.Request) error {
+	var request client.RemoveBanRequest
+	if err := api.Bind(r, &request); err != nil {
+		return api.InvalidRequestError(err)
+	}
+	if request.Target == "" {
+		return api.InvalidRequestError(errors.New("target is required"))
+	}
+	if err := h.BanList.RemoveBan(request.Target); err != nil {
+		return api.InvalidRequestError(err)
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func banFromBanList(ban *networkpeers.Ban) *client.Ban {
+	b := &client.Ban{
+		Target:   ban.Target,
+		Reason:   ban.Reason,
+		Created:  ban.Created,
+		Offenses: ban.Offenses,
+	}
+	if !ban.Expiry.IsZero() {
+		expiry := ban.Expiry
+		b.Expiry = &expiry
+	}
+	return b
+}
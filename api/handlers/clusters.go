@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"bytes"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/bloxapp/ssv/api"
+	"github.com/bloxapp/ssv/api/client"
+	"github.com/bloxapp/ssv/protocol/v2/types"
+	registrystorage "github.com/bloxapp/ssv/registry/storage"
+)
+
+// Clusters serves the clusters known to this node, which are derived from the shares
+// by grouping them by owner and operators.
+type Clusters struct {
+	Shares registrystorage.Shares
+}
+
+func (h *Clusters) List(w http.ResponseWriter, r *http.Request) error {
+	var request client.ClustersRequest
+	var response client.ClustersResponse
+
+	if err := api.Bind(r, &request); err != nil {
+		return api.InvalidRequestError(err)
+	}
+	var liquidated *bool
+	if request.Liquidated != "" {
+		v, err := strconv.ParseBool(request.Liquidated)
+		if err != nil {
+			return api.InvalidRequestError(errors.Wrap(err, "invalid liquidated"))
+		}
+		liquidated = &v
+	}
+
+	query := registrystorage.SharesQuery{}
+	for _, owner := range request.Owners {
+		query.Owners = append(query.Owners, common.BytesToAddress(owner))
+	}
+	for _, id := range request.Operators {
+		query.OperatorIDs = append(query.OperatorIDs, id)
+	}
+	var filters []registrystorage.SharesFilter
+	if len(request.Clusters) > 0 {
+		filters = append(filters, byClusters(request.Clusters, false))
+	}
+
+	clusters := make(map[string]*client.Cluster)
+	for _, share := range h.Shares.Query(nil, query, filters...) {
+		operatorIDs := make([]uint64, len(share.Committee))
+		for i, op := range share.Committee {
+			operatorIDs[i] = op.OperatorID
+		}
+		clusterID, err := types.ComputeClusterIDHash(share.OwnerAddress.Bytes(), operatorIDs)
+		if err != nil {
+			return api.Error(errors.Wrap(err, "could not compute cluster id"))
+		}
+
+		cluster, ok := clusters[string(clusterID)]
+		if !ok {
+			cluster = &client.Cluster{
+				ID:        clusterID,
+				Owner:     api.Hex(share.OwnerAddress.Bytes()),
+				Operators: operatorIDs,
+			}
+			clusters[string(clusterID)] = cluster
+		}
+		cluster.Validators++
+		// liquidation is tracked per share, a cluster is liquidated if any of its shares is
+		cluster.Liquidated = cluster.Liquidated || share.Liquidated
+	}
+
+	response.Data = make([]*client.Cluster, 0, len(clusters))
+	for _, cluster := range clusters {
+		if liquidated != nil && cluster.Liquidated != *liquidated {
+			continue
+		}
+		response.Data = append(response.Data, cluster)
+	}
+	sort.Slice(response.Data, func(i, j int) bool {
+		return bytes.Compare(response.Data[i].ID, response.Data[j].ID) < 0
+	})
+	return api.Render(w, r, response)
+}
@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+
+	"github.com/bloxapp/ssv/api"
+	"github.com/bloxapp/ssv/api/client"
+	"github.com/bloxapp/ssv/monitoring/effectiveness"
+)
+
+type Effectiveness struct {
+	// Storage is nil when effectiveness tracking is disabled.
+	Storage *effectiveness.Storage
+}
+
+func (h *Effectiveness) List(w http.ResponseWriter, r *http.Request) error {
+	var request client.EffectivenessRequest
+	var response client.EffectivenessResponse
+
+	if err := api.Bind(r, &request); err != nil {
+		return api.InvalidRequestError(err)
+	}
+	if request.To != 0 && request.To < request.From {
+		return api.InvalidRequestError(errors.New("to must not be before from"))
+	}
+	if len(request.Indices) == 0 {
+		return api.InvalidRequestError(errors.New("indices are required"))
+	}
+	if len(request.Indices) > effectiveness.MaxRecordsIndices {
+		return api.InvalidRequestError(errors.Errorf("at most %d indices are allowed", effectiveness.MaxRecordsIndices))
+	}
+	if h.Storage == nil {
+		return api.ErrNotFound
+	}
+
+	indices := make([]phase0.ValidatorIndex, len(request.Indices))
+	for i, index := range request.Indices {
+		indices[i] = phase0.ValidatorIndex(index)
+	}
+	records, err := h.Storage.Records(indices, phase0.Epoch(request.From), phase0.Epoch(request.To))
+	if err != nil {
+		return api.Error(errors.Wrap(err, "could not get effectiveness records"))
+	}
+
+	response.Data = []*client.ValidatorEffectiveness{}
+	byIndex := make(map[phase0.ValidatorIndex]*client.ValidatorEffectiveness)
+	for _, record := range records {
+		v, ok := byIndex[record.ValidatorIndex]
+		if !ok {
+			v = &client.ValidatorEffectiveness{Index: record.ValidatorIndex}
+			byIndex[record.ValidatorIndex] = v
+			response.Data = append(response.Data, v)
+		}
+		addRecord(v, record)
+	}
+	for _, v := range response.Data {
+		if v.IncludedAttestations > 0 {
+			v.AverageInclusionDistance /= float64(v.IncludedAttestations)
+		}
+	}
+	return api.Render(w, r, response)
+}
+
+// addRecord adds the record to the validator's epochs and totals,
+// leaving AverageInclusionDistance as the sum of the inclusion distances.
+func addRecord(v *client.ValidatorEffectiveness, record *effectiveness.Record) {
+	epoch := &client.EpochEffectiveness{Epoch: record.Epoch}
+	if a := record.Attestation; a != nil {
+		epoch.Attestation = &client.AttestationEffectiveness{
+			Slot:              a.Slot,
+			Included:          a.Included,
+			InclusionSlot:     a.InclusionSlot,
+			InclusionDistance: a.InclusionDistance,
+			SourceCorrect:     a.SourceCorrect,
+			TargetCorrect:     a.TargetCorrect,
+			HeadCorrect:       a.HeadCorrect,
+		}
+		v.Attestations++
+		if a.Included {
+			v.IncludedAttestations++
+			v.AverageInclusionDistance += float64(a.InclusionDistance)
+		}
+		if a.SourceCorrect {
+			v.CorrectSources++
+		}
+		if a.TargetCorrect {
+			v.CorrectTargets++
+		}
+		if a.HeadCorrect {
+			v.CorrectHeads++
+		}
+	}
+	if s := record.SyncCommittee; s != nil {
+		epoch.SyncCommittee = &client.SyncCommitteeEffectiveness{
+			Expected:     s.Expected,
+			Participated: s.Participated,
+		}
+		v.SyncCommittee.Expected += s.Expected
+		v.SyncCommittee.Participated += s.Participated
+	}
+	v.Epochs = append(v.Epochs, epoch)
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/bloxapp/ssv/api"
+	"github.com/bloxapp/ssv/api/client"
 	networkpeers "github.com/bloxapp/ssv/network/peers"
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -13,37 +14,6 @@ type TopicIndex interface {
 	PeersByTopic() ([]peer.ID, map[string][]peer.ID)
 }
 
-type AllPeersAndTopicsJSON struct {
-	AllPeers     []peer.ID        `json:"all_peers"`
-	PeersByTopic []topicIndexJSON `json:"peers_by_topic"`
-}
-
-type topicIndexJSON struct {
-	TopicName string    `json:"topic"`
-	Peers     []peer.ID `json:"peers"`
-}
-
-type connectionJSON struct {
-	Address   string `json:"address"`
-	Direction string `json:"direction"`
-}
-
-type peerJSON struct {
-	ID            peer.ID          `json:"id"`
-	Addresses     []string         `json:"addresses"`
-	Connections   []connectionJSON `json:"connections"`
-	Connectedness string           `json:"connectedness"`
-	Subnets       string           `json:"subnets"`
-	Version       string           `json:"version"`
-}
-
-type identityJSON struct {
-	PeerID    peer.ID  `json:"peer_id"`
-	Addresses []string `json:"addresses"`
-	Subnets   string   `json:"subnets"`
-	Version   string   `json:"version"`
-}
-
 type Node struct {
 	PeersIndex networkpeers.Index
 	TopicIndex TopicIndex
@@ -52,7 +22,7 @@ type Node struct {
 
 func (h *Node) Identity(w http.ResponseWriter, r *http.Request) error {
 	nodeInfo := h.PeersIndex.Self()
-	resp := identityJSON{
+	resp := client.Identity{
 		PeerID:  h.Network.LocalPeer(),
 		Subnets: nodeInfo.Metadata.Subnets,
 		Version: nodeInfo.Metadata.NodeVersion,
@@ -65,9 +35,9 @@ func (h *Node) Identity(w http.ResponseWriter, r *http.Request) error {
 
 func (h *Node) Peers(w http.ResponseWriter, r *http.Request) error {
 	peers := h.Network.Peers()
-	resp := make([]peerJSON, len(peers))
+	resp := make([]client.Peer, len(peers))
 	for i, id := range peers {
-		resp[i] = peerJSON{
+		resp[i] = client.Peer{
 			ID:            id,
 			Connectedness: h.Network.Connectedness(id).String(),
 			Subnets:       h.PeersIndex.GetPeerSubnets(id).String(),
@@ -79,7 +49,7 @@ func (h *Node) Peers(w http.ResponseWriter, r *http.Request) error {
 
 		conns := h.Network.ConnsToPeer(id)
 		for _, conn := range conns {
-			resp[i].Connections = append(resp[i].Connections, connectionJSON{
+			resp[i].Connections = append(resp[i].Connections, client.Connection{
 				Address:   conn.RemoteMultiaddr().String(),
 				Direction: conn.Stat().Direction.String(),
 			})
@@ -96,10 +66,10 @@ func (h *Node) Peers(w http.ResponseWriter, r *http.Request) error {
 
 func (h *Node) Topics(w http.ResponseWriter, r *http.Request) error {
 	allpeers, peerbytpc := h.TopicIndex.PeersByTopic()
-	alland := AllPeersAndTopicsJSON{}
-	tpcs := []topicIndexJSON{}
+	alland := client.Topics{}
+	tpcs := []client.TopicPeers{}
 	for topic, peerz := range peerbytpc {
-		tpcs = append(tpcs, topicIndexJSON{TopicName: topic, Peers: peerz})
+		tpcs = append(tpcs, client.TopicPeers{Topic: topic, Peers: peerz})
 	}
 	alland.AllPeers = allpeers
 	alland.PeersByTopic = tpcs
@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"bytes"
+	"net/http"
+	"sort"
+	"strconv"
+
+	spectypes "github.com/bloxapp/ssv-spec/types"
+	"github.com/go-chi/chi/v5"
+	"github.com/pkg/errors"
+
+	"github.com/bloxapp/ssv/api"
+	"github.com/bloxapp/ssv/api/client"
+	registrystorage "github.com/bloxapp/ssv/registry/storage"
+)
+
+type Operators struct {
+	Operators registrystorage.Operators
+	Shares    registrystorage.Shares
+}
+
+func (h *Operators) List(w http.ResponseWriter, r *http.Request) error {
+	var request client.OperatorsRequest
+	var response client.OperatorsResponse
+
+	if err := api.Bind(r, &request); err != nil {
+		return api.InvalidRequestError(err)
+	}
+
+	operators, err := h.Operators.ListOperators(nil, 0, 0)
+	if err != nil {
+		return api.Error(errors.Wrap(err, "could not list operators"))
+	}
+	sort.Slice(operators, func(i, j int) bool {
+		return operators[i].ID < operators[j].ID
+	})
+
+	response.Data = make([]*client.Operator, 0, len(operators))
+	for i := range operators {
+		op := &operators[i]
+		if len(request.IDs) > 0 && !containsUint64(request.IDs, op.ID) {
+			continue
+		}
+		if len(request.Owners) > 0 && !containsHex(request.Owners, op.OwnerAddress[:]) {
+			continue
+		}
+		response.Data = append(response.Data, h.operatorFromData(op))
+	}
+	return api.Render(w, r, response)
+}
+
+func (h *Operators) Get(w http.ResponseWriter, r *http.Request) error {
+	var request client.OperatorRequest
+	var err error
+	request.ID, err = strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		return api.InvalidRequestError(errors.Wrap(err, "invalid operator id"))
+	}
+
+	op, found, err := h.Operators.GetOperatorData(nil, request.ID)
+	if err != nil {
+		return api.Error(errors.Wrap(err, "could not get operator"))
+	}
+	if !found {
+		return api.ErrNotFound
+	}
+	return api.Render(w, r, h.operatorFromData(op))
+}
+
+func (h *Operators) operatorFromData(op *registrystorage.OperatorData) *client.Operator {
+	shares := h.Shares.Query(nil, registrystorage.SharesQuery{OperatorIDs: []spectypes.OperatorID{op.ID}})
+	return &client.Operator{
+		ID:         op.ID,
+		PublicKey:  string(op.PublicKey),
+		Owner:      api.Hex(op.OwnerAddress[:]),
+		Validators: len(shares),
+	}
+}
+
+func containsUint64(values []uint64, v uint64) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
+
+func containsHex(values []api.Hex, v []byte) bool {
+	for _, value := range values {
+		if bytes.Equal(value, v) {
+			return true
+		}
+	}
+	return false
+}
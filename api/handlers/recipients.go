@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-chi/chi/v5"
+	"github.com/pkg/errors"
+
+	"github.com/bloxapp/ssv/api"
+	"github.com/bloxapp/ssv/api/client"
+	registrystorage "github.com/bloxapp/ssv/registry/storage"
+)
+
+type Recipients struct {
+	Recipients registrystorage.Recipients
+}
+
+func (h *Recipients) List(w http.ResponseWriter, r *http.Request) error {
+	var request client.RecipientsRequest
+	var response client.RecipientsResponse
+
+	if err := api.Bind(r, &request); err != nil {
+		return api.InvalidRequestError(err)
+	}
+	if len(request.Owners) == 0 {
+		return api.InvalidRequestError(errors.New("owners are required"))
+	}
+
+	response.Data = make([]*client.Recipient, 0, len(request.Owners))
+	for _, owner := range request.Owners {
+		recipient, found, err := h.recipient(owner)
+		if err != nil {
+			return api.Error(err)
+		}
+		if found {
+			response.Data = append(response.Data, recipient)
+		}
+	}
+	return api.Render(w, r, response)
+}
+
+func (h *Recipients) Get(w http.ResponseWriter, r *http.Request) error {
+	var request client.RecipientRequest
+	if err := request.Owner.Bind(chi.URLParam(r, "owner")); err != nil || len(request.Owner) != common.AddressLength {
+		return api.InvalidRequestError(errors.New("invalid owner address"))
+	}
+
+	recipient, found, err := h.recipient(request.Owner)
+	if err != nil {
+		return api.Error(err)
+	}
+	if !found {
+		return api.ErrNotFound
+	}
+	return api.Render(w, r, recipient)
+}
+
+func (h *Recipients) recipient(owner []byte) (*client.Recipient, bool, error) {
+	data, found, err := h.Recipients.GetRecipientData(nil, common.BytesToAddress(owner))
+	if err != nil {
+		return nil, false, errors.Wrap(err, "could not get recipient data")
+	}
+	if !found || data == nil {
+		return nil, false, nil
+	}
+
+	recipient := &client.Recipient{
+		Owner:        api.Hex(data.Owner.Bytes()),
+		FeeRecipient: api.Hex(data.FeeRecipient[:]),
+	}
+	if data.Nonce != nil {
+		nonce := uint16(*data.Nonce)
+		recipient.Nonce = &nonce
+		recipient.NextNonce = nonce + 1
+	}
+	return recipient, true, nil
+}
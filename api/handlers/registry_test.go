@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bloxapp/ssv/api"
+	"github.com/bloxapp/ssv/api/client"
+	"github.com/bloxapp/ssv/logging"
+	registrystorage "github.com/bloxapp/ssv/registry/storage"
+	"github.com/bloxapp/ssv/storage/basedb"
+	"github.com/bloxapp/ssv/storage/kv"
+)
+
+func TestRegistryHandlers(t *testing.T) {
+	logger := logging.TestLogger(t)
+	db, err := kv.NewInMemory(logger, basedb.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	prefix := []byte("test")
+	shares, err := registrystorage.NewSharesStorage(logger, db, prefix)
+	require.NoError(t, err)
+	operators := registrystorage.NewOperatorsStorage(logger, db, prefix)
+	recipients := registrystorage.NewRecipientsStorage(logger, db, prefix)
+
+	owner1, owner2 := common.HexToAddress("0x1"), common.HexToAddress("0x2")
+	for id := uint64(1); id <= 5; id++ {
+		_, err := operators.SaveOperatorData(nil, &registrystorage.OperatorData{ID: id, PublicKey: []byte("pk"), OwnerAddress: owner1})
+		require.NoError(t, err)
+	}
+	for i, committee := range [][]uint64{{1, 2, 3, 4}, {1, 2, 3, 4}, {2, 3, 4, 5}} {
+		share := mockShare(committee...)
+		share.ValidatorPubKey = bytes.Repeat([]byte{byte(i + 1)}, 48)
+		share.OwnerAddress = owner2
+		share.Liquidated = i == 2
+		require.NoError(t, shares.Save(nil, share))
+	}
+	require.NoError(t, recipients.BumpNonce(nil, owner2))
+
+	router := chi.NewRouter()
+	operatorsHandler := &Operators{Operators: operators, Shares: shares}
+	router.Get("/v1/operators", api.Handler(operatorsHandler.List))
+	router.Get("/v1/operators/{id}", api.Handler(operatorsHandler.Get))
+	router.Get("/v1/clusters", api.Handler((&Clusters{Shares: shares}).List))
+	recipientsHandler := &Recipients{Recipients: recipients}
+	router.Get("/v1/recipients", api.Handler(recipientsHandler.List))
+	router.Get("/v1/recipients/{owner}", api.Handler(recipientsHandler.Get))
+
+	get := func(url string, expectedCode int, resp any) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, url, nil))
+		require.Equal(t, expectedCode, w.Code, w.Body.String())
+		if resp != nil {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+		}
+	}
+
+	t.Run("operators", func(t *testing.T) {
+		var list client.OperatorsResponse
+		get("/v1/operators?ids=1,5,6", http.StatusOK, &list)
+		require.Len(t, list.Data, 2)
+		require.Equal(t, 2, list.Data[0].Validators)
+		require.Equal(t, 1, list.Data[1].Validators)
+
+		get("/v1/operators?owners="+owner2.Hex(), http.StatusOK, &list)
+		require.Len(t, list.Data, 0)
+
+		var op client.Operator
+		get("/v1/operators/3", http.StatusOK, &op)
+		require.Equal(t, uint64(3), op.ID)
+		require.Equal(t, 3, op.Validators)
+		require.Equal(t, api.Hex(owner1.Bytes()), op.Owner)
+
+		get("/v1/operators/6", http.StatusNotFound, nil)
+		get("/v1/operators/x", http.StatusBadRequest, nil)
+	})
+
+	t.Run("clusters", func(t *testing.T) {
+		var list client.ClustersResponse
+		get("/v1/clusters", http.StatusOK, &list)
+		require.Len(t, list.Data, 2)
+
+		get("/v1/clusters?operators=1", http.StatusOK, &list)
+		require.Len(t, list.Data, 1)
+		require.Equal(t, 2, list.Data[0].Validators)
+		require.False(t, list.Data[0].Liquidated)
+
+		get("/v1/clusters?liquidated=true&owners="+owner2.Hex(), http.StatusOK, &list)
+		require.Len(t, list.Data, 1)
+		require.Equal(t, []uint64{2, 3, 4, 5}, list.Data[0].Operators)
+
+		get("/v1/clusters?liquidated=maybe", http.StatusBadRequest, nil)
+	})
+
+	t.Run("recipients", func(t *testing.T) {
+		var recipient client.Recipient
+		get("/v1/recipients/"+owner2.Hex(), http.StatusOK, &recipient)
+		require.Equal(t, api.Hex(owner2.Bytes()), recipient.Owner)
+		require.Equal(t, api.Hex(owner2.Bytes()), recipient.FeeRecipient)
+		require.Equal(t, uint16(0), *recipient.Nonce)
+		require.Equal(t, uint16(1), recipient.NextNonce)
+
+		get("/v1/recipients/"+owner1.Hex(), http.StatusNotFound, nil)
+		get("/v1/recipients/0x12", http.StatusBadRequest, nil)
+
+		var list client.RecipientsResponse
+		get("/v1/recipients?owners="+owner1.Hex()+","+owner2.Hex(), http.StatusOK, &list)
+		require.Len(t, list.Data, 1)
+	})
+}
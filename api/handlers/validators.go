@@ -2,14 +2,17 @@ package handlers
 
 import (
 	"bytes"
+	"encoding/binary"
 	"net/http"
-	"strconv"
-	"strings"
+	"sort"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	spectypes "github.com/bloxapp/ssv-spec/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
 
 	"github.com/bloxapp/ssv/api"
+	"github.com/bloxapp/ssv/api/client"
 	"github.com/bloxapp/ssv/protocol/v2/types"
 	registrystorage "github.com/bloxapp/ssv/registry/storage"
 )
@@ -19,116 +22,122 @@ type Validators struct {
 }
 
 func (h *Validators) List(w http.ResponseWriter, r *http.Request) error {
-	var request struct {
-		Owners      api.HexSlice    `json:"owners" form:"owners"`
-		Operators   api.Uint64Slice `json:"operators" form:"operators"`
-		Clusters    requestClusters `json:"clusters" form:"clusters"`
-		Subclusters requestClusters `json:"subclusters" form:"subclusters"`
-		PubKeys     api.HexSlice    `json:"pubkeys" form:"pubkeys"`
-		Indices     api.Uint64Slice `json:"indices" form:"indices"`
-	}
-	var response struct {
-		Data []*validatorJSON `json:"data"`
-	}
+	var request client.ValidatorsRequest
+	var response client.ValidatorsResponse
 
 	if err := api.Bind(r, &request); err != nil {
-		return err
+		return api.InvalidRequestError(err)
+	}
+	if request.Sort == "" {
+		request.Sort = client.ValidatorsSortPubKey
+	}
+	if request.Sort != client.ValidatorsSortPubKey && request.Sort != client.ValidatorsSortIndex {
+		return api.InvalidRequestError(errors.Errorf("invalid sort %q", request.Sort))
+	}
+	if request.Limit < 0 {
+		return api.InvalidRequestError(errors.New("limit must not be negative"))
 	}
 
-	var filters []registrystorage.SharesFilter
-	if len(request.Owners) > 0 {
-		filters = append(filters, byOwners(request.Owners))
+	query := registrystorage.SharesQuery{}
+	for _, owner := range request.Owners {
+		query.Owners = append(query.Owners, common.BytesToAddress(owner))
+	}
+	for _, id := range request.Operators {
+		query.OperatorIDs = append(query.OperatorIDs, id)
 	}
-	if len(request.Operators) > 0 {
-		filters = append(filters, byOperators(request.Operators))
+	for _, pk := range request.PubKeys {
+		query.PubKeys = append(query.PubKeys, pk)
 	}
+	for _, index := range request.Indices {
+		query.Indices = append(query.Indices, phase0.ValidatorIndex(index))
+	}
+
+	var filters []registrystorage.SharesFilter
 	if len(request.Clusters) > 0 {
 		filters = append(filters, byClusters(request.Clusters, false))
 	}
 	if len(request.Subclusters) > 0 {
 		filters = append(filters, byClusters(request.Subclusters, true))
 	}
-	if len(request.PubKeys) > 0 {
-		filters = append(filters, byPubKeys(request.PubKeys))
-	}
-	if len(request.Indices) > 0 {
-		filters = append(filters, byIndices(request.Indices))
+
+	shares := h.Shares.Query(nil, query, filters...)
+	if request.Count {
+		return api.Render(w, r, client.Count{Count: len(shares)})
 	}
 
-	shares := h.Shares.List(nil, filters...)
-	response.Data = make([]*validatorJSON, len(shares))
+	keys := make([][]byte, len(shares))
 	for i, share := range shares {
-		response.Data[i] = validatorFromShare(share)
+		keys[i] = validatorSortKey(share, request.Sort)
 	}
-	return api.Render(w, r, response)
-}
+	sort.Sort(sharesByKey{shares: shares, keys: keys, desc: request.Desc})
 
-func byOwners(owners []api.Hex) registrystorage.SharesFilter {
-	return func(share *types.SSVShare) bool {
-		for _, a := range owners {
-			if bytes.Equal(a, share.OwnerAddress[:]) {
-				return true
+	// skip the validators up to (and including) the cursor
+	from := 0
+	if len(request.Cursor) > 0 {
+		from = sort.Search(len(keys), func(i int) bool {
+			cmp := bytes.Compare(keys[i], request.Cursor)
+			if request.Desc {
+				return cmp < 0
 			}
-		}
-		return false
+			return cmp > 0
+		})
+	}
+	to := len(shares)
+	if request.Limit > 0 && from+request.Limit < to {
+		to = from + request.Limit
+		response.Pagination.NextCursor = keys[to-1]
+	}
+
+	response.Pagination.Total = len(shares)
+	response.Data = make([]*client.Validator, 0, to-from)
+	for _, share := range shares[from:to] {
+		response.Data = append(response.Data, validatorFromShare(share))
 	}
+	return api.Render(w, r, response)
 }
 
-func byOperators(operators []uint64) registrystorage.SharesFilter {
-	return func(share *types.SSVShare) bool {
-		for _, a := range operators {
-			for _, b := range share.Committee {
-				if a == b.OperatorID {
-					return true
-				}
-			}
-		}
-		return false
+// validatorSortKey returns the key the given share is sorted (and paginated) by,
+// sorting by index falls back to the public key for validators with the same (or no) index.
+func validatorSortKey(share *types.SSVShare, sortBy string) []byte {
+	if sortBy != client.ValidatorsSortIndex {
+		return share.ValidatorPubKey
+	}
+	key := make([]byte, 8, 8+len(share.ValidatorPubKey))
+	if share.HasBeaconMetadata() {
+		binary.BigEndian.PutUint64(key, uint64(share.BeaconMetadata.Index))
 	}
+	return append(key, share.ValidatorPubKey...)
 }
 
-// byClusters returns a filter that matches shares that match or contain any of the given clusters.
-func byClusters(clusters requestClusters, contains bool) registrystorage.SharesFilter {
-	return func(share *types.SSVShare) bool {
-		shareCommittee := make([]string, len(share.Committee))
-		for i, c := range share.Committee {
-			shareCommittee[i] = strconv.FormatUint(c.OperatorID, 10)
-		}
-		shareStr := strings.Join(shareCommittee, ",")
+// sharesByKey sorts shares by their sort keys
+type sharesByKey struct {
+	shares []*types.SSVShare
+	keys   [][]byte
+	desc   bool
+}
 
-		for _, cluster := range clusters {
-			clusterStrs := make([]string, len(cluster))
-			for i, c := range cluster {
-				clusterStrs[i] = strconv.FormatUint(c, 10)
-			}
-			clusterStr := strings.Join(clusterStrs, ",")
+func (s sharesByKey) Len() int { return len(s.shares) }
 
-			if contains && strings.Contains(shareStr, clusterStr) {
-				return true
-			}
-			if !contains && shareStr == clusterStr {
-				return true
-			}
-		}
-		return false
+func (s sharesByKey) Less(i, j int) bool {
+	if s.desc {
+		return bytes.Compare(s.keys[i], s.keys[j]) > 0
 	}
+	return bytes.Compare(s.keys[i], s.keys[j]) < 0
 }
 
-func byPubKeys(pubkeys []api.Hex) registrystorage.SharesFilter {
-	return func(share *types.SSVShare) bool {
-		for _, pubKey := range pubkeys {
-			if bytes.Equal(pubKey, share.ValidatorPubKey) {
-				return true
-			}
-		}
-		return false
-	}
+func (s sharesByKey) Swap(i, j int) {
+	s.shares[i], s.shares[j] = s.shares[j], s.shares[i]
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
 }
 
-func byIndices(indices []uint64) registrystorage.SharesFilter {
+// byClusters returns a filter that matches shares that match or contain any of the given clusters.
+func byClusters(clusters api.Clusters, contains bool) registrystorage.SharesFilter {
 	return func(share *types.SSVShare) bool {
-		for _, index := range indices {
-			if share.Metadata.BeaconMetadata.Index == phase0.ValidatorIndex(index) {
+		for _, cluster := range clusters {
+			if contains && committeeContains(share.Committee, cluster) {
+				return true
+			}
+			if !contains && len(cluster) == len(share.Committee) && committeeContains(share.Committee, cluster) {
 				return true
 			}
 		}
@@ -136,46 +145,29 @@ func byIndices(indices []uint64) registrystorage.SharesFilter {
 	}
 }
 
-// requestClusters is a space-separated list of comma-separated lists of operator IDs.
-type requestClusters [][]uint64
-
-func (c *requestClusters) Bind(value string) error {
-	if value == "" {
-		return nil
+// committeeContains returns whether the given operator IDs appear consecutively in the committee
+func committeeContains(committee []*spectypes.Operator, cluster []uint64) bool {
+	if len(cluster) == 0 {
+		return false
 	}
-	for _, s := range strings.Split(value, " ") {
-		var cluster []uint64
-		for _, s := range strings.Split(s, ",") {
-			n, err := strconv.ParseUint(s, 10, 64)
-			if err != nil {
-				return err
+Offsets:
+	for offset := 0; offset+len(cluster) <= len(committee); offset++ {
+		for i, id := range cluster {
+			if committee[offset+i].OperatorID != id {
+				continue Offsets
 			}
-			cluster = append(cluster, n)
 		}
-		*c = append(*c, cluster)
+		return true
 	}
-	return nil
-}
-
-type validatorJSON struct {
-	PubKey          api.Hex                `json:"public_key"`
-	Index           phase0.ValidatorIndex  `json:"index"`
-	Status          string                 `json:"status"`
-	ActivationEpoch phase0.Epoch           `json:"activation_epoch"`
-	Owner           api.Hex                `json:"owner"`
-	Committee       []spectypes.OperatorID `json:"committee"`
-	Quorum          uint64                 `json:"quorum"`
-	PartialQuorum   uint64                 `json:"partial_quorum"`
-	Graffiti        string                 `json:"graffiti"`
-	Liquidated      bool                   `json:"liquidated"`
+	return false
 }
 
-func validatorFromShare(share *types.SSVShare) *validatorJSON {
-	v := &validatorJSON{
+func validatorFromShare(share *types.SSVShare) *client.Validator {
+	v := &client.Validator{
 		PubKey: api.Hex(share.ValidatorPubKey),
 		Owner:  api.Hex(share.OwnerAddress[:]),
-		Committee: func() []spectypes.OperatorID {
-			committee := make([]spectypes.OperatorID, len(share.Committee))
+		Committee: func() []uint64 {
+			committee := make([]uint64, len(share.Committee))
 			for i, op := range share.Committee {
 				committee[i] = op.OperatorID
 			}
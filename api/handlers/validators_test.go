@@ -1,10 +1,26 @@
 package handlers
 
 import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	spectypes "github.com/bloxapp/ssv-spec/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bloxapp/ssv/api"
+	"github.com/bloxapp/ssv/api/client"
+	"github.com/bloxapp/ssv/logging"
+	beaconprotocol "github.com/bloxapp/ssv/protocol/v2/blockchain/beacon"
 	"github.com/bloxapp/ssv/protocol/v2/types"
+	registrystorage "github.com/bloxapp/ssv/registry/storage"
+	"github.com/bloxapp/ssv/storage/basedb"
+	"github.com/bloxapp/ssv/storage/kv"
 )
 
 func mockShare(operatorIDs ...uint64) *types.SSVShare {
@@ -24,56 +40,56 @@ func mockShare(operatorIDs ...uint64) *types.SSVShare {
 func TestByClusters(t *testing.T) {
 	testCases := []struct {
 		name      string
-		clusters  requestClusters
+		clusters  api.Clusters
 		contains  bool
 		shares    []*types.SSVShare
 		expectRes []bool
 	}{
 		{
 			name:      "Exact Match",
-			clusters:  requestClusters{{10, 20, 30}},
+			clusters:  api.Clusters{{10, 20, 30}},
 			contains:  false,
 			shares:    []*types.SSVShare{mockShare(10, 20, 30), mockShare(40, 50, 60)},
 			expectRes: []bool{true, false},
 		},
 		{
 			name:      "Substring Match",
-			clusters:  requestClusters{{10, 20}},
+			clusters:  api.Clusters{{10, 20}},
 			contains:  true,
 			shares:    []*types.SSVShare{mockShare(10, 20, 30), mockShare(40, 50, 60)},
 			expectRes: []bool{true, false},
 		},
 		{
 			name:      "No Match",
-			clusters:  requestClusters{{40, 50}},
+			clusters:  api.Clusters{{40, 50}},
 			contains:  false,
 			shares:    []*types.SSVShare{mockShare(10, 20, 30), mockShare(40, 50, 60)},
 			expectRes: []bool{false, false},
 		},
 		{
 			name:      "No Match With Contains",
-			clusters:  requestClusters{{70, 80}},
+			clusters:  api.Clusters{{70, 80}},
 			contains:  true,
 			shares:    []*types.SSVShare{mockShare(10, 20, 30), mockShare(40, 50, 60)},
 			expectRes: []bool{false, false},
 		},
 		{
 			name:      "Mismatch Lengths",
-			clusters:  requestClusters{{10, 20, 30}},
+			clusters:  api.Clusters{{10, 20, 30}},
 			contains:  false,
 			shares:    []*types.SSVShare{mockShare(10, 20), mockShare(40, 50, 60)},
 			expectRes: []bool{false, false},
 		},
 		{
 			name:      "Multiple Clusters",
-			clusters:  requestClusters{{20, 30, 40}, {80, 90, 100}},
+			clusters:  api.Clusters{{20, 30, 40}, {80, 90, 100}},
 			contains:  false,
 			shares:    []*types.SSVShare{mockShare(20, 30, 40), mockShare(40, 50, 60), mockShare(80, 90, 100), mockShare(70, 80, 90, 100), mockShare(60, 80, 100), mockShare(20, 30, 40)},
 			expectRes: []bool{true, false, true, false, false, true},
 		},
 		{
 			name:      "Multiple Clusters With Contains",
-			clusters:  requestClusters{{20, 30, 40}, {80, 90, 100}},
+			clusters:  api.Clusters{{20, 30, 40}, {80, 90, 100}},
 			contains:  true,
 			shares:    []*types.SSVShare{mockShare(10, 20, 30, 40, 50), mockShare(10, 30, 40), mockShare(40, 50, 60), mockShare(70, 80, 90, 100), mockShare(60, 80, 100), mockShare(20, 30, 40)},
 			expectRes: []bool{true, false, false, true, false, true},
@@ -92,3 +108,79 @@ func TestByClusters(t *testing.T) {
 		})
 	}
 }
+
+func TestValidators_List(t *testing.T) {
+	logger := logging.TestLogger(t)
+	db, err := kv.NewInMemory(logger, basedb.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	shares, err := registrystorage.NewSharesStorage(logger, db, []byte("test"))
+	require.NoError(t, err)
+
+	owner := common.HexToAddress("0x1")
+	for i := 1; i <= 10; i++ {
+		share := mockShare(1, 2, 3, 4)
+		share.ValidatorPubKey = bytes.Repeat([]byte{byte(i)}, 48)
+		share.BeaconMetadata = &beaconprotocol.ValidatorMetadata{Index: phase0.ValidatorIndex(100 - i)}
+		if i%2 == 0 {
+			share = mockShare(5, 6, 7, 8)
+			share.ValidatorPubKey = bytes.Repeat([]byte{byte(i)}, 48)
+			share.OwnerAddress = owner
+		}
+		require.NoError(t, shares.Save(nil, share))
+	}
+	h := &Validators{Shares: shares}
+
+	list := func(query string) (resp struct {
+		Data       []*client.Validator `json:"data"`
+		Pagination client.Pagination   `json:"pagination"`
+		Count      int                 `json:"count"`
+	}) {
+		r := httptest.NewRequest(http.MethodGet, "/v1/validators?"+query, nil)
+		w := httptest.NewRecorder()
+		api.Handler(h.List)(w, r)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		return resp
+	}
+
+	resp := list("")
+	require.Len(t, resp.Data, 10)
+	require.Equal(t, 10, resp.Pagination.Total)
+
+	resp = list("owners=" + hex.EncodeToString(owner[:]) + "&operators=5")
+	require.Len(t, resp.Data, 5)
+
+	resp = list("operators=1&count=true")
+	require.Nil(t, resp.Data)
+	require.Equal(t, 5, resp.Count)
+
+	resp = list("clusters=1,2,3,4&indices=99,97,96")
+	require.Len(t, resp.Data, 2)
+
+	// paginate by index
+	var indices []phase0.ValidatorIndex
+	cursor := ""
+	for {
+		resp = list("operators=1&sort=index&limit=2&cursor=" + cursor)
+		for _, v := range resp.Data {
+			indices = append(indices, v.Index)
+		}
+		if resp.Pagination.NextCursor == nil {
+			break
+		}
+		cursor = hex.EncodeToString(resp.Pagination.NextCursor)
+	}
+	require.Equal(t, []phase0.ValidatorIndex{91, 93, 95, 97, 99}, indices)
+
+	resp = list("operators=1&sort=index&desc=true&limit=3")
+	require.Len(t, resp.Data, 3)
+	require.Equal(t, phase0.ValidatorIndex(99), resp.Data[0].Index)
+	require.Equal(t, 5, resp.Pagination.Total)
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/validators?sort=balance", nil)
+	w := httptest.NewRecorder()
+	api.Handler(h.List)(w, r)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,373 @@
+// Package openapi generates an OpenAPI 3 document of the SSV API from the request and response types of its handlers.
+package openapi
+
+import (
+	"encoding"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bloxapp/ssv/api"
+)
+
+const Version = "3.0.3"
+
+// Operation describes an endpoint of the API.
+type Operation struct {
+	Method  string
+	Path    string
+	Summary string
+
+	// Request is the struct the handler binds the request into, if any.
+	// Fields tagged with path are path parameters, the others are query parameters,
+	// or the JSON body of requests which have one.
+	Request any
+
+	// Responses are the possible bodies of a successful response,
+	// multiple responses are described with oneOf.
+	Responses []any
+}
+
+type Document struct {
+	OpenAPI    string                          `json:"openapi"`
+	Info       Info                            `json:"info"`
+	Paths      map[string]map[string]*PathItem `json:"paths"`
+	Components Components                      `json:"components"`
+}
+
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type PathItem struct {
+	Summary     string               `json:"summary,omitempty"`
+	OperationID string               `json:"operationId"`
+	Parameters  []*Parameter         `json:"parameters,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Content map[string]*MediaType `json:"content"`
+}
+
+type Response struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas"`
+}
+
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	AllOf                []*Schema          `json:"allOf,omitempty"`
+	OneOf                []*Schema          `json:"oneOf,omitempty"`
+}
+
+var (
+	timeType            = reflect.TypeOf(time.Time{})
+	jsonMarshalerType   = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	binderType          = reflect.TypeOf((*api.Binder)(nil)).Elem()
+	pathParameterRegexp = regexp.MustCompile(`{([^}]+)}`)
+)
+
+// errorResponse is the body of every unsuccessful response.
+var errorResponse = api.ErrorResponse{}
+
+// Generate returns the OpenAPI document of the given operations.
+func Generate(title, version string, operations []Operation) *Document {
+	g := &generator{schemas: map[string]*Schema{}}
+	doc := &Document{
+		OpenAPI: Version,
+		Info:    Info{Title: title, Version: version},
+		Paths:   map[string]map[string]*PathItem{},
+	}
+	errorSchema := g.schema(reflect.TypeOf(errorResponse))
+
+	for _, op := range operations {
+		item := &PathItem{
+			Summary:     op.Summary,
+			OperationID: operationID(op.Method, op.Path),
+			Responses: map[string]*Response{
+				"default": {
+					Description: "Error",
+					Content:     jsonContent(errorSchema),
+				},
+			},
+		}
+
+		if op.Request != nil {
+			item.Parameters, item.RequestBody = g.request(op.Method, reflect.TypeOf(op.Request))
+		}
+
+		success := &Response{Description: "OK"}
+		switch len(op.Responses) {
+		case 0:
+		case 1:
+			success.Content = jsonContent(g.schema(reflect.TypeOf(op.Responses[0])))
+		default:
+			oneOf := &Schema{}
+			for _, response := range op.Responses {
+				oneOf.OneOf = append(oneOf.OneOf, g.schema(reflect.TypeOf(response)))
+			}
+			success.Content = jsonContent(oneOf)
+		}
+		item.Responses["200"] = success
+
+		if doc.Paths[op.Path] == nil {
+			doc.Paths[op.Path] = map[string]*PathItem{}
+		}
+		doc.Paths[op.Path][strings.ToLower(op.Method)] = item
+	}
+
+	doc.Components.Schemas = g.schemas
+	return doc
+}
+
+type generator struct {
+	schemas map[string]*Schema
+}
+
+// request returns the parameters and the body of a request which is bound into the given struct.
+func (g *generator) request(method string, typ reflect.Type) ([]*Parameter, *RequestBody) {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	var params []*Parameter
+	body := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	hasBody := method != http.MethodGet && method != http.MethodDelete && method != http.MethodHead
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		doc := field.Tag.Get("doc")
+
+		if name := field.Tag.Get("path"); name != "" {
+			params = append(params, &Parameter{
+				Name:        name,
+				In:          "path",
+				Description: doc,
+				Required:    true,
+				Schema:      g.parameterSchema(field.Type),
+			})
+			continue
+		}
+
+		if hasBody {
+			name, _ := jsonName(field)
+			if name == "" {
+				continue
+			}
+			schema := g.schema(field.Type)
+			body.Properties[name] = withDescription(schema, doc)
+			continue
+		}
+
+		name := field.Tag.Get("form")
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+		params = append(params, &Parameter{
+			Name:        name,
+			In:          "query",
+			Description: doc,
+			Schema:      g.parameterSchema(field.Type),
+		})
+	}
+
+	if !hasBody || len(body.Properties) == 0 {
+		return params, nil
+	}
+	return params, &RequestBody{Content: jsonContent(body)}
+}
+
+// parameterSchema returns the schema of a path or query parameter.
+// Types which bind themselves (such as comma-separated lists) are described as strings.
+func (g *generator) parameterSchema(typ reflect.Type) *Schema {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if reflect.PtrTo(typ).Implements(binderType) {
+		return &Schema{Type: "string"}
+	}
+	return g.schema(typ)
+}
+
+// schema returns the schema of the JSON encoding of the given type,
+// named structs are referenced from the components of the document.
+func (g *generator) schema(typ reflect.Type) *Schema {
+	if typ.Kind() == reflect.Ptr {
+		return nullable(g.schema(typ.Elem()))
+	}
+
+	switch {
+	case typ == timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case typ.Implements(jsonMarshalerType), reflect.PtrTo(typ).Implements(jsonMarshalerType),
+		typ.Implements(textMarshalerType), reflect.PtrTo(typ).Implements(textMarshalerType):
+		return &Schema{Type: "string"}
+	}
+
+	switch typ.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &Schema{Type: "integer", Format: intFormat(typ)}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer", Format: intFormat(typ)}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice:
+		if typ.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte", Nullable: true}
+		}
+		items := g.schema(typ.Elem())
+		// elements of slices are never nil in the responses of the API
+		items.Nullable = false
+		return &Schema{Type: "array", Items: items, Nullable: true}
+	case reflect.Array:
+		if typ.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "array", Items: &Schema{Type: "integer"}}
+		}
+		return &Schema{Type: "array", Items: g.schema(typ.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: g.schema(typ.Elem()), Nullable: true}
+	case reflect.Struct:
+		if typ.Name() == "" {
+			return g.structSchema(typ)
+		}
+		name := typ.Name()
+		if _, ok := g.schemas[name]; !ok {
+			g.schemas[name] = nil // guards against recursive types
+			g.schemas[name] = g.structSchema(typ)
+		}
+		return &Schema{Ref: "#/components/schemas/" + name}
+	default:
+		// interfaces may hold any value
+		return &Schema{}
+	}
+}
+
+func (g *generator) structSchema(typ reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, omitEmpty := jsonName(field)
+		if name == "" {
+			continue
+		}
+		schema.Properties[name] = withDescription(g.schema(field.Type), field.Tag.Get("doc"))
+		if !omitEmpty {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+	sort.Strings(schema.Required)
+	return schema
+}
+
+// jsonName returns the name of the field in its JSON encoding, which is empty if it's omitted.
+func jsonName(field reflect.StructField) (name string, omitEmpty bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+	parts := strings.Split(tag, ",")
+	name = parts[0]
+	if name == "" {
+		name = field.Name
+	}
+	for _, opt := range parts[1:] {
+		if opt == "omitempty" {
+			omitEmpty = true
+		}
+	}
+	return name, omitEmpty
+}
+
+// nullable allows null in place of the given schema, references can't have siblings so they're wrapped.
+func nullable(schema *Schema) *Schema {
+	if schema.Ref != "" {
+		return &Schema{AllOf: []*Schema{schema}, Nullable: true}
+	}
+	schema.Nullable = true
+	return schema
+}
+
+func withDescription(schema *Schema, description string) *Schema {
+	if description == "" {
+		return schema
+	}
+	if schema.Ref != "" {
+		return &Schema{AllOf: []*Schema{schema}, Description: description}
+	}
+	schema.Description = description
+	return schema
+}
+
+func intFormat(typ reflect.Type) string {
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int64:
+		return "int64"
+	case reflect.Uint, reflect.Uint64:
+		return "uint64"
+	case reflect.Int32:
+		return "int32"
+	default:
+		return ""
+	}
+}
+
+func jsonContent(schema *Schema) map[string]*MediaType {
+	return map[string]*MediaType{"application/json": {Schema: schema}}
+}
+
+// operationID returns a unique ID of the operation, e.g. getOperatorsById for GET /v1/operators/{id}.
+func operationID(method, path string) string {
+	var b strings.Builder
+	b.WriteString(strings.ToLower(method))
+	path = pathParameterRegexp.ReplaceAllString(path, "by-$1")
+	for _, part := range strings.FieldsFunc(path, func(r rune) bool { return r == '/' || r == '-' || r == '_' || r == '.' }) {
+		if part == "v1" {
+			continue
+		}
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return b.String()
+}
@@ -0,0 +1,136 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ValidateResponse returns an error if the body of a successful response of the given operation
+// doesn't match its schema. Validation is strict: properties which aren't in the schema are errors,
+// so that changes to the responses of the handlers fail until the document is updated.
+func (d *Document) ValidateResponse(method, path string, body []byte) error {
+	item, ok := d.Paths[path][strings.ToLower(method)]
+	if !ok {
+		return errors.Errorf("operation %s %s is not documented", method, path)
+	}
+	response, ok := item.Responses["200"]
+	if !ok || response.Content == nil {
+		return errors.Errorf("operation %s %s has no documented response", method, path)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return errors.Wrap(err, "could not decode response")
+	}
+	return d.validate(response.Content["application/json"].Schema, value, "$")
+}
+
+func (d *Document) validate(schema *Schema, value any, at string) error {
+	if schema.Ref != "" {
+		resolved, ok := d.Components.Schemas[strings.TrimPrefix(schema.Ref, "#/components/schemas/")]
+		if !ok {
+			return errors.Errorf("%s: unresolved reference %s", at, schema.Ref)
+		}
+		return d.validate(resolved, value, at)
+	}
+	if value == nil {
+		if schema.Nullable || schema.Type == "" && len(schema.AllOf) == 0 && len(schema.OneOf) == 0 {
+			return nil
+		}
+		return errors.Errorf("%s: unexpected null", at)
+	}
+	for _, sub := range schema.AllOf {
+		if err := d.validate(sub, value, at); err != nil {
+			return err
+		}
+	}
+	if len(schema.OneOf) > 0 {
+		var matches int
+		var errs []string
+		for _, sub := range schema.OneOf {
+			if err := d.validate(sub, value, at); err != nil {
+				errs = append(errs, err.Error())
+				continue
+			}
+			matches++
+		}
+		if matches != 1 {
+			return errors.Errorf("%s: matches %d of oneOf schemas (%s)", at, matches, strings.Join(errs, "; "))
+		}
+	}
+
+	switch schema.Type {
+	case "":
+		return nil
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return typeError(at, schema.Type, value)
+		}
+	case "string":
+		if _, ok := value.(string); !ok {
+			return typeError(at, schema.Type, value)
+		}
+	case "integer", "number":
+		n, ok := value.(json.Number)
+		if !ok {
+			return typeError(at, schema.Type, value)
+		}
+		if schema.Type == "integer" && strings.ContainsAny(n.String(), ".eE") {
+			return typeError(at, schema.Type, value)
+		}
+		if strings.HasPrefix(schema.Format, "uint") && strings.HasPrefix(n.String(), "-") {
+			return errors.Errorf("%s: expected unsigned integer, got %s", at, n)
+		}
+	case "array":
+		items, ok := value.([]any)
+		if !ok {
+			return typeError(at, schema.Type, value)
+		}
+		for i, item := range items {
+			if err := d.validate(schema.Items, item, fmt.Sprintf("%s[%d]", at, i)); err != nil {
+				return err
+			}
+		}
+	case "object":
+		object, ok := value.(map[string]any)
+		if !ok {
+			return typeError(at, schema.Type, value)
+		}
+		for _, name := range schema.Required {
+			if _, ok := object[name]; !ok {
+				return errors.Errorf("%s: missing required property %q", at, name)
+			}
+		}
+		names := make([]string, 0, len(object))
+		for name := range object {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			property, ok := schema.Properties[name]
+			if !ok {
+				property = schema.AdditionalProperties
+			}
+			if property == nil {
+				return errors.Errorf("%s: undocumented property %q", at, name)
+			}
+			if err := d.validate(property, object[name], at+"."+name); err != nil {
+				return err
+			}
+		}
+	default:
+		return errors.Errorf("%s: unsupported type %s", at, schema.Type)
+	}
+	return nil
+}
+
+func typeError(at, expected string, value any) error {
+	return errors.Errorf("%s: expected %s, got %T", at, expected, value)
+}
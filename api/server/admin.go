@@ -0,0 +1,244 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/bloxapp/ssv/api"
+	"github.com/bloxapp/ssv/api/handlers"
+)
+
+// maxAuditedBodySize is the maximum size of a request body that is written to the audit log.
+const maxAuditedBodySize = 4096
+
+// AdminConfig configures the admin API, which is served on a separate listener
+// and requires either bearer tokens, mTLS or both. It only listens on localhost unless Host is set.
+type AdminConfig struct {
+	Host         string   `yaml:"Host" env:"ADMIN_API_HOST" env-default:"127.0.0.1" env-description:"Host to listen on for the admin API, localhost by default"`
+	Port         int      `yaml:"Port" env:"ADMIN_API_PORT" env-description:"Port to listen on for the admin API, disabled if 0"`
+	Tokens       []string `yaml:"Tokens" env:"ADMIN_API_TOKENS" env-description:"Bearer tokens which are authorized to use the admin API"`
+	TLSCertFile  string   `yaml:"TLSCertFile" env:"ADMIN_API_TLS_CERT_FILE" env-description:"Path to the TLS certificate of the admin API"`
+	TLSKeyFile   string   `yaml:"TLSKeyFile" env:"ADMIN_API_TLS_KEY_FILE" env-description:"Path to the TLS private key of the admin API"`
+	ClientCAFile string   `yaml:"ClientCAFile" env:"ADMIN_API_CLIENT_CA_FILE" env-description:"Path to the CA certificates which sign the client certificates, enables mTLS"`
+}
+
+// Addr returns the address the admin API listens on.
+func (c AdminConfig) Addr() string {
+	return net.JoinHostPort(c.Host, strconv.Itoa(c.Port))
+}
+
+// Enabled returns whether the admin API should be served.
+func (c AdminConfig) Enabled() bool {
+	return c.Port > 0
+}
+
+// Validate returns an error if the admin API isn't protected by any authentication method.
+func (c AdminConfig) Validate() error {
+	if len(c.Tokens) == 0 && c.ClientCAFile == "" {
+		return errors.New("admin API requires either tokens or a client CA")
+	}
+	for _, token := range c.Tokens {
+		if token == "" {
+			return errors.New("admin API tokens must not be empty")
+		}
+	}
+	if (c.TLSCertFile == "") != (c.TLSKeyFile == "") {
+		return errors.New("admin API requires both a TLS certificate and key")
+	}
+	if c.ClientCAFile != "" && c.TLSCertFile == "" {
+		return errors.New("admin API requires a TLS certificate and key for mTLS")
+	}
+	return nil
+}
+
+// AdminServer serves the mutating operations of the node, every call is audit-logged.
+type AdminServer struct {
+	logger *zap.Logger
+	addr   string
+	cfg    AdminConfig
+
+	admin *handlers.Admin
+	bans  *handlers.Bans
+}
+
+func NewAdmin(
+	logger *zap.Logger,
+	addr string,
+	cfg AdminConfig,
+	admin *handlers.Admin,
+	bans *handlers.Bans,
+) *AdminServer {
+	return &AdminServer{
+		logger: logger,
+		addr:   addr,
+		cfg:    cfg,
+		admin:  admin,
+		bans:   bans,
+	}
+}
+
+// Run serves the admin API until the context is done, and then waits for the requests in progress to finish.
+func (s *AdminServer) Run(ctx context.Context) error {
+	if err := s.cfg.Validate(); err != nil {
+		return err
+	}
+
+	server := &http.Server{
+		Addr:        s.addr,
+		Handler:     s.router(),
+		ReadTimeout: 12 * time.Second,
+		// Allow for long-running operations such as a full garbage collection.
+		WriteTimeout: 10 * time.Minute,
+	}
+
+	if s.cfg.TLSCertFile == "" {
+		s.logger.Warn("admin API is served without TLS, tokens are sent in plain text")
+		s.logger.Info("Serving admin API", zap.String("addr", s.addr))
+		return serve(ctx, server, server.ListenAndServe)
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if s.cfg.ClientCAFile != "" {
+		pem, err := os.ReadFile(s.cfg.ClientCAFile)
+		if err != nil {
+			return errors.Wrap(err, "could not read client CA file")
+		}
+		clientCAs := x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pem) {
+			return errors.New("could not parse client CA file")
+		}
+		tlsConfig.ClientCAs = clientCAs
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	server.TLSConfig = tlsConfig
+
+	s.logger.Info("Serving admin API", zap.String("addr", s.addr), zap.Bool("mtls", s.cfg.ClientCAFile != ""))
+	return serve(ctx, server, func() error {
+		return server.ListenAndServeTLS(s.cfg.TLSCertFile, s.cfg.TLSKeyFile)
+	})
+}
+
+func (s *AdminServer) router() http.Handler {
+	router := chi.NewRouter()
+	router.Use(middleware.Recoverer)
+	router.Use(middlewareAudit(s.logger))
+	router.Use(middlewareAuth(s.cfg.Tokens))
+
+	router.Post("/v1/admin/validators/metadata/refresh", api.Handler(s.admin.RefreshMetadata))
+	router.Post("/v1/admin/validators/{pubkey}/restart", api.Handler(s.admin.RestartValidator))
+	router.Post("/v1/admin/validators/{pubkey}/resync", api.Handler(s.admin.ResyncValidator))
+	router.Get("/v1/admin/log-level", api.Handler(s.admin.LogLevel))
+	router.Put("/v1/admin/log-level", api.Handler(s.admin.SetLogLevel))
+	router.Post("/v1/admin/db/gc", api.Handler(s.admin.CollectGarbage))
+	router.Post("/v1/admin/bans", api.Handler(s.bans.Add))
+	router.Delete("/v1/admin/bans", api.Handler(s.bans.Remove))
+
+	return router
+}
+
+// middlewareAuth authorizes requests with one of the given bearer tokens, if any.
+// Client certificates are verified by the TLS listener when mTLS is enabled.
+func middlewareAuth(tokens []string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			if len(tokens) > 0 && !authorized(tokens, r.Header.Get("Authorization")) {
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+				return
+			}
+			next.ServeHTTP(w, r)
+		}
+		return http.HandlerFunc(fn)
+	}
+}
+
+func authorized(tokens []string, header string) bool {
+	token, ok := strings.CutPrefix(header, "Bearer ")
+	if !ok || token == "" {
+		return false
+	}
+	authorized := false
+	for _, t := range tokens {
+		// compare with every token in constant time to not leak which of them matched
+		if subtle.ConstantTimeCompare([]byte(t), []byte(token)) == 1 {
+			authorized = true
+		}
+	}
+	return authorized
+}
+
+// middlewareAudit logs every call, including unauthorized ones, along with the identity of the caller.
+// The identity is the subject of the client certificate and a fingerprint of the bearer token.
+func middlewareAudit(logger *zap.Logger) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			start := time.Now()
+
+			var body []byte
+			if r.Body != nil {
+				var err error
+				body, err = io.ReadAll(io.LimitReader(r.Body, maxAuditedBodySize+1))
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+				r.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), r.Body))
+				if len(body) > maxAuditedBodySize {
+					body = body[:maxAuditedBodySize]
+				}
+			}
+
+			defer func() {
+				logger.Info(
+					"admin API call",
+					zap.String("method", r.Method),
+					zap.String("path", r.URL.Path),
+					zap.String("query", r.URL.RawQuery),
+					zap.ByteString("body", body),
+					zap.String("remote_addr", r.RemoteAddr),
+					zap.String("client_cert", clientCertSubject(r)),
+					zap.String("token", tokenFingerprint(r.Header.Get("Authorization"))),
+					zap.Int("status", ww.Status()),
+					zap.Duration("took", time.Since(start)),
+				)
+			}()
+			next.ServeHTTP(ww, r)
+		}
+		return http.HandlerFunc(fn)
+	}
+}
+
+func clientCertSubject(r *http.Request) string {
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return ""
+	}
+	return r.TLS.PeerCertificates[0].Subject.String()
+}
+
+// tokenFingerprint identifies a bearer token in the audit log without revealing it.
+func tokenFingerprint(header string) string {
+	token, ok := strings.CutPrefix(header, "Bearer ")
+	if !ok || token == "" {
+		return ""
+	}
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:4])
+}
@@ -0,0 +1,180 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/bloxapp/ssv/api/handlers"
+	"github.com/bloxapp/ssv/logging"
+)
+
+func TestAdminServer(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	validators := &validatorsAdmin{}
+	gc := &garbageCollector{}
+	s := NewAdmin(zap.New(core), ":0", AdminConfig{Tokens: []string{"secret", "other"}},
+		&handlers.Admin{Validators: validators, DB: gc},
+		&handlers.Bans{},
+	)
+	router := s.router()
+
+	request := func(method, path, token string, body any) (int, map[string]any) {
+		var reader *bytes.Reader
+		if body != nil {
+			raw, err := json.Marshal(body)
+			require.NoError(t, err)
+			reader = bytes.NewReader(raw)
+		} else {
+			reader = bytes.NewReader(nil)
+		}
+		req := httptest.NewRequest(method, path, reader)
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		var response map[string]any
+		_ = json.Unmarshal(rec.Body.Bytes(), &response)
+		return rec.Code, response
+	}
+
+	t.Run("unauthorized", func(t *testing.T) {
+		code, _ := request(http.MethodPost, "/v1/admin/validators/metadata/refresh", "", nil)
+		require.Equal(t, http.StatusUnauthorized, code)
+		code, _ = request(http.MethodPost, "/v1/admin/validators/metadata/refresh", "wrong", nil)
+		require.Equal(t, http.StatusUnauthorized, code)
+		require.False(t, validators.refreshed)
+	})
+
+	t.Run("refresh metadata", func(t *testing.T) {
+		code, _ := request(http.MethodPost, "/v1/admin/validators/metadata/refresh", "other", nil)
+		require.Equal(t, http.StatusOK, code)
+		require.True(t, validators.refreshed)
+	})
+
+	t.Run("restart and resync validator", func(t *testing.T) {
+		pk := bytes.Repeat([]byte{1}, 48)
+
+		code, _ := request(http.MethodPost, "/v1/admin/validators/0x"+hex.EncodeToString(pk)+"/restart", "secret", nil)
+		require.Equal(t, http.StatusOK, code)
+		require.Equal(t, pk, validators.restarted)
+
+		code, _ = request(http.MethodPost, "/v1/admin/validators/"+hex.EncodeToString(pk)+"/resync", "secret", nil)
+		require.Equal(t, http.StatusOK, code)
+		require.Equal(t, pk, validators.resynced)
+
+		code, _ = request(http.MethodPost, "/v1/admin/validators/0x01/restart", "secret", nil)
+		require.Equal(t, http.StatusBadRequest, code)
+
+		validators.err = errors.New("share not found")
+		code, response := request(http.MethodPost, "/v1/admin/validators/"+hex.EncodeToString(pk)+"/restart", "secret", nil)
+		require.Equal(t, http.StatusInternalServerError, code)
+		require.Equal(t, "could not restart validator: share not found", response["error"])
+	})
+
+	t.Run("log level", func(t *testing.T) {
+		previous := logging.Level()
+		defer func() { require.NoError(t, logging.SetLevel(previous.String())) }()
+		require.NoError(t, logging.SetLevel("info"))
+
+		code, response := request(http.MethodPut, "/v1/admin/log-level", "secret", map[string]string{"level": "debug"})
+		require.Equal(t, http.StatusOK, code)
+		require.Equal(t, "debug", response["level"])
+
+		code, response = request(http.MethodGet, "/v1/admin/log-level", "secret", nil)
+		require.Equal(t, http.StatusOK, code)
+		require.Equal(t, "debug", response["level"])
+
+		code, _ = request(http.MethodPut, "/v1/admin/log-level", "secret", map[string]string{"level": "loud"})
+		require.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("garbage collection", func(t *testing.T) {
+		code, _ := request(http.MethodPost, "/v1/admin/db/gc", "secret", nil)
+		require.Equal(t, http.StatusOK, code)
+		code, _ = request(http.MethodPost, "/v1/admin/db/gc?full=true", "secret", nil)
+		require.Equal(t, http.StatusOK, code)
+		require.Equal(t, []string{"quick", "full"}, gc.cycles)
+	})
+
+	t.Run("audit log", func(t *testing.T) {
+		entries := logs.FilterMessage("admin API call").All()
+		require.NotEmpty(t, entries)
+
+		var unauthorized, setLevel bool
+		for _, entry := range entries {
+			fields := entry.ContextMap()
+			require.NotContains(t, fields["token"], "secret")
+			if fields["status"] == int64(http.StatusUnauthorized) {
+				unauthorized = true
+			}
+			if fields["method"] == http.MethodPut && strings.Contains(fields["body"].(string), `"level":"debug"`) {
+				setLevel = true
+				require.Equal(t, "/v1/admin/log-level", fields["path"])
+				require.NotEmpty(t, fields["token"])
+			}
+		}
+		require.True(t, unauthorized)
+		require.True(t, setLevel)
+	})
+}
+
+func TestAdminConfig_Validate(t *testing.T) {
+	require.Error(t, AdminConfig{Port: 1}.Validate())
+	require.Error(t, AdminConfig{Port: 1, Tokens: []string{""}}.Validate())
+	require.Error(t, AdminConfig{Port: 1, ClientCAFile: "ca.pem"}.Validate())
+	require.Error(t, AdminConfig{Port: 1, Tokens: []string{"secret"}, TLSCertFile: "cert.pem"}.Validate())
+	require.NoError(t, AdminConfig{Port: 1, Tokens: []string{"secret"}}.Validate())
+	require.NoError(t, AdminConfig{Port: 1, ClientCAFile: "ca.pem", TLSCertFile: "cert.pem", TLSKeyFile: "key.pem"}.Validate())
+}
+
+type validatorsAdmin struct {
+	refreshed bool
+	restarted []byte
+	resynced  []byte
+	err       error
+}
+
+func (v *validatorsAdmin) RefreshMetadata() {
+	v.refreshed = true
+}
+
+func (v *validatorsAdmin) RestartValidator(pubKey []byte) error {
+	v.restarted = pubKey
+	return v.err
+}
+
+func (v *validatorsAdmin) ResyncValidator(pubKey []byte) error {
+	v.resynced = pubKey
+	return v.err
+}
+
+type garbageCollector struct {
+	cycles []string
+}
+
+func (gc *garbageCollector) QuickGC(context.Context) error {
+	gc.cycles = append(gc.cycles, "quick")
+	return nil
+}
+
+func (gc *garbageCollector) FullGC(context.Context) error {
+	gc.cycles = append(gc.cycles, "full")
+	return nil
+}
@@ -1,14 +1,19 @@
 package server
 
 import (
+	"context"
 	"net/http"
 	"runtime"
 	"time"
 
 	"github.com/bloxapp/ssv/api"
+	"github.com/bloxapp/ssv/api/client"
 	"github.com/bloxapp/ssv/api/handlers"
+	"github.com/bloxapp/ssv/api/openapi"
+	"github.com/bloxapp/ssv/utils/commons"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
+	"github.com/pkg/errors"
 	"go.uber.org/zap"
 )
 
@@ -16,45 +21,135 @@ type Server struct {
 	logger *zap.Logger
 	addr   string
 
-	node       *handlers.Node
-	validators *handlers.Validators
+	node          *handlers.Node
+	bans          *handlers.Bans
+	validators    *handlers.Validators
+	operators     *handlers.Operators
+	clusters      *handlers.Clusters
+	recipients    *handlers.Recipients
+	effectiveness *handlers.Effectiveness
 }
 
 func New(
 	logger *zap.Logger,
 	addr string,
 	node *handlers.Node,
+	bans *handlers.Bans,
 	validators *handlers.Validators,
+	operators *handlers.Operators,
+	clusters *handlers.Clusters,
+	recipients *handlers.Recipients,
+	effectiveness *handlers.Effectiveness,
 ) *Server {
 	return &Server{
-		logger:     logger,
-		addr:       addr,
-		node:       node,
-		validators: validators,
+		logger:        logger,
+		addr:          addr,
+		node:          node,
+		bans:          bans,
+		validators:    validators,
+		operators:     operators,
+		clusters:      clusters,
+		recipients:    recipients,
+		effectiveness: effectiveness,
 	}
 }
 
-func (s *Server) Run() error {
+// Run serves the API until the context is done, and then waits for the requests in progress to finish.
+func (s *Server) Run(ctx context.Context) error {
+	s.logger.Info("Serving SSV API", zap.String("addr", s.addr))
+
+	server := &http.Server{
+		Addr:         s.addr,
+		Handler:      s.router(),
+		ReadTimeout:  12 * time.Second,
+		WriteTimeout: 12 * time.Second,
+	}
+	return serve(ctx, server, server.ListenAndServe)
+}
+
+// serve runs the server with the given listen function until the context is done,
+// and then shuts it down gracefully, returning once the requests in progress are finished.
+func serve(ctx context.Context, server *http.Server, listen func() error) error {
+	shutdownErr := make(chan error, 1)
+	go func() {
+		<-ctx.Done()
+		shutdownErr <- server.Shutdown(context.Background())
+	}()
+
+	if err := listen(); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return <-shutdownErr
+}
+
+// route is an endpoint of the API, the request and response types describe it in the OpenAPI document.
+type route struct {
+	method    string
+	path      string
+	summary   string
+	handler   api.HandlerFunc
+	request   any
+	responses []any
+}
+
+func (s *Server) routes() []route {
+	return []route{
+		{http.MethodGet, "/v1/node/identity", "Identity of this node", s.node.Identity, nil, []any{client.Identity{}}},
+		{http.MethodGet, "/v1/node/peers", "Peers of this node", s.node.Peers, nil, []any{[]client.Peer{}}},
+		{http.MethodGet, "/v1/node/topics", "Peers of each subscribed topic", s.node.Topics, nil, []any{client.Topics{}}},
+		{http.MethodGet, "/v1/node/bans", "Banned peers and addresses", s.bans.List, nil, []any{client.BansResponse{}}},
+		{http.MethodGet, "/v1/validators", "Validators matching the query", s.validators.List, client.ValidatorsRequest{}, []any{client.ValidatorsResponse{}, client.Count{}}},
+		{http.MethodGet, "/v1/validators/effectiveness", "On-chain effectiveness of the duties of validators", s.effectiveness.List, client.EffectivenessRequest{}, []any{client.EffectivenessResponse{}}},
+		{http.MethodGet, "/v1/operators", "Operators matching the query", s.operators.List, client.OperatorsRequest{}, []any{client.OperatorsResponse{}}},
+		{http.MethodGet, "/v1/operators/{id}", "Operator by ID", s.operators.Get, client.OperatorRequest{}, []any{client.Operator{}}},
+		{http.MethodGet, "/v1/clusters", "Clusters matching the query", s.clusters.List, client.ClustersRequest{}, []any{client.ClustersResponse{}}},
+		{http.MethodGet, "/v1/recipients", "Fee recipients of the given owners", s.recipients.List, client.RecipientsRequest{}, []any{client.RecipientsResponse{}}},
+		{http.MethodGet, "/v1/recipients/{owner}", "Fee recipient of an owner", s.recipients.Get, client.RecipientRequest{}, []any{client.Recipient{}}},
+	}
+}
+
+// openAPIPath serves the OpenAPI document which is generated from the routes.
+const openAPIPath = "/v1/openapi.json"
+
+// openAPI returns the OpenAPI document of the given routes, including the route that serves it.
+func openAPI(routes []route) *openapi.Document {
+	operations := make([]openapi.Operation, 0, len(routes)+1)
+	for _, r := range routes {
+		operations = append(operations, openapi.Operation{
+			Method:    r.method,
+			Path:      r.path,
+			Summary:   r.summary,
+			Request:   r.request,
+			Responses: r.responses,
+		})
+	}
+	operations = append(operations, openapi.Operation{
+		Method:    http.MethodGet,
+		Path:      openAPIPath,
+		Summary:   "OpenAPI document of this API",
+		Responses: []any{map[string]any{}},
+	})
+	return openapi.Generate("SSV API", commons.GetNodeVersion(), operations)
+}
+
+func (s *Server) router() http.Handler {
 	router := chi.NewRouter()
 	router.Use(middleware.Recoverer)
 	router.Use(middleware.Throttle(runtime.NumCPU() * 4))
 	router.Use(middleware.Compress(5, "application/json"))
 	router.Use(middlewareLogger(s.logger))
 
-	router.Get("/v1/node/identity", api.Handler(s.node.Identity))
-	router.Get("/v1/node/peers", api.Handler(s.node.Peers))
-	router.Get("/v1/node/topics", api.Handler(s.node.Topics))
-	router.Get("/v1/validators", api.Handler(s.validators.List))
+	routes := s.routes()
+	for _, r := range routes {
+		router.Method(r.method, r.path, api.Handler(r.handler))
+	}
 
-	s.logger.Info("Serving SSV API", zap.String("addr", s.addr))
+	doc := openAPI(routes)
+	router.Get(openAPIPath, api.Handler(func(w http.ResponseWriter, r *http.Request) error {
+		return api.Render(w, r, doc)
+	}))
 
-	server := &http.Server{
-		Addr:         s.addr,
-		Handler:      router,
-		ReadTimeout:  12 * time.Second,
-		WriteTimeout: 12 * time.Second,
-	}
-	return server.ListenAndServe()
+	return router
 }
 
 func middlewareLogger(logger *zap.Logger) func(next http.Handler) http.Handler {
@@ -0,0 +1,298 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	spectypes "github.com/bloxapp/ssv-spec/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bloxapp/ssv/api"
+	"github.com/bloxapp/ssv/api/client"
+	"github.com/bloxapp/ssv/api/handlers"
+	"github.com/bloxapp/ssv/api/openapi"
+	"github.com/bloxapp/ssv/logging"
+	"github.com/bloxapp/ssv/monitoring/effectiveness"
+	networkpeers "github.com/bloxapp/ssv/network/peers"
+	"github.com/bloxapp/ssv/protocol/v2/types"
+	registrystorage "github.com/bloxapp/ssv/registry/storage"
+	"github.com/bloxapp/ssv/storage/basedb"
+	"github.com/bloxapp/ssv/storage/kv"
+)
+
+func TestServer_OpenAPI(t *testing.T) {
+	s, owner := testServer(t)
+	router := s.router().(chi.Router)
+	doc := openAPI(s.routes())
+
+	t.Run("every route is documented", func(t *testing.T) {
+		err := chi.Walk(router, func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+			_, ok := doc.Paths[route][strings.ToLower(method)]
+			require.True(t, ok, "%s %s is not documented", method, route)
+			return nil
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("every path parameter is documented", func(t *testing.T) {
+		for path, items := range doc.Paths {
+			for method, item := range items {
+				for _, param := range strings.Split(path, "{")[1:] {
+					name := param[:strings.Index(param, "}")]
+					var found bool
+					for _, p := range item.Parameters {
+						found = found || p.In == "path" && p.Name == name
+					}
+					require.True(t, found, "%s %s doesn't document parameter %s", method, path, name)
+				}
+			}
+		}
+	})
+
+	t.Run("responses match the document", func(t *testing.T) {
+		requests := []struct {
+			route string
+			url   string
+		}{
+			{"/v1/node/bans", "/v1/node/bans"},
+			{"/v1/validators", "/v1/validators?limit=1"},
+			{"/v1/validators", "/v1/validators?count=true"},
+			{"/v1/validators/effectiveness", "/v1/validators/effectiveness?indices=1,2"},
+			{"/v1/operators", "/v1/operators"},
+			{"/v1/operators/{id}", "/v1/operators/1"},
+			{"/v1/clusters", "/v1/clusters"},
+			{"/v1/recipients", "/v1/recipients?owners=" + owner.Hex()},
+			{"/v1/recipients/{owner}", "/v1/recipients/" + owner.Hex()},
+			{openAPIPath, openAPIPath},
+		}
+		for _, r := range requests {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, r.url, nil))
+			require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+			require.NoError(t, doc.ValidateResponse(http.MethodGet, r.route, w.Body.Bytes()), r.url)
+		}
+	})
+
+	t.Run("served document", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, openAPIPath, nil))
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var served openapi.Document
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &served))
+		require.Equal(t, openapi.Version, served.OpenAPI)
+		require.Equal(t, doc.Paths, served.Paths)
+		require.Equal(t, doc.Components, served.Components)
+	})
+
+	t.Run("unknown properties fail validation", func(t *testing.T) {
+		err := doc.ValidateResponse(http.MethodGet, "/v1/operators/{id}", []byte(`{"id":1,"public_key":"","owner":"0x01","validators":0,"extra":true}`))
+		require.ErrorContains(t, err, `undocumented property "extra"`)
+		err = doc.ValidateResponse(http.MethodGet, "/v1/operators/{id}", []byte(`{"id":1}`))
+		require.ErrorContains(t, err, "missing required property")
+	})
+}
+
+func TestServer_Client(t *testing.T) {
+	s, owner := testServer(t)
+	httpServer := httptest.NewServer(s.router())
+	defer httpServer.Close()
+
+	ctx := context.Background()
+	c := client.New(httpServer.URL + "/")
+
+	bans, err := c.Bans(ctx)
+	require.NoError(t, err)
+	require.Len(t, bans, 1)
+	require.Equal(t, "10.0.0.0/8", bans[0].Target)
+	require.NotNil(t, bans[0].Expiry)
+
+	validators, err := c.Validators(ctx, client.ValidatorsRequest{
+		Owners: api.HexSlice{owner.Bytes()},
+		Limit:  2,
+	})
+	require.NoError(t, err)
+	require.Len(t, validators.Data, 2)
+	require.Equal(t, 3, validators.Pagination.Total)
+
+	next, err := c.Validators(ctx, client.ValidatorsRequest{
+		Owners: api.HexSlice{owner.Bytes()},
+		Limit:  2,
+		Cursor: validators.Pagination.NextCursor,
+	})
+	require.NoError(t, err)
+	require.Len(t, next.Data, 1)
+	require.Empty(t, next.Pagination.NextCursor)
+
+	count, err := c.CountValidators(ctx, client.ValidatorsRequest{Clusters: api.Clusters{{1, 2, 3, 4}}})
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	operators, err := c.Operators(ctx, client.OperatorsRequest{IDs: api.Uint64Slice{1, 2}})
+	require.NoError(t, err)
+	require.Len(t, operators, 2)
+
+	operator, err := c.Operator(ctx, 5)
+	require.NoError(t, err)
+	require.Equal(t, 1, operator.Validators)
+
+	_, err = c.Operator(ctx, 6)
+	require.True(t, client.IsNotFound(err))
+
+	clusters, err := c.Clusters(ctx, client.ClustersRequest{Liquidated: "false"})
+	require.NoError(t, err)
+	require.Len(t, clusters, 1)
+
+	recipient, err := c.Recipient(ctx, owner.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, api.Hex(owner.Bytes()), recipient.FeeRecipient)
+
+	_, err = c.Recipients(ctx, client.RecipientsRequest{})
+	var apiErr *client.Error
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+	require.NotEmpty(t, apiErr.Message)
+
+	validatorsEffectiveness, err := c.Effectiveness(ctx, client.EffectivenessRequest{Indices: api.Uint64Slice{1}, From: 10})
+	require.NoError(t, err)
+	require.Len(t, validatorsEffectiveness, 1)
+	require.Equal(t, 1, validatorsEffectiveness[0].Attestations)
+	require.Equal(t, 1, validatorsEffectiveness[0].IncludedAttestations)
+	require.Equal(t, float64(2), validatorsEffectiveness[0].AverageInclusionDistance)
+	require.Equal(t, uint64(30), validatorsEffectiveness[0].SyncCommittee.Participated)
+	require.Len(t, validatorsEffectiveness[0].Epochs, 1)
+
+	_, err = c.Effectiveness(ctx, client.EffectivenessRequest{From: 10, To: 9})
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+
+	doc, err := c.OpenAPI(ctx)
+	require.NoError(t, err)
+	require.Contains(t, doc["paths"], "/v1/validators")
+}
+
+func TestValues(t *testing.T) {
+	request := client.ValidatorsRequest{
+		Owners:   api.HexSlice{{0x01}, {0x02}},
+		Clusters: api.Clusters{{1, 2}, {3}},
+		Sort:     client.ValidatorsSortIndex,
+		Limit:    10,
+		Desc:     true,
+	}
+	values, err := api.Values(request)
+	require.NoError(t, err)
+	require.Equal(t, "clusters=1%2C2+3&desc=true&limit=10&owners=0x01%2C0x02&sort=index", values.Encode())
+
+	// binding the values should result in the same request
+	var bound client.ValidatorsRequest
+	require.NoError(t, api.Bind(httptest.NewRequest(http.MethodGet, "/?"+values.Encode(), nil), &bound))
+	require.Equal(t, request, bound)
+}
+
+// testServer returns a server whose registry storages contain 5 operators and 3 validators of a single owner.
+func testServer(t *testing.T) (*Server, common.Address) {
+	logger := logging.TestLogger(t)
+	db, err := kv.NewInMemory(logger, basedb.Options{})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	prefix := []byte("test")
+	shares, err := registrystorage.NewSharesStorage(logger, db, prefix)
+	require.NoError(t, err)
+	operators := registrystorage.NewOperatorsStorage(logger, db, prefix)
+	recipients := registrystorage.NewRecipientsStorage(logger, db, prefix)
+	banList, err := networkpeers.NewBanList(nil)
+	require.NoError(t, err)
+
+	owner := common.HexToAddress("0x2")
+	for id := uint64(1); id <= 5; id++ {
+		_, err := operators.SaveOperatorData(nil, &registrystorage.OperatorData{ID: id, PublicKey: []byte("pk"), OwnerAddress: owner})
+		require.NoError(t, err)
+	}
+	for i, committee := range [][]uint64{{1, 2, 3, 4}, {1, 2, 3, 4}, {2, 3, 4, 5}} {
+		share := &types.SSVShare{}
+		for _, id := range committee {
+			share.Committee = append(share.Committee, &spectypes.Operator{OperatorID: id})
+		}
+		share.ValidatorPubKey = bytes.Repeat([]byte{byte(i + 1)}, 48)
+		share.OwnerAddress = owner
+		share.Liquidated = i == 2
+		require.NoError(t, shares.Save(nil, share))
+	}
+	require.NoError(t, recipients.BumpNonce(nil, owner))
+	records := effectiveness.NewStorage(db)
+	for epoch := phase0.Epoch(9); epoch <= 10; epoch++ {
+		require.NoError(t, records.Update(1, epoch, func(record *effectiveness.Record) {
+			record.Attestation = &effectiveness.Attestation{Slot: phase0.Slot(epoch) * 32, Included: true, InclusionDistance: 2, SourceCorrect: true}
+			record.SyncCommittee = &effectiveness.SyncCommittee{Expected: 32, Participated: 30}
+		}))
+	}
+	require.NoError(t, records.Update(2, 10, func(record *effectiveness.Record) {
+		record.Attestation = &effectiveness.Attestation{Slot: 320}
+	}))
+	_, err = banList.AddBan("10.0.0.0/8", "test", time.Hour)
+	require.NoError(t, err)
+
+	s := New(
+		logger,
+		":0",
+		&handlers.Node{},
+		&handlers.Bans{BanList: banList},
+		&handlers.Validators{Shares: shares},
+		&handlers.Operators{Operators: operators, Shares: shares},
+		&handlers.Clusters{Shares: shares},
+		&handlers.Recipients{Recipients: recipients},
+		&handlers.Effectiveness{Storage: records},
+	)
+	return s, owner
+}
+
+func TestServe_Shutdown(t *testing.T) {
+	started, release := make(chan struct{}), make(chan struct{})
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		w.WriteHeader(http.StatusOK)
+	})}
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan error, 1)
+	go func() {
+		stopped <- serve(ctx, server, func() error { return server.Serve(ln) })
+	}()
+
+	statuses := make(chan int, 1)
+	go func() {
+		resp, err := http.Get("http://" + ln.Addr().String())
+		if err != nil {
+			statuses <- 0
+			return
+		}
+		_ = resp.Body.Close()
+		statuses <- resp.StatusCode
+	}()
+	<-started
+	cancel()
+
+	// the request in progress is finished before the server stops
+	select {
+	case <-stopped:
+		t.Fatal("server stopped with a request in progress")
+	case <-time.After(100 * time.Millisecond):
+	}
+	close(release)
+	require.Equal(t, http.StatusOK, <-statuses)
+	require.NoError(t, <-stopped)
+}
@@ -25,11 +25,15 @@ func (h *Hex) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (h Hex) FormValue() string {
+	return "0x" + hex.EncodeToString(h)
+}
+
 func (h *Hex) Bind(value string) error {
 	if value == "" {
 		return nil
 	}
-	b, err := hex.DecodeString(value)
+	b, err := hex.DecodeString(strings.TrimPrefix(value, "0x"))
 	if err != nil {
 		return err
 	}
@@ -39,6 +43,14 @@ func (h *Hex) Bind(value string) error {
 
 type HexSlice []Hex
 
+func (hs HexSlice) FormValue() string {
+	values := make([]string, len(hs))
+	for i, h := range hs {
+		values[i] = h.FormValue()
+	}
+	return strings.Join(values, ",")
+}
+
 func (hs *HexSlice) Bind(value string) error {
 	if value == "" {
 		return nil
@@ -56,6 +68,14 @@ func (hs *HexSlice) Bind(value string) error {
 
 type Uint64Slice []uint64
 
+func (us Uint64Slice) FormValue() string {
+	values := make([]string, len(us))
+	for i, n := range us {
+		values[i] = strconv.FormatUint(n, 10)
+	}
+	return strings.Join(values, ",")
+}
+
 func (us *Uint64Slice) Bind(value string) error {
 	if value == "" {
 		return nil
@@ -69,3 +89,28 @@ func (us *Uint64Slice) Bind(value string) error {
 	}
 	return nil
 }
+
+// Clusters is a space-separated list of comma-separated lists of operator IDs.
+type Clusters [][]uint64
+
+func (c Clusters) FormValue() string {
+	values := make([]string, len(c))
+	for i, cluster := range c {
+		values[i] = Uint64Slice(cluster).FormValue()
+	}
+	return strings.Join(values, " ")
+}
+
+func (c *Clusters) Bind(value string) error {
+	if value == "" {
+		return nil
+	}
+	for _, s := range strings.Split(value, " ") {
+		var cluster Uint64Slice
+		if err := cluster.Bind(s); err != nil {
+			return err
+		}
+		*c = append(*c, cluster)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package api
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Valuer is implemented by types that encode to a form value, it's the inverse of Binder.
+type Valuer interface {
+	FormValue() string
+}
+
+// Values encodes the fields of the given struct to form values, it's the inverse of Bind.
+// Zero values and path parameters are omitted.
+func Values(src interface{}) (url.Values, error) {
+	values := url.Values{}
+
+	val := reflect.ValueOf(src)
+	if val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return values, nil
+		}
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: %T", errInvalidType, src)
+	}
+
+	for i := 0; i < val.NumField(); i++ {
+		fieldType := val.Type().Field(i)
+
+		tag := fieldType.Tag
+		if tag.Get("path") != "" {
+			continue
+		}
+		formField := tag.Get("form")
+		if formField == "" {
+			formField = strings.ToLower(fieldType.Name)
+		}
+
+		fieldValue := val.Field(i)
+		if fieldValue.Kind() == reflect.Ptr {
+			if fieldValue.IsNil() {
+				continue
+			}
+			fieldValue = fieldValue.Elem()
+		}
+		if fieldValue.IsZero() {
+			continue
+		}
+
+		if valuer, ok := fieldValue.Interface().(Valuer); ok {
+			if v := valuer.FormValue(); v != "" {
+				values.Set(formField, v)
+			}
+			continue
+		}
+
+		switch fieldValue.Kind() {
+		case reflect.String:
+			values.Set(formField, fieldValue.String())
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			values.Set(formField, strconv.FormatInt(fieldValue.Int(), 10))
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			values.Set(formField, strconv.FormatUint(fieldValue.Uint(), 10))
+		case reflect.Float32, reflect.Float64:
+			values.Set(formField, strconv.FormatFloat(fieldValue.Float(), 'f', -1, 64))
+		case reflect.Bool:
+			values.Set(formField, strconv.FormatBool(fieldValue.Bool()))
+		default:
+			return nil, fmt.Errorf("%w: %s", errInvalidType, fieldType.Name)
+		}
+	}
+	return values, nil
+}
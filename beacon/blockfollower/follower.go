@@ -0,0 +1,104 @@
+// Package blockfollower reads the blocks of each slot once they're a few slots old,
+// for the components which follow what was included on-chain.
+package blockfollower
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/prysm/v4/async/event"
+	"go.uber.org/zap"
+
+	"github.com/bloxapp/ssv/logging/fields"
+	beaconprotocol "github.com/bloxapp/ssv/protocol/v2/blockchain/beacon"
+)
+
+// ProcessingLag is how many slots behind the current slot blocks are read,
+// so that short reorgs are settled by the time a block is read.
+const ProcessingLag = 2
+
+// BlockProvider provides the blocks which are read by the Follower.
+type BlockProvider interface {
+	SignedBeaconBlock(ctx context.Context, blockID string) (*spec.VersionedSignedBeaconBlock, error)
+}
+
+// SlotTicker notifies about the start of each slot.
+type SlotTicker interface {
+	Subscribe(subscription chan phase0.Slot) event.Subscription
+}
+
+// BlockHandler handles the block of the slot, which is nil if the slot is empty.
+type BlockHandler func(ctx context.Context, slot phase0.Slot, block *spec.VersionedSignedBeaconBlock) error
+
+// ProcessedHandler is called after each round of reads with the slot the blocks were read until,
+// and the last slot whose block was read, which is behind it if a block couldn't be read.
+type ProcessedHandler func(until, lastSlot phase0.Slot)
+
+// Follower reads the block of each slot once it's ProcessingLag slots old.
+// Blocks which couldn't be read are retried on the next slot, and slots which are more than
+// an epoch behind are skipped.
+type Follower struct {
+	logger      *zap.Logger
+	blocks      BlockProvider
+	network     beaconprotocol.BeaconNetwork
+	onBlock     BlockHandler
+	onProcessed ProcessedHandler
+
+	lastSlot phase0.Slot
+}
+
+// New creates a Follower which passes the blocks to onBlock, and then calls onProcessed if it's not nil.
+func New(logger *zap.Logger, blocks BlockProvider, network beaconprotocol.BeaconNetwork, onBlock BlockHandler, onProcessed ProcessedHandler) *Follower {
+	return &Follower{
+		logger:      logger,
+		blocks:      blocks,
+		network:     network,
+		onBlock:     onBlock,
+		onProcessed: onProcessed,
+	}
+}
+
+// Start reads the blocks of each slot once it's ProcessingLag slots old, until the context is done.
+func (f *Follower) Start(ctx context.Context, ticker SlotTicker) {
+	slots := make(chan phase0.Slot)
+	sub := ticker.Subscribe(slots)
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case slot := <-slots:
+			if slot > ProcessingLag {
+				f.ProcessUntil(ctx, slot-ProcessingLag)
+			}
+		}
+	}
+}
+
+// ProcessUntil reads the blocks of the slots since the last read slot until the given slot.
+func (f *Follower) ProcessUntil(ctx context.Context, until phase0.Slot) {
+	slotsPerEpoch := phase0.Slot(f.network.SlotsPerEpoch())
+	if f.lastSlot == 0 || until > f.lastSlot+slotsPerEpoch {
+		// Start from the given slot, or skip the slots which can't be caught up with.
+		f.lastSlot = until - 1
+	}
+
+	for slot := f.lastSlot + 1; slot <= until; slot++ {
+		block, err := f.blocks.SignedBeaconBlock(ctx, strconv.FormatUint(uint64(slot), 10))
+		if err != nil {
+			f.logger.Warn("could not get block, retrying next slot", fields.Slot(slot), zap.Error(err))
+			break
+		}
+		if err := f.onBlock(ctx, slot, block); err != nil {
+			f.logger.Warn("could not process block", fields.Slot(slot), zap.Error(err))
+		}
+		f.lastSlot = slot
+	}
+
+	if f.onProcessed != nil {
+		f.onProcessed(until, f.lastSlot)
+	}
+}
@@ -0,0 +1,64 @@
+package blockfollower
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	spectypes "github.com/bloxapp/ssv-spec/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bloxapp/ssv/logging"
+	"github.com/bloxapp/ssv/protocol/v2/blockchain/beacon"
+)
+
+type testBlocks struct {
+	unavailable map[phase0.Slot]bool
+}
+
+func (b *testBlocks) SignedBeaconBlock(_ context.Context, blockID string) (*spec.VersionedSignedBeaconBlock, error) {
+	slot, err := strconv.ParseUint(blockID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	if b.unavailable[phase0.Slot(slot)] {
+		return nil, errors.New("unavailable")
+	}
+	return nil, nil
+}
+
+func TestFollower_ProcessUntil(t *testing.T) {
+	blocks := &testBlocks{unavailable: map[phase0.Slot]bool{}}
+	var read []phase0.Slot
+	var lastSlots []phase0.Slot
+	follower := New(logging.TestLogger(t), blocks, beacon.NewNetwork(spectypes.PraterNetwork),
+		func(_ context.Context, slot phase0.Slot, _ *spec.VersionedSignedBeaconBlock) error {
+			read = append(read, slot)
+			return errors.New("blocks which can't be processed aren't retried")
+		},
+		func(_, lastSlot phase0.Slot) {
+			lastSlots = append(lastSlots, lastSlot)
+		},
+	)
+	ctx := context.Background()
+
+	// The first read starts from the given slot.
+	follower.ProcessUntil(ctx, 100)
+	require.Equal(t, []phase0.Slot{100}, read)
+
+	// Blocks which couldn't be read are retried on the next slot.
+	blocks.unavailable[102] = true
+	follower.ProcessUntil(ctx, 103)
+	require.Equal(t, []phase0.Slot{100, 101}, read)
+	delete(blocks.unavailable, 102)
+	follower.ProcessUntil(ctx, 104)
+	require.Equal(t, []phase0.Slot{100, 101, 102, 103, 104}, read)
+	require.Equal(t, []phase0.Slot{100, 101, 104}, lastSlots)
+
+	// Slots which are more than an epoch behind are skipped.
+	follower.ProcessUntil(ctx, 200)
+	require.Equal(t, []phase0.Slot{100, 101, 102, 103, 104, 200}, read)
+}
@@ -2,6 +2,7 @@ package goclient
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	eth2apiv1 "github.com/attestantio/go-eth2-client/api/v1"
@@ -9,6 +10,7 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	spectypes "github.com/bloxapp/ssv-spec/types"
 	ssz "github.com/ferranbt/fastssz"
+	"github.com/jellydator/ttlcache/v3"
 	"github.com/pkg/errors"
 )
 
@@ -17,19 +19,72 @@ func (gc *goClient) AttesterDuties(ctx context.Context, epoch phase0.Epoch, vali
 	return gc.client.AttesterDuties(ctx, epoch, validatorIndices)
 }
 
+// GetAttestationData returns the attestation data of the given slot and committee index.
+// The attestation data of a slot is fetched once for all committees and cached for the slot,
+// since it only differs by the committee index in all supported forks.
 func (gc *goClient) GetAttestationData(slot phase0.Slot, committeeIndex phase0.CommitteeIndex) (ssz.Marshaler, spec.DataVersion, error) {
-
-	startTime := time.Now()
-	attestationData, err := gc.client.AttestationData(gc.ctx, slot, committeeIndex)
+	data, err := gc.slotAttestationData(slot)
 	if err != nil {
 		return nil, DataVersionNil, err
 	}
 
-	metricsAttesterDataRequest.Observe(time.Since(startTime).Seconds())
-
+	// The checkpoints are copied as well, so that callers can't modify the cached data.
+	attestationData := &phase0.AttestationData{
+		Slot:            data.Slot,
+		Index:           committeeIndex,
+		BeaconBlockRoot: data.BeaconBlockRoot,
+		Source:          copyCheckpoint(data.Source),
+		Target:          copyCheckpoint(data.Target),
+	}
 	return attestationData, spec.DataVersionPhase0, nil
 }
 
+func copyCheckpoint(checkpoint *phase0.Checkpoint) *phase0.Checkpoint {
+	if checkpoint == nil {
+		return nil
+	}
+	checkpointCopy := *checkpoint
+	return &checkpointCopy
+}
+
+// slotAttestationData returns the attestation data of the given slot for committee index 0,
+// either from the cache or from the beacon node, with concurrent requests coalesced into one.
+func (gc *goClient) slotAttestationData(slot phase0.Slot) (*phase0.AttestationData, error) {
+	if item := gc.attestationDataCache.Get(slot); item != nil {
+		metricsAttestationDataCache.WithLabelValues("hit").Inc()
+		return item.Value(), nil
+	}
+
+	fetched := false
+	result, err, _ := gc.attestationDataInflight.Do(fmt.Sprint(slot), func() (interface{}, error) {
+		// The data might have been cached by a request which finished since the cache was checked.
+		if item := gc.attestationDataCache.Get(slot); item != nil {
+			return item.Value(), nil
+		}
+		fetched = true
+
+		startTime := time.Now()
+		attestationData, err := gc.client.AttestationData(gc.ctx, slot, 0)
+		if err != nil {
+			return nil, err
+		}
+		metricsAttesterDataRequest.Observe(time.Since(startTime).Seconds())
+
+		gc.attestationDataCache.Set(slot, attestationData, ttlcache.DefaultTTL)
+		return attestationData, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if fetched {
+		metricsAttestationDataCache.WithLabelValues("miss").Inc()
+	} else {
+		metricsAttestationDataCache.WithLabelValues("hit").Inc()
+	}
+	return result.(*phase0.AttestationData), nil
+}
+
 // SubmitAttestation implements Beacon interface
 func (gc *goClient) SubmitAttestation(attestation *phase0.Attestation) error {
 	signingRoot, err := gc.getSigningRoot(attestation.Data)
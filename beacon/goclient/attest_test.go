@@ -0,0 +1,84 @@
+package goclient
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/stretchr/testify/require"
+)
+
+// attestationDataClient is a client which counts the attestation data requests.
+type attestationDataClient struct {
+	Client
+	requests atomic.Int32
+	release  chan struct{}
+}
+
+func (c *attestationDataClient) AttestationData(_ context.Context, slot phase0.Slot, committeeIndex phase0.CommitteeIndex) (*phase0.AttestationData, error) {
+	c.requests.Add(1)
+	<-c.release
+	return &phase0.AttestationData{
+		Slot:            slot,
+		Index:           committeeIndex,
+		BeaconBlockRoot: phase0.Root{byte(slot)},
+		Source:          &phase0.Checkpoint{Epoch: 1, Root: phase0.Root{1}},
+		Target:          &phase0.Checkpoint{Epoch: 2, Root: phase0.Root{2}},
+	}, nil
+}
+
+func TestGetAttestationData(t *testing.T) {
+	client := &attestationDataClient{release: make(chan struct{})}
+	gc := &goClient{
+		ctx:    context.Background(),
+		client: client,
+		attestationDataCache: ttlcache.New(
+			ttlcache.WithTTL[phase0.Slot, *phase0.AttestationData](time.Minute),
+		),
+	}
+
+	// concurrent requests of a slot are coalesced into one
+	const committees = 64
+	results := make([]*phase0.AttestationData, committees)
+	var wg sync.WaitGroup
+	for i := 0; i < committees; i++ {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			obj, _, err := gc.GetAttestationData(10, phase0.CommitteeIndex(i))
+			require.NoError(t, err)
+			results[i] = obj.(*phase0.AttestationData)
+		}()
+	}
+	require.Eventually(t, func() bool { return client.requests.Load() == 1 }, time.Second, time.Millisecond)
+	close(client.release)
+	wg.Wait()
+	require.EqualValues(t, 1, client.requests.Load())
+
+	// the data of each committee is derived from the data of committee 0
+	for i, data := range results {
+		require.Equal(t, phase0.Slot(10), data.Slot)
+		require.Equal(t, phase0.CommitteeIndex(i), data.Index)
+		require.Equal(t, phase0.Root{10}, data.BeaconBlockRoot)
+	}
+
+	// later requests of the slot are served from the cache, and modifying the returned data doesn't modify the cache
+	results[0].Source.Epoch = 100
+	results[0].Target.Root = phase0.Root{100}
+	obj, _, err := gc.GetAttestationData(10, 3)
+	require.NoError(t, err)
+	require.Equal(t, phase0.CommitteeIndex(3), obj.(*phase0.AttestationData).Index)
+	require.Equal(t, &phase0.Checkpoint{Epoch: 1, Root: phase0.Root{1}}, obj.(*phase0.AttestationData).Source)
+	require.Equal(t, &phase0.Checkpoint{Epoch: 2, Root: phase0.Root{2}}, obj.(*phase0.AttestationData).Target)
+	require.EqualValues(t, 1, client.requests.Load())
+
+	// other slots are fetched
+	_, _, err = gc.GetAttestationData(11, 0)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, client.requests.Load())
+}
@@ -0,0 +1,18 @@
+package goclient
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// SignedBeaconBlock returns the signed beacon block of the given block ID, or nil if there's no such block.
+func (gc *goClient) SignedBeaconBlock(ctx context.Context, blockID string) (*spec.VersionedSignedBeaconBlock, error) {
+	return gc.client.SignedBeaconBlock(ctx, blockID)
+}
+
+// BeaconBlockRoot returns the root of the beacon block of the given block ID, or nil if there's no such block.
+func (gc *goClient) BeaconBlockRoot(ctx context.Context, blockID string) (*phase0.Root, error) {
+	return gc.client.BeaconBlockRoot(ctx, blockID)
+}
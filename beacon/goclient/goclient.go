@@ -13,11 +13,13 @@ import (
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	spectypes "github.com/bloxapp/ssv-spec/types"
+	"github.com/jellydator/ttlcache/v3"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/rs/zerolog"
 	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
 
 	"github.com/bloxapp/ssv/logging/fields"
 	"github.com/bloxapp/ssv/operator/slot_ticker"
@@ -34,6 +36,7 @@ var (
 	allMetrics = []prometheus.Collector{
 		metricsBeaconNodeStatus,
 		metricsBeaconDataRequest,
+		metricsAttestationDataCache,
 	}
 	metricsBeaconNodeStatus = promauto.NewGauge(prometheus.GaugeOpts{
 		Name: "ssv_beacon_status",
@@ -47,6 +50,12 @@ var (
 		Buckets: []float64{0.02, 0.05, 0.1, 0.2, 0.5, 1, 5},
 	}, []string{"role"})
 
+	// metricsAttestationDataCache counts the requests of attestation data by whether they were served by the cache.
+	metricsAttestationDataCache = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ssv_beacon_attestation_data_cache",
+		Help: "Attestation data requests by whether they were served from the cache (hit) or the beacon node (miss)",
+	}, []string{"result"})
+
 	metricsAttesterDataRequest                  = metricsBeaconDataRequest.WithLabelValues(spectypes.BNRoleAttester.String())
 	metricsAggregatorDataRequest                = metricsBeaconDataRequest.WithLabelValues(spectypes.BNRoleAggregator.String())
 	metricsProposerDataRequest                  = metricsBeaconDataRequest.WithLabelValues(spectypes.BNRoleProposer.String())
@@ -112,6 +121,7 @@ type Client interface {
 	eth2client.BlindedBeaconBlockSubmitter
 	eth2client.DomainProvider
 	eth2client.BeaconBlockRootProvider
+	eth2client.SignedBeaconBlockProvider
 	eth2client.SyncCommitteeMessagesSubmitter
 	eth2client.BeaconBlockRootProvider
 	eth2client.SyncCommitteeContributionProvider
@@ -144,6 +154,11 @@ type goClient struct {
 	registrationMu       sync.Mutex
 	registrationLastSlot phase0.Slot
 	registrationCache    map[phase0.BLSPubKey]*api.VersionedSignedValidatorRegistration
+
+	// attestationDataCache caches the attestation data of recent slots,
+	// and attestationDataInflight coalesces concurrent requests for the attestation data of a slot.
+	attestationDataCache    *ttlcache.Cache[phase0.Slot, *phase0.AttestationData]
+	attestationDataInflight singleflight.Group
 }
 
 // New init new client and go-client instance
@@ -173,6 +188,10 @@ func New(logger *zap.Logger, opt beaconprotocol.Options, operatorID spectypes.Op
 		gasLimit:          opt.GasLimit,
 		operatorID:        operatorID,
 		registrationCache: map[phase0.BLSPubKey]*api.VersionedSignedValidatorRegistration{},
+		attestationDataCache: ttlcache.New(
+			ttlcache.WithTTL[phase0.Slot, *phase0.AttestationData](opt.Network.SlotDurationSec()),
+			ttlcache.WithDisableTouchOnHit[phase0.Slot, *phase0.AttestationData](),
+		),
 	}
 
 	// Get the node's version and client.
@@ -192,6 +211,13 @@ func New(logger *zap.Logger, opt beaconprotocol.Options, operatorID spectypes.Op
 	// Start registration submitter.
 	go client.registrationSubmitter(tickerChan)
 
+	// Start automatic expired item deletion in attestationDataCache.
+	go client.attestationDataCache.Start()
+	go func() {
+		<-opt.Context.Done()
+		client.attestationDataCache.Stop()
+	}()
+
 	return client, nil
 }
 
@@ -225,6 +251,31 @@ func (gc *goClient) Healthy(ctx context.Context) error {
 	return nil
 }
 
+// Status is the status of the beacon node as reported by the status endpoint.
+type Status struct {
+	Version      string      `json:"version"`
+	SyncDistance phase0.Slot `json:"sync_distance"`
+	IsSyncing    bool        `json:"is_syncing"`
+	IsOptimistic bool        `json:"is_optimistic"`
+}
+
+// Status returns the version and sync status of the beacon node.
+func (gc *goClient) Status(ctx context.Context) (any, error) {
+	syncState, err := gc.client.NodeSyncing(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if syncState == nil {
+		return nil, errors.New("sync state is nil")
+	}
+	return Status{
+		Version:      gc.nodeVersion,
+		SyncDistance: syncState.SyncDistance,
+		IsSyncing:    syncState.IsSyncing,
+		IsOptimistic: syncState.IsOptimistic,
+	}, nil
+}
+
 // GetBeaconNetwork returns the beacon network the node is on
 func (gc *goClient) GetBeaconNetwork() spectypes.BeaconNetwork {
 	return gc.network.BeaconNetwork
@@ -23,6 +23,9 @@ import (
 
 const (
 	batchSize = 500
+	// minBlindedBlockTimeout is the minimal time given to the builder to return a blinded block,
+	// in case the duty started after the deadline of its slot.
+	minBlindedBlockTimeout = 500 * time.Millisecond
 )
 
 // ProposerDuties returns proposer duties for the given epoch.
@@ -73,12 +76,17 @@ func (gc *goClient) GetBeaconBlock(slot phase0.Slot, graffiti, randao []byte) (s
 }
 
 // GetBlindedBeaconBlock returns blinded beacon block by the given slot, graffiti, and randao.
+// The request fails if the builder doesn't return the block by the deadline of the slot,
+// so that the caller can fall back to a local block in time.
 func (gc *goClient) GetBlindedBeaconBlock(slot phase0.Slot, graffiti, randao []byte) (ssz.Marshaler, spec.DataVersion, error) {
 	sig := phase0.BLSSignature{}
 	copy(sig[:], randao[:])
 
+	ctx, cancel := context.WithDeadline(gc.ctx, gc.blindedBlockDeadline(slot))
+	defer cancel()
+
 	reqStart := time.Now()
-	beaconBlock, err := gc.client.BlindedBeaconBlockProposal(gc.ctx, slot, sig, graffiti)
+	beaconBlock, err := gc.client.BlindedBeaconBlockProposal(ctx, slot, sig, graffiti)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -110,6 +118,17 @@ func (gc *goClient) GetBlindedBeaconBlock(slot phase0.Slot, graffiti, randao []b
 	}
 }
 
+// blindedBlockDeadline returns the deadline for getting a blinded block of the given slot from the builder,
+// which is a sixth of the slot, leaving the rest of the first third of the slot
+// for the committee to reach consensus and submit the block before attestations are made.
+func (gc *goClient) blindedBlockDeadline(slot phase0.Slot) time.Time {
+	deadline := gc.network.GetSlotStartTime(slot).Add(gc.network.SlotDurationSec() / 6)
+	if time.Until(deadline) < minBlindedBlockTimeout {
+		return time.Now().Add(minBlindedBlockTimeout)
+	}
+	return deadline
+}
+
 func (gc *goClient) SubmitBlindedBeaconBlock(block *api.VersionedBlindedBeaconBlock, sig phase0.BLSSignature) error {
 	signedBlock := &api.VersionedSignedBlindedBeaconBlock{
 		Version: block.Version,
@@ -0,0 +1,11 @@
+package shadow
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var metricsSubmissions = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "ssv_shadow_submissions",
+	Help: "Submissions withheld in shadow mode by type and whether they match the chain (match, mismatch, missing or unverified)",
+}, []string{"type", "result"})
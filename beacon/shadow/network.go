@@ -0,0 +1,34 @@
+package shadow
+
+import (
+	spectypes "github.com/bloxapp/ssv-spec/types"
+	"go.uber.org/zap"
+
+	"github.com/bloxapp/ssv/logging"
+	"github.com/bloxapp/ssv/logging/fields"
+	"github.com/bloxapp/ssv/network"
+)
+
+// Network is a network.P2PNetwork which receives messages as usual but doesn't broadcast any,
+// so that a shadow node running with the identity of a live operator doesn't equivocate
+// by sending its own consensus and partial signature messages along with the live node.
+type Network struct {
+	network.P2PNetwork
+	logger *zap.Logger
+}
+
+// NewNetwork wraps the given network.
+func NewNetwork(logger *zap.Logger, p2pNetwork network.P2PNetwork) *Network {
+	return &Network{
+		P2PNetwork: p2pNetwork,
+		logger:     logger.Named(logging.NameShadow),
+	}
+}
+
+// Broadcast only logs the message.
+func (n *Network) Broadcast(msg *spectypes.SSVMessage) error {
+	n.logger.Debug("🕶️ not broadcasting message",
+		fields.MessageID(msg.MsgID),
+		fields.MessageType(msg.MsgType))
+	return nil
+}
@@ -0,0 +1,277 @@
+// Package shadow provides a beacon node which runs duties without submitting them,
+// to validate new versions and configurations against the traffic of a live network.
+package shadow
+
+import (
+	"context"
+	"sync"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"go.uber.org/zap"
+
+	"github.com/bloxapp/ssv/beacon/blockfollower"
+	"github.com/bloxapp/ssv/logging"
+	"github.com/bloxapp/ssv/logging/fields"
+	beaconprotocol "github.com/bloxapp/ssv/protocol/v2/blockchain/beacon"
+)
+
+// Comparison results of the submissions.
+const (
+	resultMatch      = "match"
+	resultMismatch   = "mismatch"
+	resultMissing    = "missing"
+	resultUnverified = "unverified"
+)
+
+// Config configures the shadow mode.
+type Config struct {
+	Enabled bool `yaml:"Enabled" env:"SHADOW_MODE" env-default:"false" env-description:"Run duties without submitting them to the beacon node, and compare them with the chain instead"`
+}
+
+type attestationKey struct {
+	slot           phase0.Slot
+	committeeIndex phase0.CommitteeIndex
+	bit            uint64
+}
+
+// Node is a beaconprotocol.BeaconNode which logs what it would submit instead of submitting it,
+// and compares it with what was included on-chain once the following blocks are available:
+//   - attestations with the attestation data included for the same validator.
+//   - blocks with the parent root of the block proposed at the same slot.
+//   - sync committee messages with the parent root of the next block.
+//
+// Aggregates, contributions, validator registrations and proposal preparations are only logged.
+// Subnet subscriptions are passed through, since they only affect the beacon node itself.
+type Node struct {
+	beaconprotocol.BeaconNode
+	logger   *zap.Logger
+	network  beaconprotocol.BeaconNetwork
+	follower *blockfollower.Follower
+
+	mu           sync.Mutex
+	attestations map[attestationKey]phase0.Root
+	proposals    map[phase0.Slot]phase0.Root
+	syncMessages map[phase0.Slot][]*altair.SyncCommitteeMessage
+}
+
+// New wraps the given beacon node, whose blocks are read from the given provider.
+func New(logger *zap.Logger, node beaconprotocol.BeaconNode, blocks blockfollower.BlockProvider, network beaconprotocol.BeaconNetwork) *Node {
+	n := &Node{
+		BeaconNode:   node,
+		logger:       logger.Named(logging.NameShadow),
+		network:      network,
+		attestations: make(map[attestationKey]phase0.Root),
+		proposals:    make(map[phase0.Slot]phase0.Root),
+		syncMessages: make(map[phase0.Slot][]*altair.SyncCommitteeMessage),
+	}
+	n.follower = blockfollower.New(n.logger, blocks, network, n.processBlock, n.processed)
+	return n
+}
+
+// SubmitAttestation records the attestation to compare it with the attestation included on-chain.
+func (n *Node) SubmitAttestation(attestation *phase0.Attestation) error {
+	root, err := attestation.Data.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	n.logger.Info("🕶️ not submitting attestation",
+		fields.Slot(attestation.Data.Slot),
+		zap.Uint64("committee_index", uint64(attestation.Data.Index)),
+		zap.Stringer("data_root", phase0.Root(root)))
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for _, bit := range attestation.AggregationBits.BitIndices() {
+		n.attestations[attestationKey{attestation.Data.Slot, attestation.Data.Index, uint64(bit)}] = root
+	}
+	return nil
+}
+
+// SubmitBeaconBlock records the block to compare it with the block proposed on-chain.
+func (n *Node) SubmitBeaconBlock(block *spec.VersionedBeaconBlock, _ phase0.BLSSignature) error {
+	slot, err := block.Slot()
+	if err != nil {
+		return err
+	}
+	parentRoot, err := block.ParentRoot()
+	if err != nil {
+		return err
+	}
+	n.recordProposal(slot, parentRoot, "block")
+	return nil
+}
+
+// SubmitBlindedBeaconBlock records the block to compare it with the block proposed on-chain.
+func (n *Node) SubmitBlindedBeaconBlock(block *api.VersionedBlindedBeaconBlock, _ phase0.BLSSignature) error {
+	slot, err := block.Slot()
+	if err != nil {
+		return err
+	}
+	parentRoot, err := block.ParentRoot()
+	if err != nil {
+		return err
+	}
+	n.recordProposal(slot, parentRoot, "blinded block")
+	return nil
+}
+
+func (n *Node) recordProposal(slot phase0.Slot, parentRoot phase0.Root, kind string) {
+	n.logger.Info("🕶️ not submitting "+kind, fields.Slot(slot), zap.Stringer("parent_root", parentRoot))
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.proposals[slot] = parentRoot
+}
+
+// SubmitSyncMessage records the message to compare it with the head of the chain at its slot.
+func (n *Node) SubmitSyncMessage(msg *altair.SyncCommitteeMessage) error {
+	n.logger.Info("🕶️ not submitting sync committee message",
+		fields.Slot(msg.Slot),
+		zap.Uint64("validator_index", uint64(msg.ValidatorIndex)),
+		zap.Stringer("block_root", msg.BeaconBlockRoot))
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.syncMessages[msg.Slot] = append(n.syncMessages[msg.Slot], msg)
+	return nil
+}
+
+// SubmitSignedAggregateSelectionProof only logs the aggregate.
+func (n *Node) SubmitSignedAggregateSelectionProof(msg *phase0.SignedAggregateAndProof) error {
+	n.logger.Info("🕶️ not submitting aggregate",
+		fields.Slot(msg.Message.Aggregate.Data.Slot),
+		zap.Uint64("aggregator_index", uint64(msg.Message.AggregatorIndex)),
+		zap.Uint64("attesters", msg.Message.Aggregate.AggregationBits.Count()))
+	metricsSubmissions.WithLabelValues("aggregate", resultUnverified).Inc()
+	return nil
+}
+
+// SubmitSignedContributionAndProof only logs the contribution.
+func (n *Node) SubmitSignedContributionAndProof(contribution *altair.SignedContributionAndProof) error {
+	n.logger.Info("🕶️ not submitting sync committee contribution",
+		fields.Slot(contribution.Message.Contribution.Slot),
+		zap.Uint64("aggregator_index", uint64(contribution.Message.AggregatorIndex)),
+		zap.Uint64("subcommittee_index", contribution.Message.Contribution.SubcommitteeIndex))
+	metricsSubmissions.WithLabelValues("contribution", resultUnverified).Inc()
+	return nil
+}
+
+// SubmitValidatorRegistration only logs the registration.
+func (n *Node) SubmitValidatorRegistration(pubkey []byte, feeRecipient bellatrix.ExecutionAddress, _ phase0.BLSSignature) error {
+	n.logger.Info("🕶️ not submitting validator registration", fields.PubKey(pubkey), fields.FeeRecipient(feeRecipient[:]))
+	metricsSubmissions.WithLabelValues("validator_registration", resultUnverified).Inc()
+	return nil
+}
+
+// SubmitProposalPreparation only logs the preparations.
+func (n *Node) SubmitProposalPreparation(feeRecipients map[phase0.ValidatorIndex]bellatrix.ExecutionAddress) error {
+	n.logger.Debug("🕶️ not submitting proposal preparations", zap.Int("validators", len(feeRecipients)))
+	metricsSubmissions.WithLabelValues("proposal_preparation", resultUnverified).Add(float64(len(feeRecipients)))
+	return nil
+}
+
+// Start compares the recorded submissions with the blocks of each slot once it's blockfollower.ProcessingLag
+// slots old, until the context is done.
+func (n *Node) Start(ctx context.Context, ticker blockfollower.SlotTicker) {
+	n.follower.Start(ctx, ticker)
+}
+
+// processed gives up on the attestations which can no longer be included.
+func (n *Node) processed(_, lastSlot phase0.Slot) {
+	slotsPerEpoch := phase0.Slot(n.network.SlotsPerEpoch())
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for key := range n.attestations {
+		if key.slot+slotsPerEpoch < lastSlot {
+			n.logger.Debug("🕶️ attestation wasn't included", fields.Slot(key.slot), zap.Uint64("committee_index", uint64(key.committeeIndex)))
+			metricsSubmissions.WithLabelValues("attestation", resultMissing).Inc()
+			delete(n.attestations, key)
+		}
+	}
+}
+
+// processBlock compares the submissions with the block of the slot, which is nil if the slot is empty.
+func (n *Node) processBlock(_ context.Context, slot phase0.Slot, block *spec.VersionedSignedBeaconBlock) error {
+	n.mu.Lock()
+	proposal, proposed := n.proposals[slot]
+	delete(n.proposals, slot)
+	syncMessages := n.syncMessages[slot-1]
+	delete(n.syncMessages, slot-1)
+	n.mu.Unlock()
+
+	if block == nil {
+		if proposed {
+			n.logger.Info("🕶️ no block was proposed", fields.Slot(slot))
+			metricsSubmissions.WithLabelValues("block", resultMissing).Inc()
+		}
+		metricsSubmissions.WithLabelValues("sync_committee_message", resultMissing).Add(float64(len(syncMessages)))
+		return nil
+	}
+
+	parentRoot, err := block.ParentRoot()
+	if err != nil {
+		return err
+	}
+	if proposed {
+		n.compare("block", slot, proposal, parentRoot)
+	}
+	for _, msg := range syncMessages {
+		n.compare("sync_committee_message", msg.Slot, msg.BeaconBlockRoot, parentRoot)
+	}
+
+	attestations, err := block.Attestations()
+	if err != nil {
+		return err
+	}
+	for _, attestation := range attestations {
+		if err := n.processAttestation(attestation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processAttestation compares the included attestation with the recorded attestations of its attesters.
+func (n *Node) processAttestation(attestation *phase0.Attestation) error {
+	var roots []phase0.Root
+	n.mu.Lock()
+	for _, bit := range attestation.AggregationBits.BitIndices() {
+		key := attestationKey{attestation.Data.Slot, attestation.Data.Index, uint64(bit)}
+		if root, ok := n.attestations[key]; ok {
+			roots = append(roots, root)
+			delete(n.attestations, key)
+		}
+	}
+	n.mu.Unlock()
+	if len(roots) == 0 {
+		return nil
+	}
+
+	included, err := attestation.Data.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	for _, root := range roots {
+		n.compare("attestation", attestation.Data.Slot, root, included)
+	}
+	return nil
+}
+
+// compare reports whether the root of a submission matches the root found on-chain.
+func (n *Node) compare(kind string, slot phase0.Slot, submitted, onChain phase0.Root) {
+	if submitted == onChain {
+		metricsSubmissions.WithLabelValues(kind, resultMatch).Inc()
+		return
+	}
+	n.logger.Warn("🕶️ submission doesn't match the chain",
+		zap.String("type", kind),
+		fields.Slot(slot),
+		zap.Stringer("submitted_root", submitted),
+		zap.Stringer("on_chain_root", onChain))
+	metricsSubmissions.WithLabelValues(kind, resultMismatch).Inc()
+}
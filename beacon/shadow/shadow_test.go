@@ -0,0 +1,117 @@
+package shadow
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	spectypes "github.com/bloxapp/ssv-spec/types"
+	"github.com/bloxapp/ssv-spec/types/testingutils"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bloxapp/ssv/logging"
+	"github.com/bloxapp/ssv/network"
+	"github.com/bloxapp/ssv/protocol/v2/blockchain/beacon"
+)
+
+type testBlocks map[phase0.Slot]*spec.VersionedSignedBeaconBlock
+
+func (b testBlocks) SignedBeaconBlock(_ context.Context, blockID string) (*spec.VersionedSignedBeaconBlock, error) {
+	slot, err := strconv.ParseUint(blockID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return b[phase0.Slot(slot)], nil
+}
+
+func testBlock(slot phase0.Slot, parentRoot phase0.Root, attestations ...*phase0.Attestation) *capella.BeaconBlock {
+	block := *testingutils.TestingBeaconBlockCapella
+	body := *block.Body
+	block.Slot = slot
+	block.ParentRoot = parentRoot
+	block.Body = &body
+	body.Attestations = attestations
+	return &block
+}
+
+func testAttestation(slot phase0.Slot, headRoot phase0.Root, bits ...uint64) *phase0.Attestation {
+	aggregationBits := bitfield.NewBitlist(8)
+	for _, bit := range bits {
+		aggregationBits.SetBitAt(bit, true)
+	}
+	return &phase0.Attestation{
+		AggregationBits: aggregationBits,
+		Data: &phase0.AttestationData{
+			Slot:            slot,
+			Index:           3,
+			BeaconBlockRoot: headRoot,
+			Source:          &phase0.Checkpoint{},
+			Target:          &phase0.Checkpoint{},
+		},
+	}
+}
+
+func TestNode(t *testing.T) {
+	blocks := testBlocks{}
+	node := New(logging.TestLogger(t), nil, blocks, beacon.NewNetwork(spectypes.PraterNetwork))
+
+	head := phase0.Root{1}
+	onChain := testBlock(101, head, testAttestation(100, head, 5, 6))
+	blocks[101] = &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionCapella,
+		Capella: &capella.SignedBeaconBlock{Message: onChain},
+	}
+
+	// The attestation of bit 5 matches, the one of bit 6 voted for another head, and the one of bit 7 isn't included.
+	require.NoError(t, node.SubmitAttestation(testAttestation(100, head, 5)))
+	require.NoError(t, node.SubmitAttestation(testAttestation(100, phase0.Root{2}, 6)))
+	require.NoError(t, node.SubmitAttestation(testAttestation(100, head, 7)))
+	// The sync committee message matches the head, while the block was built on another parent.
+	require.NoError(t, node.SubmitSyncMessage(&altair.SyncCommitteeMessage{Slot: 100, BeaconBlockRoot: head}))
+	require.NoError(t, node.SubmitBeaconBlock(&spec.VersionedBeaconBlock{
+		Version: spec.DataVersionCapella,
+		Capella: testBlock(101, phase0.Root{2}),
+	}, phase0.BLSSignature{}))
+
+	ctx := context.Background()
+	for slot := phase0.Slot(100); slot <= 133; slot++ {
+		node.follower.ProcessUntil(ctx, slot)
+	}
+
+	require.Equal(t, float64(1), testutil.ToFloat64(metricsSubmissions.WithLabelValues("attestation", resultMatch)))
+	require.Equal(t, float64(1), testutil.ToFloat64(metricsSubmissions.WithLabelValues("attestation", resultMismatch)))
+	require.Equal(t, float64(1), testutil.ToFloat64(metricsSubmissions.WithLabelValues("attestation", resultMissing)))
+	require.Equal(t, float64(1), testutil.ToFloat64(metricsSubmissions.WithLabelValues("sync_committee_message", resultMatch)))
+	require.Equal(t, float64(1), testutil.ToFloat64(metricsSubmissions.WithLabelValues("block", resultMismatch)))
+	require.Empty(t, node.attestations)
+	require.Empty(t, node.proposals)
+	require.Empty(t, node.syncMessages)
+}
+
+type testNetwork struct {
+	network.P2PNetwork
+	broadcasted []*spectypes.SSVMessage
+}
+
+func (n *testNetwork) Broadcast(msg *spectypes.SSVMessage) error {
+	n.broadcasted = append(n.broadcasted, msg)
+	return nil
+}
+
+func TestNetwork_Broadcast(t *testing.T) {
+	p2pNetwork := &testNetwork{}
+	net := NewNetwork(logging.TestLogger(t), p2pNetwork)
+
+	msg := &spectypes.SSVMessage{
+		MsgType: spectypes.SSVConsensusMsgType,
+		MsgID:   spectypes.NewMsgID(testingutils.TestingSSVDomainType, testingutils.TestingValidatorPubKey[:], spectypes.BNRoleAttester),
+	}
+	require.NoError(t, net.Broadcast(msg))
+	require.Empty(t, p2pNetwork.broadcasted)
+}
@@ -8,6 +8,9 @@ import (
 	"math/big"
 	"net/http"
 	"os"
+	"os/signal"
+	"sync"
+	"syscall"
 	"time"
 
 	spectypes "github.com/bloxapp/ssv-spec/types"
@@ -20,7 +23,9 @@ import (
 	"github.com/bloxapp/ssv/api/handlers"
 	apiserver "github.com/bloxapp/ssv/api/server"
 
+	"github.com/bloxapp/ssv/beacon/blockfollower"
 	"github.com/bloxapp/ssv/beacon/goclient"
+	"github.com/bloxapp/ssv/beacon/shadow"
 	global_config "github.com/bloxapp/ssv/cli/config"
 	"github.com/bloxapp/ssv/ekm"
 	"github.com/bloxapp/ssv/eth/eventhandler"
@@ -35,10 +40,14 @@ import (
 	"github.com/bloxapp/ssv/logging"
 	"github.com/bloxapp/ssv/logging/fields"
 	"github.com/bloxapp/ssv/migrations"
+	"github.com/bloxapp/ssv/monitoring/effectiveness"
 	"github.com/bloxapp/ssv/monitoring/metrics"
 	"github.com/bloxapp/ssv/monitoring/metricsreporter"
+	"github.com/bloxapp/ssv/monitoring/notifier"
+	"github.com/bloxapp/ssv/monitoring/tracing"
 	"github.com/bloxapp/ssv/network"
 	p2pv1 "github.com/bloxapp/ssv/network/p2p"
+	"github.com/bloxapp/ssv/network/peers"
 	"github.com/bloxapp/ssv/networkconfig"
 	"github.com/bloxapp/ssv/nodeprobe"
 	"github.com/bloxapp/ssv/operator"
@@ -76,9 +85,17 @@ type config struct {
 	WsAPIPort int  `yaml:"WebSocketAPIPort" env:"WS_API_PORT" env-description:"Port to listen on for the websocket API."`
 	WithPing  bool `yaml:"WithPing" env:"WITH_PING" env-description:"Whether to send websocket ping messages'"`
 
-	SSVAPIPort int `yaml:"SSVAPIPort" env:"SSV_API_PORT" env-description:"Port to listen on for the SSV API."`
+	SSVAPIPort int                   `yaml:"SSVAPIPort" env:"SSV_API_PORT" env-description:"Port to listen on for the SSV API."`
+	AdminAPI   apiserver.AdminConfig `yaml:"AdminAPI"`
+
+	Notifier      notifier.Config      `yaml:"Notifier"`
+	Tracing       tracing.Config       `yaml:"Tracing"`
+	Effectiveness effectiveness.Config `yaml:"Effectiveness"`
+	Shadow        shadow.Config        `yaml:"Shadow"`
 
 	LocalEventsPath string `yaml:"LocalEventsPath" env:"EVENTS_PATH" env-description:"path to local events"`
+
+	ShutdownTimeout time.Duration `yaml:"ShutdownTimeout" env:"SHUTDOWN_TIMEOUT" env-default:"12s" env-description:"Time to let running duties and the components which write to the db finish on shutdown"`
 }
 
 var cfg config
@@ -97,6 +114,23 @@ var StartNodeCmd = &cobra.Command{
 			log.Fatal("could not create logger", err)
 		}
 		defer logging.CapturePanic(logger)
+
+		// Cancelled on shutdown once the validators are stopped, to stop the remaining components.
+		ctx, cancel := context.WithCancel(cmd.Context())
+		defer cancel()
+		cmd.SetContext(ctx)
+
+		// dbWriters are the goroutines which write to the db until the context is cancelled,
+		// the db is closed on shutdown once they're done.
+		var dbWriters sync.WaitGroup
+		goDBWriter := func(f func()) {
+			dbWriters.Add(1)
+			go func() {
+				defer dbWriters.Done()
+				f()
+			}()
+		}
+
 		networkConfig, err := setupSSVNetwork(logger)
 		if err != nil {
 			logger.Fatal("could not setup network", zap.Error(err))
@@ -151,6 +185,15 @@ var StartNodeCmd = &cobra.Command{
 
 		consensusClient := setupConsensusClient(logger, operatorData.ID, slotTicker)
 
+		// In shadow mode, duties are executed as usual but nothing is broadcasted or submitted to the consensus client.
+		beaconNode := consensusClient
+		if cfg.Shadow.Enabled {
+			shadowNode := shadow.New(logger, consensusClient, consensusClient.(blockfollower.BlockProvider), networkConfig.Beacon)
+			go shadowNode.Start(cmd.Context(), slotTicker)
+			beaconNode = shadowNode
+			logger.Warn("🕶️ running in shadow mode, duties are neither broadcasted nor submitted")
+		}
+
 		executionClient, err := executionclient.New(
 			cmd.Context(),
 			cfg.ExecutionClient.Addr,
@@ -168,15 +211,20 @@ var StartNodeCmd = &cobra.Command{
 
 		cfg.SSVOptions.Context = cmd.Context()
 		cfg.SSVOptions.DB = db
-		cfg.SSVOptions.BeaconNode = consensusClient
+		cfg.SSVOptions.BeaconNode = beaconNode
 		cfg.SSVOptions.ExecutionClient = executionClient
 		cfg.SSVOptions.Network = networkConfig
 		cfg.SSVOptions.P2PNetwork = p2pNetwork
 		cfg.SSVOptions.ValidatorOptions.BeaconNetwork = networkConfig.Beacon.GetNetwork()
+		cfg.SSVOptions.ValidatorOptions.DomainAtSlot = networkConfig.DomainAtSlot
+		cfg.SSVOptions.ValidatorOptions.SignatureVerifier = types.NewDefaultSignatureVerifier()
 		cfg.SSVOptions.ValidatorOptions.Context = cmd.Context()
 		cfg.SSVOptions.ValidatorOptions.DB = db
 		cfg.SSVOptions.ValidatorOptions.Network = p2pNetwork
-		cfg.SSVOptions.ValidatorOptions.Beacon = consensusClient
+		if cfg.Shadow.Enabled {
+			cfg.SSVOptions.ValidatorOptions.Network = shadow.NewNetwork(logger, p2pNetwork)
+		}
+		cfg.SSVOptions.ValidatorOptions.Beacon = beaconNode
 		cfg.SSVOptions.ValidatorOptions.KeyManager = keyManager
 
 		cfg.SSVOptions.ValidatorOptions.ShareEncryptionKeyProvider = nodeStorage.GetPrivateKey
@@ -210,19 +258,60 @@ var StartNodeCmd = &cobra.Command{
 		cfg.SSVOptions.ValidatorOptions.StorageMap = storageMap
 		cfg.SSVOptions.ValidatorOptions.Metrics = metricsReporter
 
+		if cfg.Tracing.Enabled() {
+			shutdownTracing, err := tracing.Setup(cmd.Context(), logger, cfg.Tracing)
+			if err != nil {
+				logger.Fatal("could not setup tracing", zap.Error(err))
+			}
+			defer func() {
+				ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+				if err := shutdownTracing(ctx); err != nil {
+					logger.Warn("could not shutdown tracing", zap.Error(err))
+				}
+			}()
+		}
+
+		var notifications notifier.Notifier = notifier.Nop{}
+		closeNotifications := func() {}
+		if cfg.Notifier.Enabled() {
+			dispatcher, err := notifier.New(logger, cfg.Notifier)
+			if err != nil {
+				logger.Fatal("could not setup notifier", zap.Error(err))
+			}
+			closeNotifications = func() { _ = dispatcher.Close() }
+			defer closeNotifications()
+
+			notifications = dispatcher
+			cfg.SSVOptions.ValidatorOptions.Notifier = dispatcher
+			cfg.SSVOptions.ValidatorOptions.DutyTracker = notifier.NewDutyTracker(dispatcher, cfg.Notifier.DutyFailures)
+		}
+
+		var effectivenessStorage *effectiveness.Storage
+		if cfg.Effectiveness.Enabled {
+			effectivenessStorage = effectiveness.NewStorage(db)
+			tracker := effectiveness.NewTracker(
+				logger,
+				consensusClient.(effectiveness.BeaconNode),
+				networkConfig.Beacon,
+				effectivenessStorage,
+				cfg.Effectiveness.RetentionEpochs,
+			)
+			goDBWriter(func() { tracker.Start(cmd.Context(), slotTicker) })
+			cfg.SSVOptions.ValidatorOptions.EffectivenessTracker = tracker
+		}
+
 		validatorCtrl := validator.NewController(logger, cfg.SSVOptions.ValidatorOptions)
 		cfg.SSVOptions.ValidatorController = validatorCtrl
 		cfg.SSVOptions.Metrics = metricsReporter
 
 		operatorNode = operator.New(logger, cfg.SSVOptions, slotTicker)
 
-		if cfg.MetricsAPIPort > 0 {
-			go startMetricsHandler(cmd.Context(), logger, db, metricsReporter, cfg.MetricsAPIPort, cfg.EnableProfile)
-		}
-
 		nodeProber := nodeprobe.NewProber(
 			logger,
 			func() {
+				// deliver the pending notifications, as Fatal exits without running deferred calls.
+				closeNotifications()
 				logger.Fatal("ethereum node(s) are either out of sync or down. Ensure the nodes are healthy to resume.")
 			},
 			map[string]nodeprobe.Node{
@@ -235,6 +324,14 @@ var StartNodeCmd = &cobra.Command{
 			},
 		)
 
+		nodeProber.AddStatusReporter("p2p network", p2pNetwork.(nodeprobe.StatusReporter))
+		nodeProber.AddStatusReporter("validators", validatorCtrl)
+		nodeProber.SetNotifier(notifications)
+
+		if cfg.MetricsAPIPort > 0 {
+			go startMetricsHandler(cmd.Context(), logger, db, metricsReporter, nodeProber, cfg.MetricsAPIPort, cfg.EnableProfile)
+		}
+
 		nodeProber.Start(cmd.Context())
 		nodeProber.Wait()
 		logger.Info("ethereum node(s) are healthy")
@@ -250,6 +347,8 @@ var StartNodeCmd = &cobra.Command{
 			metricsReporter,
 			networkConfig,
 			nodeStorage,
+			notifications,
+			goDBWriter,
 		)
 		nodeProber.AddNode("event syncer", eventSyncer)
 
@@ -262,6 +361,15 @@ var StartNodeCmd = &cobra.Command{
 		if err := p2pNetwork.Start(logger); err != nil {
 			logger.Fatal("failed to start network", zap.Error(err))
 		}
+		if cfg.Notifier.Enabled() && cfg.Notifier.MinPeers > 0 {
+			host := p2pNetwork.(p2pv1.HostProvider).Host()
+			nodeProber.AddAlert("p2p peers", notifier.LowPeerCount, nodeprobe.NodeFunc(func(ctx context.Context) error {
+				if peers := len(host.Network().Peers()); peers < cfg.Notifier.MinPeers {
+					return fmt.Errorf("connected to %d peers, expected at least %d", peers, cfg.Notifier.MinPeers)
+				}
+				return nil
+			}))
+		}
 
 		if cfg.SSVAPIPort > 0 {
 			apiServer := apiserver.New(
@@ -273,24 +381,120 @@ var StartNodeCmd = &cobra.Command{
 					Network:    p2pNetwork.(p2pv1.HostProvider).Host().Network(),
 					TopicIndex: p2pNetwork.(handlers.TopicIndex),
 				},
+				&handlers.Bans{
+					BanList: cfg.P2pNetworkConfig.BanList,
+					Network: p2pNetwork.(p2pv1.HostProvider).Host().Network(),
+				},
 				&handlers.Validators{
 					Shares: nodeStorage.Shares(),
 				},
+				&handlers.Operators{
+					Operators: nodeStorage,
+					Shares:    nodeStorage.Shares(),
+				},
+				&handlers.Clusters{
+					Shares: nodeStorage.Shares(),
+				},
+				&handlers.Recipients{
+					Recipients: nodeStorage,
+				},
+				&handlers.Effectiveness{
+					Storage: effectivenessStorage,
+				},
 			)
-			go func() {
-				err := apiServer.Run()
+			goDBWriter(func() {
+				err := apiServer.Run(cmd.Context())
 				if err != nil {
 					logger.Fatal("failed to start API server", zap.Error(err))
 				}
-			}()
+			})
+		}
+
+		if cfg.AdminAPI.Enabled() {
+			if err := cfg.AdminAPI.Validate(); err != nil {
+				logger.Fatal("invalid admin API config", zap.Error(err))
+			}
+			adminServer := apiserver.NewAdmin(
+				logger.Named(logging.NameAdminAPI),
+				cfg.AdminAPI.Addr(),
+				cfg.AdminAPI,
+				&handlers.Admin{
+					Validators: validatorCtrl,
+					DB:         db,
+				},
+				&handlers.Bans{
+					BanList: cfg.P2pNetworkConfig.BanList,
+					Network: p2pNetwork.(p2pv1.HostProvider).Host().Network(),
+				},
+			)
+			goDBWriter(func() {
+				err := adminServer.Run(cmd.Context())
+				if err != nil {
+					logger.Fatal("failed to start admin API server", zap.Error(err))
+				}
+			})
 		}
 
-		if err := operatorNode.Start(logger); err != nil {
-			logger.Fatal("failed to start SSV node", zap.Error(err))
+		signals, stopSignals := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+		defer stopSignals()
+
+		nodeErr := make(chan error, 1)
+		go func() {
+			nodeErr <- operatorNode.Start(logger)
+		}()
+		select {
+		case err := <-nodeErr:
+			if err != nil {
+				logger.Fatal("failed to start SSV node", zap.Error(err))
+			}
+		case <-signals.Done():
+			// Restore the default behavior, so that another signal exits immediately.
+			stopSignals()
+			logger.Info("shutting down", zap.Duration("timeout", cfg.ShutdownTimeout))
 		}
+		shutdownNode(logger, cancel, &dbWriters, p2pNetwork, db)
 	},
 }
 
+// shutdownNode lets the running duties finish and stops the validators, then closes the network,
+// and closes the database last, once the components which write to it are stopped.
+func shutdownNode(logger *zap.Logger, cancel context.CancelFunc, dbWriters *sync.WaitGroup, p2pNetwork network.P2PNetwork, db basedb.Database) {
+	ctx, cancelTimeout := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancelTimeout()
+	operatorNode.Shutdown(ctx, logger)
+
+	start := time.Now()
+	peers := len(p2pNetwork.(p2pv1.HostProvider).Host().Network().Peers())
+	if err := p2pNetwork.Close(); err != nil {
+		logger.Warn("could not close p2p network", zap.Error(err))
+	} else {
+		logger.Info("closed p2p network", zap.Int("peers", peers), fields.Took(time.Since(start)))
+	}
+
+	cancel()
+
+	start = time.Now()
+	writersStopped := make(chan struct{})
+	go func() {
+		operatorNode.Wait()
+		dbWriters.Wait()
+		close(writersStopped)
+	}()
+	select {
+	case <-writersStopped:
+		logger.Info("stopped the components which write to the db", fields.Took(time.Since(start)))
+	case <-ctx.Done():
+		logger.Warn("closing the db before the components which write to it stopped, shutdown timed out", fields.Took(time.Since(start)))
+	}
+
+	start = time.Now()
+	if err := db.Close(); err != nil {
+		logger.Warn("could not close db", zap.Error(err))
+	} else {
+		logger.Info("closed db", fields.Took(time.Since(start)))
+	}
+}
+
 func verifyConfig(logger *zap.Logger, nodeStorage operatorstorage.Storage, networkName string, usingLocalEvents bool) {
 	storedConfig, foundConfig, err := nodeStorage.GetConfig(nil)
 	if err != nil {
@@ -442,6 +646,9 @@ func setupSSVNetwork(logger *zap.Logger) (networkconfig.NetworkConfig, error) {
 	if err != nil {
 		return networkconfig.NetworkConfig{}, err
 	}
+	if err := networkConfig.ValidateForks(); err != nil {
+		return networkconfig.NetworkConfig{}, errors.Wrap(err, "invalid fork schedule")
+	}
 
 	types.SetDefaultDomain(networkConfig.Domain)
 
@@ -477,6 +684,12 @@ func setupP2P(
 		logger.Fatal("failed to setup network private key", zap.Error(err))
 	}
 	cfg.P2pNetworkConfig.NetworkPrivateKey = netPrivKey
+	cfg.P2pNetworkConfig.PeerStore = peers.NewPeerStore(db)
+	banList, err := peers.NewBanList(db)
+	if err != nil {
+		logger.Fatal("failed to load ban list", zap.Error(err))
+	}
+	cfg.P2pNetworkConfig.BanList = banList
 
 	return p2pv1.New(logger, &cfg.P2pNetworkConfig)
 }
@@ -504,6 +717,8 @@ func setupEventHandling(
 	metricsReporter *metricsreporter.MetricsReporter,
 	networkConfig networkconfig.NetworkConfig,
 	nodeStorage operatorstorage.Storage,
+	notifications notifier.Notifier,
+	goDBWriter func(func()),
 ) *eventsyncer.EventSyncer {
 	eventFilterer, err := executionClient.Filterer()
 	if err != nil {
@@ -523,8 +738,10 @@ func setupEventHandling(
 		cfg.SSVOptions.ValidatorOptions.Beacon,
 		storageMap,
 		eventhandler.WithFullNode(),
+		eventhandler.WithForkDomains(networkConfig.Domains()),
 		eventhandler.WithLogger(logger),
 		eventhandler.WithMetrics(metricsReporter),
+		eventhandler.WithNotifier(notifications),
 	)
 	if err != nil {
 		logger.Fatal("failed to setup event data handler", zap.Error(err))
@@ -603,23 +820,27 @@ func setupEventHandling(
 		)
 
 		// Sync ongoing registry events in the background.
-		go func() {
-			err = eventSyncer.SyncOngoing(ctx, fromBlock.Uint64())
+		goDBWriter(func() {
+			err := eventSyncer.SyncOngoing(ctx, fromBlock.Uint64())
+			if ctx.Err() != nil {
+				// Stopped on shutdown.
+				return
+			}
 
-			// Crash if ongoing sync has stopped, regardless of the reason.
+			// Crash if ongoing sync has stopped for any other reason.
 			logger.Fatal("failed syncing ongoing registry events",
 				zap.Uint64("last_processed_block", lastProcessedBlock),
 				zap.Error(err))
-		}()
+		})
 	}
 
 	return eventSyncer
 }
 
-func startMetricsHandler(ctx context.Context, logger *zap.Logger, db basedb.Database, metricsReporter *metricsreporter.MetricsReporter, port int, enableProf bool) {
+func startMetricsHandler(ctx context.Context, logger *zap.Logger, db basedb.Database, metricsReporter *metricsreporter.MetricsReporter, nodeProber *nodeprobe.Prober, port int, enableProf bool) {
 	logger = logger.Named(logging.NameMetricsHandler)
 	// init and start HTTP handler
-	metricsHandler := metrics.NewMetricsHandler(ctx, db, metricsReporter, enableProf, operatorNode.(metrics.HealthChecker))
+	metricsHandler := metrics.NewMetricsHandler(ctx, db, metricsReporter, enableProf, operatorNode.(metrics.HealthChecker), nodeProber)
 	addr := fmt.Sprintf(":%d", port)
 	if err := metricsHandler.Start(logger, http.NewServeMux(), addr); err != nil {
 		logger.Panic("failed to serve metrics", zap.Error(err))
@@ -19,6 +19,7 @@ import (
 	"github.com/bloxapp/eth2-key-manager/signer"
 	slashingprotection "github.com/bloxapp/eth2-key-manager/slashing_protection"
 	"github.com/bloxapp/eth2-key-manager/wallets"
+	specqbft "github.com/bloxapp/ssv-spec/qbft"
 	spectypes "github.com/bloxapp/ssv-spec/types"
 	ssz "github.com/ferranbt/fastssz"
 	"github.com/herumi/bls-eth-go-binary/bls"
@@ -38,7 +39,7 @@ type ethKeyManagerSigner struct {
 	walletLock        *sync.RWMutex
 	signer            signer.ValidatorSigner
 	storage           Storage
-	domain            spectypes.DomainType
+	network           networkconfig.NetworkConfig
 	slashingProtector core.SlashingProtector
 	builderProposals  bool
 }
@@ -79,7 +80,7 @@ func NewETHKeyManagerSigner(logger *zap.Logger, db basedb.Database, network netw
 		walletLock:        &sync.RWMutex{},
 		signer:            beaconSigner,
 		storage:           signerStore,
-		domain:            network.Domain,
+		network:           network,
 		slashingProtector: slashingProtector,
 		builderProposals:  builderProposals,
 	}, nil
@@ -238,7 +239,12 @@ func (km *ethKeyManagerSigner) SignRoot(data spectypes.Root, sigType spectypes.S
 		return nil, errors.Wrap(err, "could not get signing account")
 	}
 
-	root, err := spectypes.ComputeSigningRoot(data, spectypes.ComputeSignatureDomain(km.domain, sigType))
+	domain, err := km.domainOf(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get signature domain")
+	}
+
+	root, err := spectypes.ComputeSigningRoot(data, spectypes.ComputeSignatureDomain(domain, sigType))
 	if err != nil {
 		return nil, errors.Wrap(err, "could not compute signing root")
 	}
@@ -251,6 +257,21 @@ func (km *ethKeyManagerSigner) SignRoot(data spectypes.Root, sigType spectypes.S
 	return sig, nil
 }
 
+// domainOf returns the domain type that is active at the slot of the given data.
+// Data without a known slot is rejected, since its domain can't be told after a fork.
+func (km *ethKeyManagerSigner) domainOf(data spectypes.Root) (spectypes.DomainType, error) {
+	switch v := data.(type) {
+	case *specqbft.Message:
+		// the height of duty instances is the slot of the duty
+		return km.network.DomainAtSlot(phase0.Slot(v.Height)), nil
+	case *spectypes.PartialSignatureMessages:
+		return km.network.DomainAtSlot(v.Slot), nil
+	case spectypes.PartialSignatureMessages:
+		return km.network.DomainAtSlot(v.Slot), nil
+	}
+	return spectypes.DomainType{}, errors.Errorf("unknown domain of %T", data)
+}
+
 func (km *ethKeyManagerSigner) AddShare(shareKey *bls.SecretKey) error {
 	km.walletLock.Lock()
 	defer km.walletLock.Unlock()
@@ -419,4 +419,13 @@ func TestSignRoot(t *testing.T) {
 		require.NoError(t, err)
 		// require.True(t, res)
 	})
+
+	t.Run("unknown domain", func(t *testing.T) {
+		pk := &bls.PublicKey{}
+		require.NoError(t, pk.Deserialize(_byteArray(pk1Str)))
+
+		// the slot of a single partial signature message doesn't tell its domain
+		_, err := km.SignRoot(&spectypes.PartialSignatureMessage{}, spectypes.PartialSignatureType, pk.Serialize())
+		require.ErrorContains(t, err, "unknown domain")
+	})
 }
@@ -20,6 +20,7 @@ import (
 	"github.com/bloxapp/ssv/eth/localevents"
 	qbftstorage "github.com/bloxapp/ssv/ibft/storage"
 	"github.com/bloxapp/ssv/logging/fields"
+	"github.com/bloxapp/ssv/monitoring/notifier"
 	nodestorage "github.com/bloxapp/ssv/operator/storage"
 	beaconprotocol "github.com/bloxapp/ssv/protocol/v2/blockchain/beacon"
 	ssvtypes "github.com/bloxapp/ssv/protocol/v2/types"
@@ -64,6 +65,7 @@ type EventHandler struct {
 	taskExecutor               taskExecutor
 	eventParser                eventparser.Parser
 	domain                     spectypes.DomainType
+	forkDomains                []spectypes.DomainType
 	operatorData               OperatorData
 	shareEncryptionKeyProvider ShareEncryptionKeyProvider
 	keyManager                 spectypes.KeyManager
@@ -73,6 +75,7 @@ type EventHandler struct {
 	fullNode bool
 	logger   *zap.Logger
 	metrics  metrics
+	notifier notifier.Notifier
 }
 
 func New(
@@ -99,11 +102,15 @@ func New(
 		storageMap:                 storageMap,
 		logger:                     zap.NewNop(),
 		metrics:                    nopMetrics{},
+		notifier:                   notifier.Nop{},
 	}
 
 	for _, opt := range opts {
 		opt(eh)
 	}
+	if len(eh.forkDomains) == 0 {
+		eh.forkDomains = []spectypes.DomainType{domain}
+	}
 
 	return eh, nil
 }
@@ -138,6 +145,9 @@ func (eh *EventHandler) HandleBlockEventsStream(logs <-chan executionclient.Bloc
 				logger.Error("failed to execute task", zap.Error(err))
 			} else {
 				logger.Debug("executed task")
+				if event, ok := eh.taskNotification(task); ok {
+					eh.notifier.Notify(event)
+				}
 			}
 		}
 	}
@@ -15,7 +15,6 @@ import (
 	"github.com/bloxapp/ssv/eth/contract"
 	"github.com/bloxapp/ssv/logging/fields"
 	qbftstorage "github.com/bloxapp/ssv/protocol/v2/qbft/storage"
-	"github.com/bloxapp/ssv/protocol/v2/types"
 	ssvtypes "github.com/bloxapp/ssv/protocol/v2/types"
 	registrystorage "github.com/bloxapp/ssv/registry/storage"
 	"github.com/bloxapp/ssv/storage/basedb"
@@ -227,6 +226,7 @@ func (eh *EventHandler) handleShareCreation(
 ) (*ssvtypes.SSVShare, error) {
 	share, shareSecret, err := validatorAddedEventToShare(
 		validatorEvent,
+		eh.domain,
 		eh.shareEncryptionKeyProvider,
 		eh.operatorData.GetOperatorData(),
 		sharePublicKeys,
@@ -257,6 +257,7 @@ func (eh *EventHandler) handleShareCreation(
 
 func validatorAddedEventToShare(
 	event *contract.ContractValidatorAdded,
+	domain spectypes.DomainType,
 	shareEncryptionKeyProvider ShareEncryptionKeyProvider,
 	operatorData *registrystorage.OperatorData,
 	sharePublicKeys [][]byte,
@@ -317,7 +318,7 @@ func validatorAddedEventToShare(
 	}
 
 	validatorShare.Quorum, validatorShare.PartialQuorum = ssvtypes.ComputeQuorumAndPartialQuorum(len(committee))
-	validatorShare.DomainType = ssvtypes.GetDefaultDomain()
+	validatorShare.DomainType = domain
 	validatorShare.Committee = committee
 	validatorShare.Graffiti = []byte("ssv.network")
 
@@ -355,8 +356,13 @@ func (eh *EventHandler) handleValidatorRemoved(txn basedb.Txn, event *contract.C
 	}
 
 	removeDecidedMessages := func(role spectypes.BeaconRole, store qbftstorage.QBFTStore) error {
-		messageID := spectypes.NewMsgID(types.GetDefaultDomain(), share.ValidatorPubKey, role)
-		return store.CleanAllInstances(logger, messageID[:])
+		for _, domain := range eh.forkDomains {
+			messageID := spectypes.NewMsgID(domain, share.ValidatorPubKey, role)
+			if err := store.CleanAllInstances(logger, messageID[:]); err != nil {
+				return err
+			}
+		}
+		return nil
 	}
 	err := eh.storageMap.Each(removeDecidedMessages)
 	if err != nil {
@@ -481,7 +487,7 @@ func (eh *EventHandler) processClusterEvent(
 		return nil, nil, fmt.Errorf("could not compute share cluster id: %w", err)
 	}
 
-	shares := eh.nodeStorage.Shares().List(txn, registrystorage.ByClusterID(clusterID))
+	shares := eh.nodeStorage.Shares().Query(txn, registrystorage.SharesQuery{ClusterIDs: [][]byte{clusterID}})
 	toUpdate := make([]*ssvtypes.SSVShare, 0)
 	updatedPubKeys := make([]string, 0)
 
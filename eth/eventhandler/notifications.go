@@ -0,0 +1,92 @@
+package eventhandler
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/bloxapp/ssv/monitoring/notifier"
+	ssvtypes "github.com/bloxapp/ssv/protocol/v2/types"
+	registrystorage "github.com/bloxapp/ssv/registry/storage"
+)
+
+// taskNotification returns the event to notify about once the given task is executed.
+// Tasks are only created for events that affect the validators of this operator,
+// except for fee recipient updates, which are notified only for owners of such validators.
+func (eh *EventHandler) taskNotification(task Task) (notifier.Event, bool) {
+	switch t := task.(type) {
+	case *StartValidatorTask:
+		pk := hex.EncodeToString(t.share.ValidatorPubKey)
+		return notifier.Event{
+			Type:    notifier.ValidatorAdded,
+			Message: fmt.Sprintf("validator %s was added", pk),
+			Fields: map[string]any{
+				"validator": pk,
+				"owner":     t.share.OwnerAddress.Hex(),
+				"operators": committeeIDs(t.share),
+			},
+		}, true
+
+	case *StopValidatorTask:
+		pk := hex.EncodeToString(t.publicKey)
+		return notifier.Event{
+			Type:    notifier.ValidatorRemoved,
+			Message: fmt.Sprintf("validator %s was removed", pk),
+			Fields:  map[string]any{"validator": pk},
+		}, true
+
+	case *LiquidateClusterTask:
+		return notifier.Event{
+			Type:     notifier.ClusterLiquidated,
+			Severity: notifier.SeverityCritical,
+			Message:  fmt.Sprintf("cluster %v of %s was liquidated", t.operatorIDs, t.owner.Hex()),
+			Fields:   clusterFields(t.owner, t.operatorIDs, t.toLiquidate),
+		}, true
+
+	case *ReactivateClusterTask:
+		return notifier.Event{
+			Type:    notifier.ClusterReactivated,
+			Message: fmt.Sprintf("cluster %v of %s was reactivated", t.operatorIDs, t.owner.Hex()),
+			Fields:  clusterFields(t.owner, t.operatorIDs, t.toReactivate),
+		}, true
+
+	case *UpdateFeeRecipientTask:
+		shares := eh.nodeStorage.Shares().Query(
+			nil,
+			registrystorage.SharesQuery{Owners: []ethcommon.Address{t.owner}},
+			registrystorage.ByOperatorID(eh.operatorData.GetOperatorData().ID),
+		)
+		if len(shares) == 0 {
+			return notifier.Event{}, false
+		}
+		return notifier.Event{
+			Type:    notifier.FeeRecipientChanged,
+			Message: fmt.Sprintf("fee recipient of %s changed to %s", t.owner.Hex(), t.recipient.Hex()),
+			Fields: map[string]any{
+				"owner":         t.owner.Hex(),
+				"fee_recipient": t.recipient.Hex(),
+				"validators":    len(shares),
+			},
+		}, true
+
+	default:
+		return notifier.Event{}, false
+	}
+}
+
+func clusterFields(owner ethcommon.Address, operatorIDs []uint64, shares []*ssvtypes.SSVShare) map[string]any {
+	return map[string]any{
+		"owner":      owner.Hex(),
+		"operators":  operatorIDs,
+		"validators": len(shares),
+	}
+}
+
+func committeeIDs(share *ssvtypes.SSVShare) []uint64 {
+	ids := make([]uint64, len(share.Committee))
+	for i, operator := range share.Committee {
+		ids[i] = operator.OperatorID
+	}
+	return ids
+}
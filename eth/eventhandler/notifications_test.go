@@ -0,0 +1,80 @@
+package eventhandler
+
+import (
+	"context"
+	"testing"
+
+	spectypes "github.com/bloxapp/ssv-spec/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bloxapp/ssv/monitoring/notifier"
+	ssvtypes "github.com/bloxapp/ssv/protocol/v2/types"
+	registrystorage "github.com/bloxapp/ssv/registry/storage"
+)
+
+func TestTaskNotification(t *testing.T) {
+	logger, _ := setupLogsCapture()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ops, err := createOperators(1)
+	require.NoError(t, err)
+
+	eh, _, err := setupEventHandler(t, ctx, logger, ops[0], true)
+	require.NoError(t, err)
+	eh.operatorData = &staticOperatorData{data: &registrystorage.OperatorData{ID: 1}}
+
+	owner := ethcommon.HexToAddress("0x1")
+	share := &ssvtypes.SSVShare{
+		Share: spectypes.Share{
+			ValidatorPubKey: []byte{0xab},
+			OperatorID:      1,
+			Committee:       []*spectypes.Operator{{OperatorID: 1}, {OperatorID: 2}},
+		},
+		Metadata: ssvtypes.Metadata{OwnerAddress: owner},
+	}
+
+	event, ok := eh.taskNotification(NewStartValidatorTask(eh.taskExecutor, share))
+	require.True(t, ok)
+	require.Equal(t, notifier.ValidatorAdded, event.Type)
+	require.Equal(t, "ab", event.Fields["validator"])
+	require.Equal(t, []uint64{1, 2}, event.Fields["operators"])
+
+	event, ok = eh.taskNotification(NewStopValidatorTask(eh.taskExecutor, []byte{0xab}))
+	require.True(t, ok)
+	require.Equal(t, notifier.ValidatorRemoved, event.Type)
+
+	event, ok = eh.taskNotification(NewLiquidateClusterTask(eh.taskExecutor, owner, []uint64{1, 2}, []*ssvtypes.SSVShare{share}))
+	require.True(t, ok)
+	require.Equal(t, notifier.ClusterLiquidated, event.Type)
+	require.Equal(t, notifier.SeverityCritical, event.Severity)
+	require.Equal(t, 1, event.Fields["validators"])
+
+	event, ok = eh.taskNotification(NewReactivateClusterTask(eh.taskExecutor, owner, []uint64{1, 2}, []*ssvtypes.SSVShare{share}))
+	require.True(t, ok)
+	require.Equal(t, notifier.ClusterReactivated, event.Type)
+
+	// fee recipient changes are notified only for owners of this operator's validators
+	feeRecipientTask := NewUpdateFeeRecipientTask(eh.taskExecutor, owner, ethcommon.HexToAddress("0x2"))
+	_, ok = eh.taskNotification(feeRecipientTask)
+	require.False(t, ok)
+
+	require.NoError(t, eh.nodeStorage.Shares().Save(nil, share))
+	event, ok = eh.taskNotification(feeRecipientTask)
+	require.True(t, ok)
+	require.Equal(t, notifier.FeeRecipientChanged, event.Type)
+	require.Equal(t, ethcommon.HexToAddress("0x2").Hex(), event.Fields["fee_recipient"])
+}
+
+type staticOperatorData struct {
+	data *registrystorage.OperatorData
+}
+
+func (s *staticOperatorData) GetOperatorData() *registrystorage.OperatorData {
+	return s.data
+}
+
+func (s *staticOperatorData) SetOperatorData(data *registrystorage.OperatorData) {
+	s.data = data
+}
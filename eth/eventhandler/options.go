@@ -1,7 +1,10 @@
 package eventhandler
 
 import (
+	spectypes "github.com/bloxapp/ssv-spec/types"
+
 	"github.com/bloxapp/ssv/logging"
+	"github.com/bloxapp/ssv/monitoring/notifier"
 	"go.uber.org/zap"
 )
 
@@ -28,3 +31,18 @@ func WithFullNode() Option {
 		eh.fullNode = true
 	}
 }
+
+// WithForkDomains sets the domain types of all forks, the decided messages of every domain
+// are removed along with a validator. Defaults to the domain of the EventHandler.
+func WithForkDomains(domains []spectypes.DomainType) Option {
+	return func(eh *EventHandler) {
+		eh.forkDomains = domains
+	}
+}
+
+// WithNotifier enables notifications about the executed tasks.
+func WithNotifier(n notifier.Notifier) Option {
+	return func(eh *EventHandler) {
+		eh.notifier = n
+	}
+}
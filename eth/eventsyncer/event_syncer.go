@@ -28,6 +28,11 @@ type ExecutionClient interface {
 	StreamLogs(ctx context.Context, fromBlock uint64) <-chan executionclient.BlockLogs
 }
 
+// headBlockProvider is optionally implemented by the ExecutionClient to report the lag of the syncer.
+type headBlockProvider interface {
+	HeadBlock(ctx context.Context) (uint64, error)
+}
+
 type EventHandler interface {
 	HandleBlockEventsStream(logs <-chan executionclient.BlockLogs, executeTasks bool) (uint64, error)
 }
@@ -85,6 +90,39 @@ func (es *EventSyncer) Healthy(ctx context.Context) error {
 	return nil
 }
 
+// Status is the status of the event syncer as reported by the status endpoint.
+type Status struct {
+	LastProcessedBlock uint64 `json:"last_processed_block"`
+	// Lag is the number of blocks between the head of the execution client and the last processed block,
+	// it's omitted if the execution client can't report its head.
+	Lag *uint64 `json:"lag,omitempty"`
+}
+
+// Status returns the last processed block and how far it lags behind the execution client's head.
+func (es *EventSyncer) Status(ctx context.Context) (any, error) {
+	lastProcessedBlock, found, err := es.nodeStorage.GetLastProcessedBlock(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read last processed block: %w", err)
+	}
+	status := Status{}
+	if found && lastProcessedBlock != nil {
+		status.LastProcessedBlock = lastProcessedBlock.Uint64()
+	}
+
+	if provider, ok := es.executionClient.(headBlockProvider); ok {
+		headBlock, err := provider.HeadBlock(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get head block: %w", err)
+		}
+		var lag uint64
+		if headBlock > status.LastProcessedBlock {
+			lag = headBlock - status.LastProcessedBlock
+		}
+		status.Lag = &lag
+	}
+	return status, nil
+}
+
 // SyncHistory reads and processes historical events since the given fromBlock.
 func (es *EventSyncer) SyncHistory(ctx context.Context, fromBlock uint64) (lastProcessedBlock uint64, err error) {
 	fetchLogs, fetchError, err := es.executionClient.FetchHistoricalLogs(ctx, fromBlock)
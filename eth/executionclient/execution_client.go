@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
@@ -42,8 +43,9 @@ type ExecutionClient struct {
 	logBatchSize                uint64
 
 	// variables
-	client *ethclient.Client
-	closed chan struct{}
+	client           *ethclient.Client
+	closed           chan struct{}
+	lastFetchedBlock atomic.Uint64
 }
 
 // New creates a new instance of ExecutionClient.
@@ -168,7 +170,7 @@ func (ec *ExecutionClient) fetchLogsInBatches(ctx context.Context, startBlock, e
 			}
 		}
 
-		ec.metrics.ExecutionClientLastFetchedBlock(endBlock)
+		ec.setLastFetchedBlock(endBlock)
 	}()
 
 	return logs, errors
@@ -244,6 +246,45 @@ func (ec *ExecutionClient) Healthy(ctx context.Context) error {
 	return nil
 }
 
+// Status is the status of the execution client as reported by the status endpoint.
+type Status struct {
+	HeadBlock        uint64 `json:"head_block"`
+	LastFetchedBlock uint64 `json:"last_fetched_block"`
+}
+
+// Status returns the head block of the execution client and the last block fetched from it.
+func (ec *ExecutionClient) Status(ctx context.Context) (any, error) {
+	headBlock, err := ec.HeadBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return Status{
+		HeadBlock:        headBlock,
+		LastFetchedBlock: ec.lastFetchedBlock.Load(),
+	}, nil
+}
+
+// HeadBlock returns the current block number of the execution client.
+func (ec *ExecutionClient) HeadBlock(ctx context.Context) (uint64, error) {
+	if ec.isClosed() {
+		return 0, ErrClosed
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ec.connectionTimeout)
+	defer cancel()
+
+	headBlock, err := ec.client.BlockNumber(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get current block: %w", err)
+	}
+	return headBlock, nil
+}
+
+func (ec *ExecutionClient) setLastFetchedBlock(block uint64) {
+	ec.lastFetchedBlock.Store(block)
+	ec.metrics.ExecutionClientLastFetchedBlock(block)
+}
+
 func (ec *ExecutionClient) isClosed() bool {
 	select {
 	case <-ec.closed:
@@ -297,7 +338,7 @@ func (ec *ExecutionClient) streamLogsToChan(ctx context.Context, logs chan<- Blo
 				return lastBlock, fmt.Errorf("fetch logs: %w", err)
 			}
 			fromBlock = toBlock + 1
-			ec.metrics.ExecutionClientLastFetchedBlock(fromBlock)
+			ec.setLastFetchedBlock(fromBlock)
 		}
 	}
 }
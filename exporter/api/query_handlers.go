@@ -11,7 +11,7 @@ import (
 	"github.com/bloxapp/ssv/ibft/storage"
 	"github.com/bloxapp/ssv/logging/fields"
 	"github.com/bloxapp/ssv/protocol/v2/message"
-	"github.com/bloxapp/ssv/protocol/v2/types"
+	qbftstorage "github.com/bloxapp/ssv/protocol/v2/qbft/storage"
 )
 
 const (
@@ -19,7 +19,8 @@ const (
 )
 
 // HandleDecidedQuery handles TypeDecided queries.
-func HandleDecidedQuery(logger *zap.Logger, qbftStorage *storage.QBFTStores, nm *NetworkMessage) {
+// The instances of every given domain are returned, since the queried range may span forks.
+func HandleDecidedQuery(logger *zap.Logger, qbftStorage *storage.QBFTStores, domains []spectypes.DomainType, nm *NetworkMessage) {
 	logger.Debug("handles decided request",
 		zap.Uint64("from", nm.Msg.Filter.From),
 		zap.Uint64("to", nm.Msg.Filter.To),
@@ -54,24 +55,30 @@ func HandleDecidedQuery(logger *zap.Logger, qbftStorage *storage.QBFTStores, nm
 		return
 	}
 
-	msgID := spectypes.NewMsgID(types.GetDefaultDomain(), pkRaw, beaconRole)
 	from := specqbft.Height(nm.Msg.Filter.From)
 	to := specqbft.Height(nm.Msg.Filter.To)
-	instances, err := roleStorage.GetInstancesInRange(msgID[:], from, to)
-	if err != nil {
-		logger.Warn("failed to get instances", zap.Error(err))
-		res.Data = []string{"internal error - could not get decided messages"}
-	} else {
-		msgs := make([]*specqbft.SignedMessage, 0, len(instances))
-		for _, instance := range instances {
-			msgs = append(msgs, instance.DecidedMessage)
-		}
-		data, err := DecidedAPIData(msgs...)
+	var instances []*qbftstorage.StoredInstance
+	for _, domain := range domains {
+		msgID := spectypes.NewMsgID(domain, pkRaw, beaconRole)
+		domainInstances, err := roleStorage.GetInstancesInRange(msgID[:], from, to)
 		if err != nil {
-			res.Data = []string{err.Error()}
-		} else {
-			res.Data = data
+			logger.Warn("failed to get instances", zap.Error(err))
+			res.Data = []string{"internal error - could not get decided messages"}
+			nm.Msg = res
+			return
 		}
+		instances = append(instances, domainInstances...)
+	}
+
+	msgs := make([]*specqbft.SignedMessage, 0, len(instances))
+	for _, instance := range instances {
+		msgs = append(msgs, instance.DecidedMessage)
+	}
+	data, err := DecidedAPIData(msgs...)
+	if err != nil {
+		res.Data = []string{err.Error()}
+	} else {
+		res.Data = data
 	}
 
 	nm.Msg = res
@@ -121,7 +121,7 @@ func TestHandleDecidedQuery(t *testing.T) {
 
 	t.Run("valid range", func(t *testing.T) {
 		nm := newDecidedAPIMsg(pk.SerializeToHexStr(), spectypes.BNRoleAttester, 0, 250)
-		HandleDecidedQuery(l, ibftStorage, nm)
+		HandleDecidedQuery(l, ibftStorage, []spectypes.DomainType{types.GetDefaultDomain()}, nm)
 		require.NotNil(t, nm.Msg.Data)
 		msgs, ok := nm.Msg.Data.([]*SignedMessageAPI)
 		require.True(t, ok, "expected []*SignedMessageAPI, got %+v", nm.Msg.Data)
@@ -130,7 +130,7 @@ func TestHandleDecidedQuery(t *testing.T) {
 
 	t.Run("invalid range", func(t *testing.T) {
 		nm := newDecidedAPIMsg(pk.SerializeToHexStr(), spectypes.BNRoleAttester, 400, 404)
-		HandleDecidedQuery(l, ibftStorage, nm)
+		HandleDecidedQuery(l, ibftStorage, []spectypes.DomainType{types.GetDefaultDomain()}, nm)
 		require.NotNil(t, nm.Msg.Data)
 		data, ok := nm.Msg.Data.([]string)
 		require.True(t, ok)
@@ -139,7 +139,7 @@ func TestHandleDecidedQuery(t *testing.T) {
 
 	t.Run("non-existing validator", func(t *testing.T) {
 		nm := newDecidedAPIMsg("xxx", spectypes.BNRoleAttester, 400, 404)
-		HandleDecidedQuery(l, ibftStorage, nm)
+		HandleDecidedQuery(l, ibftStorage, []spectypes.DomainType{types.GetDefaultDomain()}, nm)
 		require.NotNil(t, nm.Msg.Data)
 		errs, ok := nm.Msg.Data.([]string)
 		require.True(t, ok)
@@ -148,7 +148,7 @@ func TestHandleDecidedQuery(t *testing.T) {
 
 	t.Run("non-existing role", func(t *testing.T) {
 		nm := newDecidedAPIMsg(pk.SerializeToHexStr(), math.MaxUint64, 0, 250)
-		HandleDecidedQuery(l, ibftStorage, nm)
+		HandleDecidedQuery(l, ibftStorage, []spectypes.DomainType{types.GetDefaultDomain()}, nm)
 		require.NotNil(t, nm.Msg.Data)
 		errs, ok := nm.Msg.Data.([]string)
 		require.True(t, ok)
@@ -157,7 +157,7 @@ func TestHandleDecidedQuery(t *testing.T) {
 
 	t.Run("non-existing storage", func(t *testing.T) {
 		nm := newDecidedAPIMsg(pk.SerializeToHexStr(), spectypes.BNRoleSyncCommitteeContribution, 0, 250)
-		HandleDecidedQuery(l, ibftStorage, nm)
+		HandleDecidedQuery(l, ibftStorage, []spectypes.DomainType{types.GetDefaultDomain()}, nm)
 		require.NotNil(t, nm.Msg.Data)
 		errs, ok := nm.Msg.Data.([]string)
 		require.True(t, ok)
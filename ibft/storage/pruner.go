@@ -0,0 +1,163 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	specqbft "github.com/bloxapp/ssv-spec/qbft"
+	spectypes "github.com/bloxapp/ssv-spec/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+
+	beaconprotocol "github.com/bloxapp/ssv/protocol/v2/blockchain/beacon"
+	qbftstorage "github.com/bloxapp/ssv/protocol/v2/qbft/storage"
+)
+
+var (
+	metricsPrunedInstances = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ssv:validator:ibft_pruned_instances",
+		Help: "The amount of historical instances that were removed by retention pruning",
+	}, []string{"role"})
+)
+
+func init() {
+	logger := zap.L()
+	if err := prometheus.Register(metricsPrunedInstances); err != nil {
+		logger.Debug("could not register prometheus collector")
+	}
+}
+
+// RetentionPolicy is the retention of decided history.
+// An instance is pruned once it is outside any of the configured limits, zero values are unlimited.
+type RetentionPolicy struct {
+	// Epochs is the amount of epochs (counted back from the current slot) to keep
+	Epochs uint64 `yaml:"Epochs"`
+	// Heights is the amount of heights (counted back from the latest instance) to keep
+	Heights uint64 `yaml:"Heights"`
+}
+
+// Enabled returns whether the policy prunes anything
+func (rp RetentionPolicy) Enabled() bool {
+	return rp.Epochs > 0 || rp.Heights > 0
+}
+
+// RetentionConfig is the configuration of decided history pruning
+type RetentionConfig struct {
+	Epochs    uint64                     `yaml:"Epochs" env:"DECIDED_RETENTION_EPOCHS" env-description:"Amount of epochs of decided history to keep, 0 keeps all history"`
+	Heights   uint64                     `yaml:"Heights" env:"DECIDED_RETENTION_HEIGHTS" env-description:"Amount of heights of decided history to keep per validator, 0 keeps all history"`
+	Roles     map[string]RetentionPolicy `yaml:"Roles" env-description:"Retention per role (e.g. ATTESTER), overrides the default retention"`
+	Interval  time.Duration              `yaml:"Interval" env:"DECIDED_PRUNING_INTERVAL" env-default:"10m" env-description:"Interval between pruning cycles of decided history"`
+	BatchSize int                        `yaml:"BatchSize" env:"DECIDED_PRUNING_BATCH_SIZE" env-default:"500" env-description:"Max amount of instances to delete in a single transaction"`
+}
+
+// Policy returns the retention policy of the given role
+func (rc RetentionConfig) Policy(role spectypes.BeaconRole) RetentionPolicy {
+	if policy, ok := rc.Roles[role.String()]; ok {
+		return policy
+	}
+	return RetentionPolicy{Epochs: rc.Epochs, Heights: rc.Heights}
+}
+
+// Pruner removes decided history that is older than the configured retention.
+// Pruned instances are no longer served by the history protocol of this node, which answers with the heights it kept.
+// History requests are sent to several peers, so the pruned heights are served by the peers which keep them.
+type Pruner struct {
+	cfg     RetentionConfig
+	network beaconprotocol.BeaconNetwork
+	stores  *QBFTStores
+}
+
+// NewPruner creates a new pruner
+func NewPruner(cfg RetentionConfig, network beaconprotocol.BeaconNetwork, stores *QBFTStores) *Pruner {
+	if cfg.Interval <= 0 {
+		cfg.Interval = 10 * time.Minute
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 500
+	}
+	return &Pruner{
+		cfg:     cfg,
+		network: network,
+		stores:  stores,
+	}
+}
+
+// Enabled returns whether any of the roles has a retention policy
+func (p *Pruner) Enabled() bool {
+	enabled := false
+	_ = p.stores.Each(func(role spectypes.BeaconRole, _ qbftstorage.QBFTStore) error {
+		enabled = enabled || p.cfg.Policy(role).Enabled()
+		return nil
+	})
+	return enabled
+}
+
+// Start prunes the stores periodically, until the context is done
+func (p *Pruner) Start(ctx context.Context, logger *zap.Logger) {
+	ticker := time.NewTicker(p.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := p.Prune(ctx, logger); err != nil && ctx.Err() == nil {
+			logger.Warn("could not prune decided history", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Prune removes the instances that are outside the retention of their role
+func (p *Pruner) Prune(ctx context.Context, logger *zap.Logger) (int, error) {
+	currentSlot := p.network.EstimatedCurrentSlot()
+	total := 0
+	err := p.stores.Each(func(role spectypes.BeaconRole, store qbftstorage.QBFTStore) error {
+		policy := p.cfg.Policy(role)
+		if !policy.Enabled() {
+			return nil
+		}
+		start := time.Now()
+		pruned, err := store.PruneInstances(ctx, p.minHeight(policy, currentSlot), p.cfg.BatchSize)
+		total += pruned
+		metricsPrunedInstances.WithLabelValues(role.String()).Add(float64(pruned))
+		if err != nil {
+			return err
+		}
+		if pruned > 0 {
+			logger.Debug("pruned decided history",
+				zap.String("role", role.String()),
+				zap.Int("pruned", pruned),
+				zap.Duration("took", time.Since(start)))
+		}
+		return nil
+	})
+	return total, err
+}
+
+// minHeight returns the lowest height to keep for the given policy.
+// the latest instance of each identifier is always kept.
+func (p *Pruner) minHeight(policy RetentionPolicy, currentSlot phase0.Slot) func([]byte, specqbft.Height) specqbft.Height {
+	var slotCutoff specqbft.Height
+	if policy.Epochs > 0 {
+		keep := phase0.Slot(policy.Epochs * p.network.SlotsPerEpoch())
+		if currentSlot > keep {
+			slotCutoff = specqbft.Height(currentSlot - keep)
+		}
+	}
+	return func(_ []byte, latest specqbft.Height) specqbft.Height {
+		min := slotCutoff
+		if policy.Heights > 0 && uint64(latest) >= policy.Heights {
+			if heightCutoff := latest - specqbft.Height(policy.Heights) + 1; heightCutoff > min {
+				min = heightCutoff
+			}
+		}
+		if min > latest {
+			min = latest
+		}
+		return min
+	}
+}
@@ -0,0 +1,111 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	specqbft "github.com/bloxapp/ssv-spec/qbft"
+	spectypes "github.com/bloxapp/ssv-spec/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bloxapp/ssv/logging"
+	"github.com/bloxapp/ssv/networkconfig"
+	qbftstorage "github.com/bloxapp/ssv/protocol/v2/qbft/storage"
+	"github.com/bloxapp/ssv/protocol/v2/types"
+	"github.com/bloxapp/ssv/storage/basedb"
+	"github.com/bloxapp/ssv/storage/kv"
+)
+
+func TestPruner(t *testing.T) {
+	logger := logging.TestLogger(t)
+	ctx := context.Background()
+	network := networkconfig.TestNetwork.Beacon
+	currentSlot := specqbft.Height(network.EstimatedCurrentSlot())
+	slotsPerEpoch := specqbft.Height(network.SlotsPerEpoch())
+
+	newInstance := func(id spectypes.MessageID, h specqbft.Height) *qbftstorage.StoredInstance {
+		return &qbftstorage.StoredInstance{
+			State: &specqbft.State{ID: id[:], Height: h, Decided: true},
+			DecidedMessage: &specqbft.SignedMessage{
+				Signature: []byte("sig"),
+				Signers:   []spectypes.OperatorID{1},
+				Message:   specqbft.Message{MsgType: specqbft.CommitMsgType, Height: h, Identifier: id[:]},
+			},
+		}
+	}
+
+	db, err := kv.NewInMemory(logger, basedb.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	stores := NewStoresFromRoles(db, spectypes.BNRoleAttester, spectypes.BNRoleSyncCommittee, spectypes.BNRoleSyncCommitteeContribution)
+	attesterID := spectypes.NewMsgID(types.GetDefaultDomain(), []byte("pk"), spectypes.BNRoleAttester)
+	syncCommitteeID := spectypes.NewMsgID(types.GetDefaultDomain(), []byte("pk"), spectypes.BNRoleSyncCommittee)
+	contributionID := spectypes.NewMsgID(types.GetDefaultDomain(), []byte("pk"), spectypes.BNRoleSyncCommitteeContribution)
+
+	// 10 epochs of attestations, and 100 heights of sync committee messages and contributions
+	for epoch := specqbft.Height(0); epoch < 10; epoch++ {
+		require.NoError(t, stores.Get(spectypes.BNRoleAttester).SaveInstance(newInstance(attesterID, currentSlot-epoch*slotsPerEpoch)))
+	}
+	require.NoError(t, stores.Get(spectypes.BNRoleAttester).SaveHighestInstance(newInstance(attesterID, currentSlot)))
+	for h := specqbft.Height(1); h <= 100; h++ {
+		require.NoError(t, stores.Get(spectypes.BNRoleSyncCommittee).SaveHighestAndHistoricalInstance(newInstance(syncCommitteeID, h)))
+		require.NoError(t, stores.Get(spectypes.BNRoleSyncCommitteeContribution).SaveHighestAndHistoricalInstance(newInstance(contributionID, h)))
+	}
+
+	count := func(role spectypes.BeaconRole, id spectypes.MessageID) int {
+		from, to := specqbft.Height(0), specqbft.Height(100)
+		if role == spectypes.BNRoleAttester {
+			from, to = currentSlot-10*slotsPerEpoch, currentSlot
+		}
+		instances, err := stores.Get(role).GetInstancesInRange(id[:], from, to)
+		require.NoError(t, err)
+		return len(instances)
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		pruner := NewPruner(RetentionConfig{}, network, stores)
+		require.False(t, pruner.Enabled())
+	})
+
+	t.Run("by epochs", func(t *testing.T) {
+		pruner := NewPruner(RetentionConfig{
+			Roles: map[string]RetentionPolicy{spectypes.BNRoleAttester.String(): {Epochs: 5}},
+		}, network, stores)
+		require.True(t, pruner.Enabled())
+
+		pruned, err := pruner.Prune(ctx, logger)
+		require.NoError(t, err)
+		require.Equal(t, 4, pruned)
+		require.Equal(t, 6, count(spectypes.BNRoleAttester, attesterID))
+		require.Equal(t, 100, count(spectypes.BNRoleSyncCommittee, syncCommitteeID))
+	})
+
+	t.Run("by heights", func(t *testing.T) {
+		pruner := NewPruner(RetentionConfig{
+			Heights:   30,
+			BatchSize: 7,
+			Roles:     map[string]RetentionPolicy{spectypes.BNRoleAttester.String(): {}},
+		}, network, stores)
+
+		pruned, err := pruner.Prune(ctx, logger)
+		require.NoError(t, err)
+		require.Equal(t, 140, pruned)
+		require.Equal(t, 30, count(spectypes.BNRoleSyncCommittee, syncCommitteeID))
+		require.Equal(t, 30, count(spectypes.BNRoleSyncCommitteeContribution, contributionID))
+		require.Equal(t, 6, count(spectypes.BNRoleAttester, attesterID))
+
+		highest, err := stores.Get(spectypes.BNRoleSyncCommittee).GetHighestInstance(syncCommitteeID[:])
+		require.NoError(t, err)
+		require.Equal(t, specqbft.Height(100), highest.State.Height)
+	})
+
+	t.Run("keeps latest instance", func(t *testing.T) {
+		pruner := NewPruner(RetentionConfig{Epochs: 1, Heights: 1}, network, stores)
+
+		_, err := pruner.Prune(ctx, logger)
+		require.NoError(t, err)
+		require.Equal(t, 1, count(spectypes.BNRoleAttester, attesterID))
+		require.Equal(t, 1, count(spectypes.BNRoleSyncCommittee, syncCommitteeID))
+	})
+}
@@ -1,9 +1,12 @@
 package storage
 
 import (
+	"bytes"
+	"context"
 	"encoding/binary"
 
 	specqbft "github.com/bloxapp/ssv-spec/qbft"
+	spectypes "github.com/bloxapp/ssv-spec/types"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -150,6 +153,100 @@ func (i *ibftStorage) CleanAllInstances(logger *zap.Logger, msgID []byte) error
 	return nil
 }
 
+// PruneInstances removes the historical instances that are below the height returned by minHeight.
+// Identifiers are discovered by scanning the keys of the store, then the instances of each identifier
+// are deleted in batches of batchSize, so that the store isn't locked for long periods.
+func (i *ibftStorage) PruneInstances(ctx context.Context, minHeight func(identifier []byte, latest specqbft.Height) specqbft.Height, batchSize int) (int, error) {
+	if batchSize <= 0 {
+		return 0, errors.New("batch size must be positive")
+	}
+
+	latest, err := i.latestHeights()
+	if err != nil {
+		return 0, errors.Wrap(err, "could not list instances")
+	}
+
+	pruned := 0
+	for id, height := range latest {
+		identifier := []byte(id)
+		n, err := i.pruneIdentifier(ctx, identifier, minHeight(identifier, height), batchSize)
+		pruned += n
+		if err != nil {
+			return pruned, errors.Wrap(err, "could not prune instances")
+		}
+	}
+	return pruned, nil
+}
+
+// latestHeights returns the height of the latest historical instance of each identifier in the store
+func (i *ibftStorage) latestHeights() (map[string]specqbft.Height, error) {
+	instancePrefix := []byte(instanceKey)
+	keyLen := len(spectypes.MessageID{}) + len(instancePrefix) + 8
+
+	latest := make(map[string]specqbft.Height)
+	err := i.db.ListKeys(i.prefix, func(key []byte) error {
+		if len(key) != keyLen {
+			// keys of overlapping prefixes, e.g. SYNC_COMMITTEE and SYNC_COMMITTEE_CONTRIBUTION
+			return nil
+		}
+		id, rest := key[:len(spectypes.MessageID{})], key[len(spectypes.MessageID{}):]
+		if !bytes.HasPrefix(rest, instancePrefix) {
+			// highest instance
+			return nil
+		}
+		height := specqbft.Height(binary.LittleEndian.Uint64(rest[len(instancePrefix):]))
+		if h, ok := latest[string(id)]; !ok || height > h {
+			latest[string(id)] = height
+		}
+		return nil
+	})
+	return latest, err
+}
+
+// pruneIdentifier removes the historical instances of the given identifier that are below minHeight
+func (i *ibftStorage) pruneIdentifier(ctx context.Context, identifier []byte, minHeight specqbft.Height, batchSize int) (int, error) {
+	prefix := append(append([]byte{}, i.prefix...), identifier...)
+	prefix = append(prefix, []byte(instanceKey)...)
+	// clip the prefix, so that the keys of a batch don't share its backing array
+	prefix = prefix[:len(prefix):len(prefix)]
+
+	var keys [][]byte
+	err := i.db.ListKeys(prefix, func(key []byte) error {
+		if len(key) == 8 && specqbft.Height(binary.LittleEndian.Uint64(key)) < minHeight {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	pruned := 0
+	for len(keys) > 0 {
+		if err := ctx.Err(); err != nil {
+			return pruned, err
+		}
+		batch := keys
+		if len(batch) > batchSize {
+			batch = batch[:batchSize]
+		}
+		err := i.db.Update(func(txn basedb.Txn) error {
+			for _, key := range batch {
+				if err := txn.Delete(prefix, key); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return pruned, err
+		}
+		pruned += len(batch)
+		keys = keys[len(batch):]
+	}
+	return pruned, nil
+}
+
 func (i *ibftStorage) save(value []byte, id string, pk []byte, keyParams ...[]byte) error {
 	prefix := append(i.prefix, pk...)
 	key := i.key(id, keyParams...)
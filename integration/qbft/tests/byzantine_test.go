@@ -0,0 +1,176 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/bloxapp/ssv-spec/qbft"
+	spectypes "github.com/bloxapp/ssv-spec/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bloxapp/ssv/protocol/v2/qbft/roundtimer"
+	protocolstorage "github.com/bloxapp/ssv/protocol/v2/qbft/storage"
+)
+
+func TestEquivocatingProposer4CommitteeScenario(t *testing.T) {
+	// operator 1 is the proposer of the first round (slot 16 % 4 == 0), it sends a conflicting proposal to operators 2 and 4
+	// so that no value can reach a prepare quorum, and the committee has to decide in a later round.
+	equivocation := &Scenario{
+		Committee: 4,
+		Duties:    allDuties(4, 16),
+		Faults: Faults{
+			Byzantine: map[spectypes.OperatorID]ByzantineBehavior{
+				1: {EquivocateTo: []spectypes.OperatorID{2, 4}},
+			},
+		},
+		ValidationFunctions: map[spectypes.OperatorID]func(*testing.T, int, *protocolstorage.StoredInstance){
+			2: decidedValidator(16, qbft.Round(2)),
+			3: decidedValidator(16, qbft.Round(2)),
+			4: decidedValidator(16, qbft.Round(2)),
+		},
+	}
+
+	equivocation.Run(t, spectypes.BNRoleAttester)
+	equivocation.Run(t, spectypes.BNRoleSyncCommittee)
+}
+
+func TestInvalidSignatures4CommitteeScenario(t *testing.T) {
+	invalidSignatures := &Scenario{
+		Committee: 4,
+		Duties:    allDuties(4, 20),
+		Faults: Faults{
+			Byzantine: map[spectypes.OperatorID]ByzantineBehavior{
+				4: {InvalidSignature: true},
+			},
+		},
+		ValidationFunctions: map[spectypes.OperatorID]func(*testing.T, int, *protocolstorage.StoredInstance){
+			1: decidedValidator(20, qbft.FirstRound),
+			2: decidedValidator(20, qbft.FirstRound),
+			3: decidedValidator(20, qbft.FirstRound),
+		},
+	}
+
+	invalidSignatures.Run(t, spectypes.BNRoleAttester)
+	invalidSignatures.Run(t, spectypes.BNRoleSyncCommittee)
+}
+
+func TestReplayOldHeight4CommitteeScenario(t *testing.T) {
+	replay := &Scenario{
+		Committee: 4,
+		Duties:    allDuties(4, 24),
+		Faults: Faults{
+			Byzantine: map[spectypes.OperatorID]ByzantineBehavior{
+				3: {ReplayOldHeight: true},
+			},
+		},
+		ValidationFunctions: map[spectypes.OperatorID]func(*testing.T, int, *protocolstorage.StoredInstance){
+			1: decidedValidator(24, qbft.FirstRound),
+			2: decidedValidator(24, qbft.FirstRound),
+			3: decidedValidator(24, qbft.FirstRound),
+			4: decidedValidator(24, qbft.FirstRound),
+		},
+	}
+
+	replay.Run(t, spectypes.BNRoleAttester)
+	replay.Run(t, spectypes.BNRoleSyncCommittee)
+}
+
+func TestDelayedAndDroppedLinks7CommitteeScenario(t *testing.T) {
+	links := &Scenario{
+		Committee: 7,
+		Duties:    allDuties(7, 28),
+		Faults: Faults{
+			Drops: map[Link]bool{
+				{From: 1, To: 2}: true,
+				{From: 3, To: 4}: true,
+				{From: 7, To: 5}: true,
+			},
+			Delays: map[Link]time.Duration{
+				{From: 6, To: 1}: 300 * time.Millisecond,
+				{From: 2, To: 3}: 500 * time.Millisecond,
+			},
+		},
+		ValidationFunctions: map[spectypes.OperatorID]func(*testing.T, int, *protocolstorage.StoredInstance){
+			1: decidedValidator(28, qbft.FirstRound),
+			2: decidedValidator(28, qbft.FirstRound),
+			3: decidedValidator(28, qbft.FirstRound),
+			4: decidedValidator(28, qbft.FirstRound),
+			5: decidedValidator(28, qbft.FirstRound),
+			6: decidedValidator(28, qbft.FirstRound),
+			7: decidedValidator(28, qbft.FirstRound),
+		},
+	}
+
+	links.Run(t, spectypes.BNRoleAttester)
+	links.Run(t, spectypes.BNRoleSyncCommittee)
+}
+
+func TestHealingPartition4CommitteeScenario(t *testing.T) {
+	// no side of the partition has a quorum, so the committee can only decide once it heals
+	partition := &Scenario{
+		Committee: 4,
+		Duties:    allDuties(4, 32),
+		Faults: Faults{
+			Partitions: []Partition{
+				{
+					Groups: [][]spectypes.OperatorID{{1, 2}, {3, 4}},
+					Start:  0,
+					Heal:   roundtimer.RoundTimeout(qbft.FirstRound) + time.Second,
+				},
+			},
+		},
+		ValidationFunctions: map[spectypes.OperatorID]func(*testing.T, int, *protocolstorage.StoredInstance){
+			1: decidedValidator(32, qbft.Round(2)),
+			2: decidedValidator(32, qbft.Round(2)),
+			3: decidedValidator(32, qbft.Round(2)),
+			4: decidedValidator(32, qbft.Round(2)),
+		},
+	}
+
+	partition.Run(t, spectypes.BNRoleAttester)
+	partition.Run(t, spectypes.BNRoleSyncCommittee)
+}
+
+func TestCrashRestart4CommitteeScenario(t *testing.T) {
+	// operator 4 crashes upon its first prepare message, before it could decide, while the rest of the committee
+	// still has a quorum. once restarted, it has to recover the decided value from its peers,
+	// and validateSafety checks that it's the value the rest of the committee decided.
+	crash := &Scenario{
+		Committee: 4,
+		Duties:    allDuties(4, 36),
+		Crashes: map[spectypes.OperatorID]CrashProperties{
+			4: {OnMessage: qbft.PrepareMsgType, Downtime: time.Second},
+		},
+		ValidationFunctions: map[spectypes.OperatorID]func(*testing.T, int, *protocolstorage.StoredInstance){
+			1: decidedValidator(36, qbft.FirstRound),
+			2: decidedValidator(36, qbft.FirstRound),
+			3: decidedValidator(36, qbft.FirstRound),
+			4: decidedValidator(36, qbft.FirstRound),
+		},
+	}
+
+	crash.Run(t, spectypes.BNRoleAttester)
+	crash.Run(t, spectypes.BNRoleSyncCommittee)
+}
+
+// allDuties returns duties without delay for every operator of the committee.
+// Every scenario uses its own slot, otherwise pubsub drops its messages as duplicates of a previous scenario.
+func allDuties(committee int, slot phase0.Slot) map[spectypes.OperatorID]DutyProperties {
+	duties := map[spectypes.OperatorID]DutyProperties{}
+	for id := 1; id <= committee; id++ {
+		duties[spectypes.OperatorID(id)] = DutyProperties{Slot: slot, ValidatorIndex: 1, Delay: NoDelay}
+	}
+	return duties
+}
+
+// decidedValidator validates that the instance of the given slot was decided with a quorum, in minRound or later.
+func decidedValidator(slot phase0.Slot, minRound qbft.Round) func(t *testing.T, committee int, actual *protocolstorage.StoredInstance) {
+	return func(t *testing.T, committee int, actual *protocolstorage.StoredInstance) {
+		require.EqualValues(t, slot, actual.State.Height, "height not matching")
+		require.GreaterOrEqual(t, int(actual.State.Round), int(minRound), "decided in an earlier round than expected")
+
+		require.NotNil(t, actual.DecidedMessage, "no decided message")
+		require.Greater(t, len(actual.DecidedMessage.Signers), quorum(committee)-1, "no commit qourum")
+	}
+}
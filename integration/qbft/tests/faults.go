@@ -0,0 +1,345 @@
+package tests
+
+import (
+	"sync"
+	"time"
+
+	specqbft "github.com/bloxapp/ssv-spec/qbft"
+	spectypes "github.com/bloxapp/ssv-spec/types"
+	spectestingutils "github.com/bloxapp/ssv-spec/types/testingutils"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// Link is a directed link between two operators, from the signer of a message to its receiver.
+type Link struct {
+	From spectypes.OperatorID
+	To   spectypes.OperatorID
+}
+
+// Partition splits the committee into groups which can't reach each other between Start and Heal,
+// both relative to the beginning of the scenario. Operators that are not part of any group are not affected.
+type Partition struct {
+	Groups [][]spectypes.OperatorID
+	Start  time.Duration
+	Heal   time.Duration
+}
+
+// ByzantineBehavior describes how a faulty operator misbehaves towards the rest of the committee.
+type ByzantineBehavior struct {
+	// EquivocateTo lists the operators that receive a conflicting (but valid) proposal.
+	EquivocateTo []spectypes.OperatorID
+	// InvalidSignature corrupts the signature of every consensus message.
+	InvalidSignature bool
+	// ReplayOldHeight sends a copy of every consensus message for the previous height.
+	ReplayOldHeight bool
+}
+
+// CrashProperties describes an operator that crashes upon receiving its first consensus message of type OnMessage,
+// and is restarted after Downtime. A commit message triggers the crash as well if it arrives first,
+// so that the operator always crashes before it could decide.
+type CrashProperties struct {
+	OnMessage specqbft.MessageType
+	Downtime  time.Duration
+}
+
+// Faults holds the faults injected by the msg router during a scenario.
+type Faults struct {
+	Byzantine  map[spectypes.OperatorID]ByzantineBehavior
+	Delays     map[Link]time.Duration
+	Drops      map[Link]bool
+	Partitions []Partition
+}
+
+// faultInjector applies Faults to the messages routed to each operator.
+type faultInjector struct {
+	faults Faults
+	keySet *spectestingutils.TestKeySet
+	start  time.Time
+
+	mtx           sync.RWMutex
+	crashed       map[spectypes.OperatorID]bool
+	crashTriggers map[spectypes.OperatorID]crashTrigger
+}
+
+type crashTrigger struct {
+	msgType specqbft.MessageType
+	crashed chan struct{}
+}
+
+func newFaultInjector(faults Faults, keySet *spectestingutils.TestKeySet) *faultInjector {
+	return &faultInjector{
+		faults:        faults,
+		keySet:        keySet,
+		start:         time.Now(),
+		crashed:       map[spectypes.OperatorID]bool{},
+		crashTriggers: map[spectypes.OperatorID]crashTrigger{},
+	}
+}
+
+// crashOn crashes the given operator once the message described by the given crash is routed to it,
+// the returned channel is closed when it crashed.
+func (fi *faultInjector) crashOn(id spectypes.OperatorID, crash CrashProperties) <-chan struct{} {
+	fi.mtx.Lock()
+	defer fi.mtx.Unlock()
+
+	trigger := crashTrigger{msgType: crash.OnMessage, crashed: make(chan struct{})}
+	fi.crashTriggers[id] = trigger
+	return trigger.crashed
+}
+
+// triggerCrash crashes the receiver if the given message triggers its crash, and returns true if it did.
+func (fi *faultInjector) triggerCrash(to spectypes.OperatorID, signedMsg *specqbft.SignedMessage) bool {
+	fi.mtx.Lock()
+	defer fi.mtx.Unlock()
+
+	trigger, ok := fi.crashTriggers[to]
+	if !ok || (signedMsg.Message.MsgType != trigger.msgType && signedMsg.Message.MsgType != specqbft.CommitMsgType) {
+		return false
+	}
+	delete(fi.crashTriggers, to)
+	fi.crashed[to] = true
+	close(trigger.crashed)
+	return true
+}
+
+// setCrashed marks the given operator as crashed (or restarted), messages to a crashed operator are lost.
+func (fi *faultInjector) setCrashed(id spectypes.OperatorID, crashed bool) {
+	fi.mtx.Lock()
+	defer fi.mtx.Unlock()
+
+	fi.crashed[id] = crashed
+}
+
+func (fi *faultInjector) isCrashed(id spectypes.OperatorID) bool {
+	fi.mtx.RLock()
+	defer fi.mtx.RUnlock()
+
+	return fi.crashed[id]
+}
+
+// inject applies the faults on the given message, which is routed to operator `to`,
+// and calls deliver for every message that should eventually reach it.
+func (fi *faultInjector) inject(logger *zap.Logger, to spectypes.OperatorID, msg *spectypes.SSVMessage, deliver func(msg *spectypes.SSVMessage)) {
+	if fi.isCrashed(to) {
+		return
+	}
+
+	signers, signedMsg := messageSigners(msg)
+	if signedMsg != nil && fi.triggerCrash(to, signedMsg) {
+		return
+	}
+	if len(signers) == 0 {
+		deliver(msg)
+		return
+	}
+
+	if !fi.reachable(signers, to) {
+		return
+	}
+
+	msgs := []*spectypes.SSVMessage{msg}
+	if signedMsg != nil && len(signers) == 1 {
+		if behavior, ok := fi.faults.Byzantine[signers[0]]; ok && signers[0] != to {
+			var err error
+			msgs, err = fi.misbehave(behavior, to, msg.MsgID, signedMsg)
+			if err != nil {
+				logger.Warn("could not inject byzantine behavior", zap.Error(err))
+				msgs = []*spectypes.SSVMessage{msg}
+			}
+		}
+	}
+
+	delay := fi.delay(signers, to)
+	if delay == 0 {
+		for _, m := range msgs {
+			deliver(m)
+		}
+		return
+	}
+	time.AfterFunc(delay, func() {
+		if fi.isCrashed(to) {
+			return
+		}
+		for _, m := range msgs {
+			deliver(m)
+		}
+	})
+}
+
+// reachable returns true if at least one of the signers can reach the receiver.
+// Aggregated messages could have been relayed by any of their signers.
+func (fi *faultInjector) reachable(signers []spectypes.OperatorID, to spectypes.OperatorID) bool {
+	for _, from := range signers {
+		if from == to {
+			return true
+		}
+		if fi.faults.Drops[Link{From: from, To: to}] {
+			continue
+		}
+		if fi.partitioned(from, to) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+func (fi *faultInjector) partitioned(from, to spectypes.OperatorID) bool {
+	elapsed := time.Since(fi.start)
+	for _, p := range fi.faults.Partitions {
+		if elapsed < p.Start || elapsed >= p.Heal {
+			continue
+		}
+		fromGroup, toGroup := groupOf(p.Groups, from), groupOf(p.Groups, to)
+		if fromGroup != -1 && toGroup != -1 && fromGroup != toGroup {
+			return true
+		}
+	}
+	return false
+}
+
+// delay returns the shortest delay of the links between the signers and the receiver.
+func (fi *faultInjector) delay(signers []spectypes.OperatorID, to spectypes.OperatorID) time.Duration {
+	var delay time.Duration
+	for i, from := range signers {
+		d := fi.faults.Delays[Link{From: from, To: to}]
+		if from == to {
+			d = 0
+		}
+		if i == 0 || d < delay {
+			delay = d
+		}
+	}
+	return delay
+}
+
+// misbehave returns the messages a byzantine operator sends to `to` in place of the given honest message.
+func (fi *faultInjector) misbehave(behavior ByzantineBehavior, to spectypes.OperatorID, msgID spectypes.MessageID, signedMsg *specqbft.SignedMessage) ([]*spectypes.SSVMessage, error) {
+	from := signedMsg.Signers[0]
+	sk := fi.keySet.Shares[from]
+	if sk == nil {
+		return nil, errors.Errorf("missing share key for operator %d", from)
+	}
+
+	msg := signedMsg.Message
+	fullData := signedMsg.FullData
+	if signedMsg.Message.MsgType == specqbft.ProposalMsgType && containsOperator(behavior.EquivocateTo, to) {
+		conflicting, err := conflictingValue(fullData)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create conflicting value")
+		}
+		root, err := specqbft.HashDataRoot(conflicting)
+		if err != nil {
+			return nil, err
+		}
+		fullData = conflicting
+		msg.Root = root
+	}
+
+	if behavior.InvalidSignature {
+		// signing with the validator key instead of the operator's share key produces a well-formed but invalid signature
+		sk = fi.keySet.ValidatorSK
+	}
+
+	sign := func(m specqbft.Message) (*spectypes.SSVMessage, error) {
+		signed := spectestingutils.SignQBFTMsg(sk, from, &m)
+		signed.FullData = fullData
+		data, err := signed.Encode()
+		if err != nil {
+			return nil, err
+		}
+		return &spectypes.SSVMessage{
+			MsgType: spectypes.SSVConsensusMsgType,
+			MsgID:   msgID,
+			Data:    data,
+		}, nil
+	}
+
+	current, err := sign(msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not sign message")
+	}
+	msgs := []*spectypes.SSVMessage{current}
+
+	if behavior.ReplayOldHeight && msg.Height > specqbft.FirstHeight {
+		old := msg
+		old.Height--
+		replayed, err := sign(old)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not sign replayed message")
+		}
+		msgs = append(msgs, replayed)
+	}
+
+	return msgs, nil
+}
+
+// conflictingValue returns a valid consensus data which differs from the given one.
+// Only attester and sync committee duties are supported.
+func conflictingValue(fullData []byte) ([]byte, error) {
+	cd := &spectypes.ConsensusData{}
+	if err := cd.Decode(fullData); err != nil {
+		return nil, err
+	}
+
+	switch cd.Duty.Type {
+	case spectypes.BNRoleAttester:
+		attestationData, err := cd.GetAttestationData()
+		if err != nil {
+			return nil, err
+		}
+		attestationData.BeaconBlockRoot[0] ^= 0xff
+		if cd.DataSSZ, err = attestationData.MarshalSSZ(); err != nil {
+			return nil, err
+		}
+	case spectypes.BNRoleSyncCommittee:
+		dataSSZ := make([]byte, len(cd.DataSSZ))
+		copy(dataSSZ, cd.DataSSZ)
+		dataSSZ[len(dataSSZ)-1] ^= 0xff
+		cd.DataSSZ = dataSSZ
+	default:
+		return nil, errors.Errorf("equivocation is not supported for role %s", cd.Duty.Type.String())
+	}
+
+	return cd.Encode()
+}
+
+// messageSigners returns the signers of a consensus or partial signature message,
+// as well as the decoded consensus message if there is one.
+func messageSigners(msg *spectypes.SSVMessage) ([]spectypes.OperatorID, *specqbft.SignedMessage) {
+	switch msg.MsgType {
+	case spectypes.SSVConsensusMsgType:
+		signedMsg := &specqbft.SignedMessage{}
+		if err := signedMsg.Decode(msg.Data); err != nil {
+			return nil, nil
+		}
+		return signedMsg.Signers, signedMsg
+	case spectypes.SSVPartialSignatureMsgType:
+		signedMsg := &spectypes.SignedPartialSignatureMessage{}
+		if err := signedMsg.Decode(msg.Data); err != nil {
+			return nil, nil
+		}
+		return []spectypes.OperatorID{signedMsg.Signer}, nil
+	default:
+		return nil, nil
+	}
+}
+
+func groupOf(groups [][]spectypes.OperatorID, id spectypes.OperatorID) int {
+	for i, group := range groups {
+		if containsOperator(group, id) {
+			return i
+		}
+	}
+	return -1
+}
+
+func containsOperator(ids []spectypes.OperatorID, id spectypes.OperatorID) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
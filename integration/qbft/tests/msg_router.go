@@ -7,15 +7,25 @@ import (
 )
 
 type msgRouter struct {
-	validator *protocolvalidator.Validator
+	operatorID spectypes.OperatorID
+	validator  *protocolvalidator.Validator
+	faults     *faultInjector
 }
 
 func (m *msgRouter) Route(logger *zap.Logger, message spectypes.SSVMessage) {
-	m.validator.HandleMessage(logger, &message)
+	if m.faults == nil {
+		m.validator.HandleMessage(logger, &message)
+		return
+	}
+	m.faults.inject(logger, m.operatorID, &message, func(msg *spectypes.SSVMessage) {
+		m.validator.HandleMessage(logger, msg)
+	})
 }
 
-func newMsgRouter(v *protocolvalidator.Validator) *msgRouter {
+func newMsgRouter(operatorID spectypes.OperatorID, v *protocolvalidator.Validator, faults *faultInjector) *msgRouter {
 	return &msgRouter{
-		validator: v,
+		operatorID: operatorID,
+		validator:  v,
+		faults:     faults,
 	}
 }
@@ -3,6 +3,7 @@ package tests
 import (
 	"context"
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -23,6 +24,7 @@ import (
 	protocolbeacon "github.com/bloxapp/ssv/protocol/v2/blockchain/beacon"
 	protocolp2p "github.com/bloxapp/ssv/protocol/v2/p2p"
 	protocolstorage "github.com/bloxapp/ssv/protocol/v2/qbft/storage"
+	qbfttesting "github.com/bloxapp/ssv/protocol/v2/qbft/testing"
 	"github.com/bloxapp/ssv/protocol/v2/ssv/queue"
 	protocolvalidator "github.com/bloxapp/ssv/protocol/v2/ssv/validator"
 	"github.com/bloxapp/ssv/protocol/v2/sync/handlers"
@@ -38,13 +40,22 @@ var (
 	KeySet13Committee = spectestingutils.Testing13SharesSet()
 )
 
+const defaultScenarioTimeout = 30 * time.Second
+
 type Scenario struct {
 	Committee           int
 	ExpectedHeight      int
 	Duties              map[spectypes.OperatorID]DutyProperties
 	ValidationFunctions map[spectypes.OperatorID]func(t *testing.T, committee int, actual *protocolstorage.StoredInstance)
-	shared              SharedData
-	validators          map[spectypes.OperatorID]*protocolvalidator.Validator
+	// Faults are injected by the msg router of every operator.
+	Faults Faults
+	// Crashes holds the operators which crash and restart during the scenario.
+	Crashes map[spectypes.OperatorID]CrashProperties
+	// Timeout bounds the time it takes the validated operators to decide (liveness), defaults to defaultScenarioTimeout.
+	Timeout    time.Duration
+	shared     SharedData
+	mtx        sync.Mutex
+	validators map[spectypes.OperatorID]*protocolvalidator.Validator
 }
 
 func (s *Scenario) Run(t *testing.T, role spectypes.BeaconRole) {
@@ -59,52 +70,89 @@ func (s *Scenario) Run(t *testing.T, role spectypes.BeaconRole) {
 
 		logger := logging.TestLogger(t)
 
-		//initiating validators
+		keySet := getKeySet(s.Committee)
+		faults := newFaultInjector(s.Faults, keySet)
+		stores := map[spectypes.OperatorID]*qbftstorage.QBFTStores{}
+
+		//registering sync handlers before starting any validator, so that previous scenarios can't be synced from
 		for id := 1; id <= s.Committee; id++ {
 			id := spectypes.OperatorID(id)
-			s.validators[id] = createValidator(t, ctx, id, getKeySet(s.Committee), logger, s.shared.Nodes[id])
-
-			stores := newStores(logger)
+			stores[id] = newStores(logger)
 			s.shared.Nodes[id].RegisterHandlers(logger, protocolp2p.WithHandler(
 				protocolp2p.LastDecidedProtocol,
-				handlers.LastDecidedHandler(logger.Named(fmt.Sprintf("decided-handler-%d", id)), stores, s.shared.Nodes[id]),
+				handlers.LastDecidedHandler(logger.Named(fmt.Sprintf("decided-handler-%d", id)), stores[id], s.shared.Nodes[id], nil),
 			), protocolp2p.WithHandler(
 				protocolp2p.DecidedHistoryProtocol,
-				handlers.HistoryHandler(logger.Named(fmt.Sprintf("history-handler-%d", id)), stores, s.shared.Nodes[id], 25),
+				handlers.HistoryHandler(logger.Named(fmt.Sprintf("history-handler-%d", id)), stores[id], s.shared.Nodes[id], 25, nil),
 			))
 		}
 
+		//initiating validators
+		for id := 1; id <= s.Committee; id++ {
+			id := spectypes.OperatorID(id)
+			s.validators[id] = createValidator(t, ctx, id, keySet, logger, s.shared.Nodes[id], stores[id], faults)
+		}
+
 		//invoking duties
 		for id, dutyProp := range s.Duties {
 			go func(id spectypes.OperatorID, dutyProp DutyProperties) { //launching goroutine for every validator
 				time.Sleep(dutyProp.Delay)
 
-				duty := createDuty(getKeySet(s.Committee).ValidatorPK.Serialize(), dutyProp.Slot, dutyProp.ValidatorIndex, role)
-				var pk spec.BLSPubKey
-				copy(pk[:], getKeySet(s.Committee).ValidatorPK.Serialize())
-				ssvMsg, err := validator.CreateDutyExecuteMsg(duty, pk, networkconfig.TestNetwork.Domain)
-				require.NoError(t, err)
-				dec, err := queue.DecodeSSVMessage(logger, ssvMsg)
-				require.NoError(t, err)
-
-				s.validators[id].Queues[role].Q.Push(dec)
+				s.startDuty(t, logger, id, dutyProp, role)
 			}(id, dutyProp)
 		}
 
+		identifier := spectypes.NewMsgID(types.GetDefaultDomain(), keySet.ValidatorPK.Serialize(), role)
+
+		//crashing and restarting validators
+		crashes := map[spectypes.OperatorID]<-chan struct{}{}
+		for id, crash := range s.Crashes {
+			crashed := faults.crashOn(id, crash)
+			crashes[id] = crashed
+			go func(id spectypes.OperatorID, crash CrashProperties) {
+				select {
+				case <-ctx.Done():
+					return
+				case <-crashed:
+				}
+				s.validator(id).Stop()
+				logger.Debug("crashed operator", fields.OperatorID(id))
+
+				if !sleepCtx(ctx, crash.Downtime) {
+					return
+				}
+				// the stopped validator drops the messages which are routed to it until it's replaced
+				faults.setCrashed(id, false)
+				s.setValidator(id, createValidator(t, ctx, id, keySet, logger, s.shared.Nodes[id], stores[id], faults))
+				logger.Debug("restarted operator", fields.OperatorID(id))
+
+				if dutyProp, ok := s.Duties[id]; ok {
+					s.startDuty(t, logger, id, dutyProp, role)
+				}
+				s.recoverDecided(ctx, logger, id, stores, role, identifier)
+			}(id, crash)
+		}
+
+		timeout := s.Timeout
+		if timeout == 0 {
+			timeout = defaultScenarioTimeout
+		}
+		deadline := time.Now().Add(timeout)
+
 		//validating state of validator after invoking duties
 		for id, validationFunc := range s.ValidationFunctions {
-			identifier := spectypes.NewMsgID(types.GetDefaultDomain(), getKeySet(s.Committee).ValidatorPK.Serialize(), role)
 			//getting stored state of validator
 			var storedInstance *protocolstorage.StoredInstance
 			for {
 				var err error
-				storedInstance, err = s.validators[id].Storage.Get(spectypes.MessageIDFromBytes(identifier[:]).GetRoleType()).GetHighestInstance(identifier[:])
+				storedInstance, err = stores[id].Get(role).GetHighestInstance(identifier[:])
 				require.NoError(t, err)
 
 				if storedInstance != nil {
 					break
 				}
 
+				require.True(t, time.Now().Before(deadline), "operator %d did not decide within %s", id, timeout)
 				time.Sleep(500 * time.Millisecond) // waiting for duty will be done and storedInstance would be saved
 			}
 
@@ -112,9 +160,22 @@ func (s *Scenario) Run(t *testing.T, role spectypes.BeaconRole) {
 			validationFunc(t, s.Committee, storedInstance)
 		}
 
+		//validating that no conflicting values were decided
+		validateSafety(t, stores, role, identifier)
+
+		//validating that the crashes happened, otherwise the scenario didn't test recovery
+		for id, crashed := range crashes {
+			select {
+			case <-crashed:
+			default:
+				require.Fail(t, "operator did not crash", "operator %d", id)
+			}
+		}
+
 		// teardown
-		for _, val := range s.validators {
-			val.Stop()
+		cancel()
+		for id := 1; id <= s.Committee; id++ {
+			s.validator(spectypes.OperatorID(id)).Stop()
 		}
 
 		// HACK: sleep to wait for function calls to github.com/herumi/bls-eth-go-binary
@@ -132,6 +193,88 @@ func (s *Scenario) Run(t *testing.T, role spectypes.BeaconRole) {
 	})
 }
 
+func (s *Scenario) startDuty(t *testing.T, logger *zap.Logger, id spectypes.OperatorID, dutyProp DutyProperties, role spectypes.BeaconRole) {
+	duty := createDuty(getKeySet(s.Committee).ValidatorPK.Serialize(), dutyProp.Slot, dutyProp.ValidatorIndex, role)
+	var pk spec.BLSPubKey
+	copy(pk[:], getKeySet(s.Committee).ValidatorPK.Serialize())
+	ssvMsg, err := validator.CreateDutyExecuteMsg(duty, pk, networkconfig.TestNetwork.Domain)
+	require.NoError(t, err)
+	dec, err := queue.DecodeSSVMessage(logger, ssvMsg)
+	require.NoError(t, err)
+
+	s.validator(id).Queues[role].Q.Push(dec)
+}
+
+func (s *Scenario) validator(id spectypes.OperatorID) *protocolvalidator.Validator {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.validators[id]
+}
+
+func (s *Scenario) setValidator(id spectypes.OperatorID, v *protocolvalidator.Validator) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.validators[id] = v
+}
+
+// recoverDecided syncs the decided value to the given restarted operator, once a quorum of the committee decided.
+// The sync on startup may happen before the rest of the committee decided, in which case the restarted operator
+// would depend on round changes to recover, so the sync is repeated until the operator has the decided value.
+func (s *Scenario) recoverDecided(ctx context.Context, logger *zap.Logger, id spectypes.OperatorID, stores map[spectypes.OperatorID]*qbftstorage.QBFTStores, role spectypes.BeaconRole, identifier spectypes.MessageID) {
+	decided := func(id spectypes.OperatorID) bool {
+		storedInstance, err := stores[id].Get(role).GetHighestInstance(identifier[:])
+		return err == nil && storedInstance != nil && storedInstance.DecidedMessage != nil
+	}
+	for !decided(id) {
+		decidedBy := 0
+		for other := range stores {
+			if other != id && decided(other) {
+				decidedBy++
+			}
+		}
+		if decidedBy >= quorum(s.Committee) {
+			if err := s.validator(id).Resync(logger); err != nil {
+				logger.Debug("could not resync restarted operator", fields.OperatorID(id), zap.Error(err))
+			}
+		}
+		if !sleepCtx(ctx, time.Second) {
+			return
+		}
+	}
+}
+
+// validateSafety checks that all operators which decided, including faulty ones, decided on the same value.
+func validateSafety(t *testing.T, stores map[spectypes.OperatorID]*qbftstorage.QBFTStores, role spectypes.BeaconRole, identifier spectypes.MessageID) {
+	var decidedBy spectypes.OperatorID
+	var decidedRoot [32]byte
+	for id, store := range stores {
+		storedInstance, err := store.Get(role).GetHighestInstance(identifier[:])
+		require.NoError(t, err)
+		if storedInstance == nil || storedInstance.DecidedMessage == nil {
+			continue
+		}
+
+		root := storedInstance.DecidedMessage.Message.Root
+		if decidedBy == 0 {
+			decidedBy, decidedRoot = id, root
+			continue
+		}
+		require.Equal(t, decidedRoot, root, "operators %d and %d decided on conflicting values", decidedBy, id)
+	}
+}
+
+// sleepCtx sleeps for the given duration, returns false if the context was canceled before.
+func sleepCtx(ctx context.Context, d time.Duration) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(d):
+		return true
+	}
+}
+
 // getKeySet returns the keyset for a given committee size. Some tests have a
 // committee size smaller than 3f+1 in order to simulate cases where operators are offline
 func getKeySet(committee int) *spectestingutils.TestKeySet {
@@ -189,7 +332,7 @@ func newStores(logger *zap.Logger) *qbftstorage.QBFTStores {
 	return storageMap
 }
 
-func createValidator(t *testing.T, pCtx context.Context, id spectypes.OperatorID, keySet *spectestingutils.TestKeySet, pLogger *zap.Logger, node network.P2PNetwork) *protocolvalidator.Validator {
+func createValidator(t *testing.T, pCtx context.Context, id spectypes.OperatorID, keySet *spectestingutils.TestKeySet, pLogger *zap.Logger, node network.P2PNetwork, stores *qbftstorage.QBFTStores, faults *faultInjector) *protocolvalidator.Validator {
 	ctx, cancel := context.WithCancel(pCtx)
 	validatorPubKey := keySet.Shares[id].GetPublicKey().Serialize()
 
@@ -200,7 +343,7 @@ func createValidator(t *testing.T, pCtx context.Context, id spectypes.OperatorID
 	require.NoError(t, err)
 
 	options := protocolvalidator.Options{
-		Storage: newStores(logger),
+		Storage: stores,
 		Network: node,
 		SSVShare: &types.SSVShare{
 			Share: *testingShare(keySet, id),
@@ -212,13 +355,14 @@ func createValidator(t *testing.T, pCtx context.Context, id spectypes.OperatorID
 				Liquidated:   false,
 			},
 		},
-		Beacon: spectestingutils.NewTestingBeaconNode(),
-		Signer: km,
+		Beacon:            spectestingutils.NewTestingBeaconNode(),
+		Signer:            km,
+		SignatureVerifier: qbfttesting.TestingSignatureVerifier(),
 	}
 
 	options.DutyRunners = validator.SetupRunners(ctx, logger, options)
 	val := protocolvalidator.NewValidator(ctx, cancel, options)
-	node.UseMessageRouter(newMsgRouter(val))
+	node.UseMessageRouter(newMsgRouter(id, val, faults))
 	started, err := val.Start(logger)
 	require.NoError(t, err)
 	require.True(t, started)
@@ -13,6 +13,24 @@ import (
 	"go.uber.org/zap/zapcore"
 )
 
+// level is the level of the console logs, it can be changed at runtime with SetLevel.
+var level = zap.NewAtomicLevel()
+
+// Level returns the current level of the console logs.
+func Level() zapcore.Level {
+	return level.Level()
+}
+
+// SetLevel changes the level of the console logs at runtime.
+func SetLevel(levelName string) error {
+	lvl, err := parseConfigLevel(levelName)
+	if err != nil {
+		return err
+	}
+	level.SetLevel(lvl)
+	return nil
+}
+
 // TODO: Log rotation out of the app
 func getFileWriter(logFileName string) io.Writer {
 	fileLogger := &lumberjack.Logger{
@@ -44,20 +62,15 @@ func parseConfigLevelEncoder(levelEncoderName string) zapcore.LevelEncoder {
 }
 
 func SetGlobalLogger(levelName string, levelEncoderName string, logFormat string, logFilePath string) error {
-	level, err := parseConfigLevel(levelName)
-	if err != nil {
+	if err := SetLevel(levelName); err != nil {
 		return err
 	}
 
 	levelEncoder := parseConfigLevelEncoder(levelEncoderName)
 
-	lv := zap.LevelEnablerFunc(func(lvl zapcore.Level) bool {
-		return lvl >= level
-	})
-
 	cfg := zap.Config{
 		Encoding:    logFormat,
-		Level:       zap.NewAtomicLevelAt(level),
+		Level:       level,
 		OutputPaths: []string{"stdout"},
 		EncoderConfig: zapcore.EncoderConfig{
 			MessageKey:  "message",
@@ -75,7 +88,7 @@ func SetGlobalLogger(levelName string, levelEncoderName string, logFormat string
 		},
 	}
 
-	consoleCore := zapcore.NewCore(zapcore.NewConsoleEncoder(cfg.EncoderConfig), os.Stdout, lv)
+	consoleCore := zapcore.NewCore(zapcore.NewConsoleEncoder(cfg.EncoderConfig), os.Stdout, level)
 
 	if logFilePath == "" {
 		zap.ReplaceGlobals(zap.New(consoleCore))
@@ -1,15 +1,20 @@
 package logging
 
 const (
+	NameAdminAPI         = "AdminAPI"
 	NameBootNode         = "BootNode"
 	NameController       = "Controller"
 	NameDiscoveryService = "DiscoveryService"
 	NameDutyScheduler    = "DutyScheduler"
+	NameEffectiveness    = "Effectiveness"
 	NameEthClient        = "EthClient"
 	NameMetricsHandler   = "MetricsHandler"
+	NameNotifier         = "Notifier"
 	NameOperator         = "Operator"
 	NameP2PNetwork       = "P2PNetwork"
+	NameShadow           = "Shadow"
 	NameSignerStorage    = "SignerStorage"
+	NameTracing          = "Tracing"
 	NameValidator        = "Validator"
 	NameWSServer         = "WSServer"
 	NameConnHandler      = "ConnHandler"
@@ -0,0 +1,26 @@
+package effectiveness
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	metricsAttestations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ssv_validator_attestations",
+		Help: "Attestation duties by whether they were included on-chain (included or missed)",
+	}, []string{"result"})
+	metricsAttestationVotes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ssv_validator_attestation_votes",
+		Help: "Votes of included attestations (source, target or head) by whether they were correct",
+	}, []string{"vote", "correct"})
+	metricsInclusionDistance = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "ssv_validator_attestation_inclusion_distance",
+		Help:    "Slots between attestations and the blocks which included them",
+		Buckets: []float64{1, 2, 3, 4, 8, 16, 32},
+	})
+	metricsSyncCommitteeBits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ssv_validator_sync_committee_bits",
+		Help: "Sync committee participation bits of validators in blocks (participated or missed)",
+	}, []string{"result"})
+)
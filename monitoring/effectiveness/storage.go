@@ -0,0 +1,147 @@
+package effectiveness
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"sync"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+
+	"github.com/bloxapp/ssv/storage/basedb"
+)
+
+var recordsPrefix = []byte("effectiveness")
+
+// Record is the on-chain effectiveness of a validator's duties in an epoch.
+type Record struct {
+	ValidatorIndex phase0.ValidatorIndex `json:"validator_index"`
+	Epoch          phase0.Epoch          `json:"epoch"`
+	Attestation    *Attestation          `json:"attestation,omitempty"`
+	SyncCommittee  *SyncCommittee        `json:"sync_committee,omitempty"`
+}
+
+// Attestation is the inclusion of an attestation and the correctness of its votes.
+// The votes of attestations which weren't included are considered incorrect.
+type Attestation struct {
+	Slot              phase0.Slot `json:"slot"`
+	Included          bool        `json:"included"`
+	InclusionSlot     phase0.Slot `json:"inclusion_slot,omitempty"`
+	InclusionDistance uint64      `json:"inclusion_distance,omitempty"`
+	SourceCorrect     bool        `json:"source_correct"`
+	TargetCorrect     bool        `json:"target_correct"`
+	HeadCorrect       bool        `json:"head_correct"`
+}
+
+// SyncCommittee counts the participation bits of a validator in the sync aggregates of the epoch's blocks.
+type SyncCommittee struct {
+	Expected     uint64 `json:"expected"`
+	Participated uint64 `json:"participated"`
+}
+
+// Storage stores the records of each validator by epoch.
+type Storage struct {
+	db basedb.Database
+	mu sync.Mutex
+}
+
+// NewStorage creates a new Storage.
+func NewStorage(db basedb.Database) *Storage {
+	return &Storage{db: db}
+}
+
+// Update applies the given function to the record of the validator in the epoch, creating it if needed.
+func (s *Storage) Update(index phase0.ValidatorIndex, epoch phase0.Epoch, update func(*Record)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := recordKey(index, epoch)
+	record := &Record{ValidatorIndex: index, Epoch: epoch}
+	obj, found, err := s.db.Get(recordsPrefix, key)
+	if err != nil {
+		return errors.Wrap(err, "could not get record")
+	}
+	if found {
+		if err := json.Unmarshal(obj.Value, record); err != nil {
+			return errors.Wrap(err, "could not unmarshal record")
+		}
+	}
+
+	update(record)
+
+	value, err := json.Marshal(record)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal record")
+	}
+	return s.db.Set(recordsPrefix, key, value)
+}
+
+// MaxRecordsIndices is the maximum amount of validators whose records can be read at once.
+const MaxRecordsIndices = 1000
+
+// Records returns the records of the given validators between the given epochs (inclusive),
+// where a zero to epoch has no upper bound. Only the records of the given validators are scanned,
+// so at least one and at most MaxRecordsIndices validators must be given.
+func (s *Storage) Records(indices []phase0.ValidatorIndex, from, to phase0.Epoch) ([]*Record, error) {
+	if len(indices) == 0 {
+		return nil, errors.New("no validator indices")
+	}
+	if len(indices) > MaxRecordsIndices {
+		return nil, errors.Errorf("too many validator indices (%d > %d)", len(indices), MaxRecordsIndices)
+	}
+
+	prefixes := make([][]byte, len(indices))
+	for i, index := range indices {
+		prefixes[i] = append(append([]byte{}, recordsPrefix...), indexKey(index)...)
+	}
+
+	var records []*Record
+	for _, prefix := range prefixes {
+		err := s.db.GetAll(prefix, func(_ int, obj basedb.Obj) error {
+			var record Record
+			if err := json.Unmarshal(obj.Value, &record); err != nil {
+				return errors.Wrap(err, "could not unmarshal record")
+			}
+			if record.Epoch >= from && (to == 0 || record.Epoch <= to) {
+				records = append(records, &record)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return records, nil
+}
+
+// Prune deletes the records of epochs before the given epoch.
+func (s *Storage) Prune(before phase0.Epoch) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var expired [][]byte
+	err := s.db.ListKeys(recordsPrefix, func(key []byte) error {
+		if len(key) == 16 && phase0.Epoch(binary.BigEndian.Uint64(key[8:])) < before {
+			expired = append(expired, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "could not list records")
+	}
+	for _, key := range expired {
+		if err := s.db.Delete(recordsPrefix, key); err != nil {
+			return 0, errors.Wrap(err, "could not delete record")
+		}
+	}
+	return len(expired), nil
+}
+
+// recordKey orders the records by validator and then by epoch.
+func recordKey(index phase0.ValidatorIndex, epoch phase0.Epoch) []byte {
+	return binary.BigEndian.AppendUint64(indexKey(index), uint64(epoch))
+}
+
+func indexKey(index phase0.ValidatorIndex) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(index))
+}
@@ -0,0 +1,329 @@
+// Package effectiveness tracks the inclusion of the attestations and sync committee messages of validators on-chain.
+package effectiveness
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	spectypes "github.com/bloxapp/ssv-spec/types"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/bloxapp/ssv/beacon/blockfollower"
+	"github.com/bloxapp/ssv/logging"
+	"github.com/bloxapp/ssv/logging/fields"
+	beaconprotocol "github.com/bloxapp/ssv/protocol/v2/blockchain/beacon"
+)
+
+// Config configures the tracking of duty effectiveness.
+type Config struct {
+	Enabled         bool   `yaml:"Enabled" env:"EFFECTIVENESS_ENABLED" env-default:"false" env-description:"Track the on-chain inclusion of attestations and sync committee messages"`
+	RetentionEpochs uint64 `yaml:"RetentionEpochs" env:"EFFECTIVENESS_RETENTION_EPOCHS" env-default:"1575" env-description:"Epochs to keep effectiveness records for (a week by default)"`
+}
+
+// BeaconNode provides the blocks which are read by the Tracker.
+type BeaconNode interface {
+	SignedBeaconBlock(ctx context.Context, blockID string) (*spec.VersionedSignedBeaconBlock, error)
+	BeaconBlockRoot(ctx context.Context, blockID string) (*phase0.Root, error)
+}
+
+type pendingAttestation struct {
+	duty     *spectypes.Duty
+	included bool
+}
+
+// Tracker reads the blocks which follow the attester and sync committee duties of validators,
+// and records whether their attestations and sync committee messages were included.
+type Tracker struct {
+	logger     *zap.Logger
+	beaconNode BeaconNode
+	network    beaconprotocol.BeaconNetwork
+	storage    *Storage
+	retention  phase0.Epoch
+	follower   *blockfollower.Follower
+
+	// mu protects the pending duties, which are added by Track.
+	mu            sync.Mutex
+	attestations  map[phase0.Slot][]*pendingAttestation
+	syncCommittee map[phase0.Slot][]*spectypes.Duty
+
+	// roots are the canonical block roots by slot, empty slots have the root of the previous block.
+	roots map[phase0.Slot]phase0.Root
+}
+
+// NewTracker creates a Tracker which keeps the records of the given amount of epochs.
+func NewTracker(logger *zap.Logger, beaconNode BeaconNode, network beaconprotocol.BeaconNetwork, storage *Storage, retentionEpochs uint64) *Tracker {
+	t := &Tracker{
+		logger:        logger.Named(logging.NameEffectiveness),
+		beaconNode:    beaconNode,
+		network:       network,
+		storage:       storage,
+		retention:     phase0.Epoch(retentionEpochs),
+		attestations:  make(map[phase0.Slot][]*pendingAttestation),
+		syncCommittee: make(map[phase0.Slot][]*spectypes.Duty),
+		roots:         make(map[phase0.Slot]phase0.Root),
+	}
+	t.follower = blockfollower.New(t.logger, beaconNode, network, t.processSlot, t.processed)
+	return t
+}
+
+// Track tracks the inclusion of the given duty, other than attester and sync committee duties are ignored.
+func (t *Tracker) Track(duty *spectypes.Duty) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	switch duty.Type {
+	case spectypes.BNRoleAttester:
+		for _, pending := range t.attestations[duty.Slot] {
+			if pending.duty.ValidatorIndex == duty.ValidatorIndex {
+				return
+			}
+		}
+		t.attestations[duty.Slot] = append(t.attestations[duty.Slot], &pendingAttestation{duty: duty})
+	case spectypes.BNRoleSyncCommittee:
+		for _, pending := range t.syncCommittee[duty.Slot] {
+			if pending.ValidatorIndex == duty.ValidatorIndex {
+				return
+			}
+		}
+		t.syncCommittee[duty.Slot] = append(t.syncCommittee[duty.Slot], duty)
+	}
+}
+
+// Start reads the blocks of each slot once it's blockfollower.ProcessingLag slots old, until the context is done.
+func (t *Tracker) Start(ctx context.Context, ticker blockfollower.SlotTicker) {
+	t.follower.Start(ctx, ticker)
+}
+
+// processed records the duties which can no longer be included as missed,
+// once the blocks until the given slot were read.
+func (t *Tracker) processed(until, lastSlot phase0.Slot) {
+	t.expire(lastSlot)
+
+	slotsPerEpoch := phase0.Slot(t.network.SlotsPerEpoch())
+	for slot := range t.roots {
+		if slot+2*slotsPerEpoch < lastSlot {
+			delete(t.roots, slot)
+		}
+	}
+
+	epoch := t.network.EstimatedEpochAtSlot(until)
+	if t.retention > 0 && epoch > t.retention && t.network.IsFirstSlotOfEpoch(until) {
+		if _, err := t.storage.Prune(epoch - t.retention); err != nil {
+			t.logger.Warn("could not prune effectiveness records", zap.Error(err))
+		}
+	}
+}
+
+// processSlot reads the block of the slot, which includes attestations of previous slots
+// and the sync committee messages of the previous slot.
+func (t *Tracker) processSlot(ctx context.Context, slot phase0.Slot, block *spec.VersionedSignedBeaconBlock) error {
+	if block == nil {
+		if root, ok := t.roots[slot-1]; ok {
+			t.roots[slot] = root
+		}
+		t.recordSyncCommittee(slot-1, nil)
+		return nil
+	}
+
+	root, err := block.Root()
+	if err != nil {
+		return errors.Wrap(err, "could not get block root")
+	}
+	t.roots[slot] = root
+
+	attestations, err := block.Attestations()
+	if err != nil {
+		return errors.Wrap(err, "could not get block attestations")
+	}
+	for _, attestation := range attestations {
+		t.recordAttestation(ctx, slot, attestation)
+	}
+
+	t.recordSyncCommittee(slot-1, syncAggregate(block))
+	return nil
+}
+
+// recordAttestation records the inclusion of the tracked attestations which are aggregated in the given one.
+func (t *Tracker) recordAttestation(ctx context.Context, slot phase0.Slot, attestation *phase0.Attestation) {
+	data := attestation.Data
+	if data == nil {
+		return
+	}
+
+	var included []*spectypes.Duty
+	t.mu.Lock()
+	for _, pending := range t.attestations[data.Slot] {
+		if pending.included || pending.duty.CommitteeIndex != data.Index ||
+			pending.duty.ValidatorCommitteeIndex >= attestation.AggregationBits.Len() ||
+			!attestation.AggregationBits.BitAt(pending.duty.ValidatorCommitteeIndex) {
+			continue
+		}
+		pending.included = true
+		included = append(included, pending.duty)
+	}
+	t.mu.Unlock()
+	if len(included) == 0 {
+		return
+	}
+
+	result := Attestation{
+		Slot:              data.Slot,
+		Included:          true,
+		InclusionSlot:     slot,
+		InclusionDistance: uint64(slot - data.Slot),
+		// Blocks only include attestations whose source is the justified checkpoint.
+		SourceCorrect: true,
+	}
+	if data.Target != nil {
+		if root, err := t.canonicalRoot(ctx, t.network.GetEpochFirstSlot(data.Target.Epoch)); err != nil {
+			t.logger.Debug("could not get target root", fields.Slot(data.Slot), zap.Error(err))
+		} else {
+			result.TargetCorrect = root == data.Target.Root
+		}
+	}
+	if root, err := t.canonicalRoot(ctx, data.Slot); err != nil {
+		t.logger.Debug("could not get head root", fields.Slot(data.Slot), zap.Error(err))
+	} else {
+		result.HeadCorrect = root == data.BeaconBlockRoot
+	}
+
+	for _, duty := range included {
+		t.saveAttestation(duty.ValidatorIndex, result)
+	}
+}
+
+// recordSyncCommittee records the participation of the sync committee duties of the slot in the given aggregate,
+// which is nil if the block of the next slot is missing.
+func (t *Tracker) recordSyncCommittee(slot phase0.Slot, aggregate *altair.SyncAggregate) {
+	t.mu.Lock()
+	duties := t.syncCommittee[slot]
+	delete(t.syncCommittee, slot)
+	t.mu.Unlock()
+
+	for _, duty := range duties {
+		result := SyncCommittee{Expected: uint64(len(duty.ValidatorSyncCommitteeIndices))}
+		if aggregate != nil {
+			for _, index := range duty.ValidatorSyncCommitteeIndices {
+				if index < aggregate.SyncCommitteeBits.Len() && aggregate.SyncCommitteeBits.BitAt(index) {
+					result.Participated++
+				}
+			}
+		}
+		metricsSyncCommitteeBits.WithLabelValues("participated").Add(float64(result.Participated))
+		metricsSyncCommitteeBits.WithLabelValues("missed").Add(float64(result.Expected - result.Participated))
+
+		err := t.storage.Update(duty.ValidatorIndex, t.network.EstimatedEpochAtSlot(slot), func(record *Record) {
+			if record.SyncCommittee == nil {
+				record.SyncCommittee = &SyncCommittee{}
+			}
+			record.SyncCommittee.Expected += result.Expected
+			record.SyncCommittee.Participated += result.Participated
+		})
+		if err != nil {
+			t.logger.Warn("could not save sync committee effectiveness", zap.Error(err))
+		}
+	}
+}
+
+// expire stops tracking the duties which can no longer be included after the given slot,
+// and records those which weren't included as missed.
+func (t *Tracker) expire(slot phase0.Slot) {
+	inclusionRange := phase0.Slot(t.network.SlotsPerEpoch())
+
+	var missed []*spectypes.Duty
+	var syncSlots []phase0.Slot
+	t.mu.Lock()
+	for dutySlot, pendings := range t.attestations {
+		if dutySlot+inclusionRange >= slot {
+			continue
+		}
+		for _, pending := range pendings {
+			if !pending.included {
+				missed = append(missed, pending.duty)
+			}
+		}
+		delete(t.attestations, dutySlot)
+	}
+	for dutySlot := range t.syncCommittee {
+		if dutySlot+1 < slot {
+			syncSlots = append(syncSlots, dutySlot)
+		}
+	}
+	t.mu.Unlock()
+
+	for _, duty := range missed {
+		t.saveAttestation(duty.ValidatorIndex, Attestation{Slot: duty.Slot})
+	}
+	for _, dutySlot := range syncSlots {
+		t.recordSyncCommittee(dutySlot, nil)
+	}
+}
+
+func (t *Tracker) saveAttestation(index phase0.ValidatorIndex, result Attestation) {
+	if result.Included {
+		metricsAttestations.WithLabelValues("included").Inc()
+		metricsInclusionDistance.Observe(float64(result.InclusionDistance))
+		metricsAttestationVotes.WithLabelValues("source", strconv.FormatBool(result.SourceCorrect)).Inc()
+		metricsAttestationVotes.WithLabelValues("target", strconv.FormatBool(result.TargetCorrect)).Inc()
+		metricsAttestationVotes.WithLabelValues("head", strconv.FormatBool(result.HeadCorrect)).Inc()
+	} else {
+		metricsAttestations.WithLabelValues("missed").Inc()
+	}
+
+	err := t.storage.Update(index, t.network.EstimatedEpochAtSlot(result.Slot), func(record *Record) {
+		record.Attestation = &result
+	})
+	if err != nil {
+		t.logger.Warn("could not save attestation effectiveness", zap.Error(err))
+	}
+}
+
+// canonicalRoot returns the root of the latest block at or before the given slot,
+// from the read blocks or else from the beacon node.
+func (t *Tracker) canonicalRoot(ctx context.Context, slot phase0.Slot) (phase0.Root, error) {
+	for s := slot; slot-s <= phase0.Slot(t.network.SlotsPerEpoch()); s-- {
+		root, ok := t.roots[s]
+		if !ok {
+			r, err := t.beaconNode.BeaconBlockRoot(ctx, strconv.FormatUint(uint64(s), 10))
+			if err != nil {
+				return phase0.Root{}, errors.Wrap(err, "could not get block root")
+			}
+			if r != nil {
+				root, ok = *r, true
+				t.roots[s] = root
+			}
+		}
+		if ok {
+			t.roots[slot] = root
+			return root, nil
+		}
+		if s == 0 {
+			break
+		}
+	}
+	return phase0.Root{}, errors.New("no block found")
+}
+
+// syncAggregate returns the sync aggregate of the block, or nil before Altair.
+func syncAggregate(block *spec.VersionedSignedBeaconBlock) *altair.SyncAggregate {
+	switch block.Version {
+	case spec.DataVersionAltair:
+		if block.Altair != nil && block.Altair.Message != nil && block.Altair.Message.Body != nil {
+			return block.Altair.Message.Body.SyncAggregate
+		}
+	case spec.DataVersionBellatrix:
+		if block.Bellatrix != nil && block.Bellatrix.Message != nil && block.Bellatrix.Message.Body != nil {
+			return block.Bellatrix.Message.Body.SyncAggregate
+		}
+	case spec.DataVersionCapella:
+		if block.Capella != nil && block.Capella.Message != nil && block.Capella.Message.Body != nil {
+			return block.Capella.Message.Body.SyncAggregate
+		}
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package effectiveness
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	spectypes "github.com/bloxapp/ssv-spec/types"
+	"github.com/bloxapp/ssv-spec/types/testingutils"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bloxapp/ssv/logging"
+	"github.com/bloxapp/ssv/protocol/v2/blockchain/beacon"
+	"github.com/bloxapp/ssv/storage/basedb"
+	"github.com/bloxapp/ssv/storage/kv"
+)
+
+// testBeaconNode serves the blocks of the given slots, other slots are empty.
+type testBeaconNode struct {
+	blocks map[phase0.Slot]*spec.VersionedSignedBeaconBlock
+}
+
+func (n *testBeaconNode) SignedBeaconBlock(_ context.Context, blockID string) (*spec.VersionedSignedBeaconBlock, error) {
+	slot, err := strconv.ParseUint(blockID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return n.blocks[phase0.Slot(slot)], nil
+}
+
+func (n *testBeaconNode) BeaconBlockRoot(ctx context.Context, blockID string) (*phase0.Root, error) {
+	block, err := n.SignedBeaconBlock(ctx, blockID)
+	if err != nil || block == nil {
+		return nil, err
+	}
+	root, err := block.Root()
+	return &root, err
+}
+
+func (n *testBeaconNode) addBlock(t *testing.T, slot phase0.Slot, attestations []*phase0.Attestation, syncBits bitfield.Bitvector512) phase0.Root {
+	message := *testingutils.TestingBeaconBlockCapella
+	body := *message.Body
+	message.Slot = slot
+	message.Body = &body
+	body.Attestations = attestations
+	body.SyncAggregate = &altair.SyncAggregate{SyncCommitteeBits: syncBits}
+
+	block := &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionCapella,
+		Capella: &capella.SignedBeaconBlock{Message: &message},
+	}
+	n.blocks[slot] = block
+	root, err := block.Root()
+	require.NoError(t, err)
+	return root
+}
+
+func TestTracker(t *testing.T) {
+	logger := logging.TestLogger(t)
+	db, err := kv.NewInMemory(logger, basedb.Options{})
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	storage := NewStorage(db)
+	node := &testBeaconNode{blocks: make(map[phase0.Slot]*spec.VersionedSignedBeaconBlock)}
+	tracker := NewTracker(logger, node, beacon.NewNetwork(spectypes.PraterNetwork), storage, 10)
+
+	targetRoot := node.addBlock(t, 96, nil, bitfield.NewBitvector512())
+	headRoot := node.addBlock(t, 100, nil, bitfield.NewBitvector512())
+
+	// Validator 1 is included a slot late with the correct votes, while validator 2 is missed.
+	bits := bitfield.NewBitlist(8)
+	bits.SetBitAt(5, true)
+	syncBits := bitfield.NewBitvector512()
+	syncBits.SetBitAt(7, true)
+	node.addBlock(t, 102, []*phase0.Attestation{{
+		AggregationBits: bits,
+		Data: &phase0.AttestationData{
+			Slot:            100,
+			Index:           3,
+			BeaconBlockRoot: headRoot,
+			Source:          &phase0.Checkpoint{},
+			Target:          &phase0.Checkpoint{Epoch: 3, Root: targetRoot},
+		},
+	}}, syncBits)
+
+	tracker.Track(&spectypes.Duty{Type: spectypes.BNRoleAttester, Slot: 100, ValidatorIndex: 1, CommitteeIndex: 3, ValidatorCommitteeIndex: 5})
+	tracker.Track(&spectypes.Duty{Type: spectypes.BNRoleAttester, Slot: 100, ValidatorIndex: 2, CommitteeIndex: 3, ValidatorCommitteeIndex: 6})
+	// Validator 3 participates in one of its sync committee positions, and validator 4 misses the empty slot.
+	tracker.Track(&spectypes.Duty{Type: spectypes.BNRoleSyncCommittee, Slot: 101, ValidatorIndex: 3, ValidatorSyncCommitteeIndices: []uint64{7, 8}})
+	tracker.Track(&spectypes.Duty{Type: spectypes.BNRoleSyncCommittee, Slot: 100, ValidatorIndex: 4, ValidatorSyncCommitteeIndices: []uint64{1}})
+
+	ctx := context.Background()
+	for slot := phase0.Slot(99); slot <= 133; slot++ {
+		tracker.follower.ProcessUntil(ctx, slot)
+	}
+
+	records, err := storage.Records([]phase0.ValidatorIndex{1, 2, 3, 4}, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, []*Record{
+		{ValidatorIndex: 1, Epoch: 3, Attestation: &Attestation{
+			Slot: 100, Included: true, InclusionSlot: 102, InclusionDistance: 2,
+			SourceCorrect: true, TargetCorrect: true, HeadCorrect: true,
+		}},
+		{ValidatorIndex: 2, Epoch: 3, Attestation: &Attestation{Slot: 100}},
+		{ValidatorIndex: 3, Epoch: 3, SyncCommittee: &SyncCommittee{Expected: 2, Participated: 1}},
+		{ValidatorIndex: 4, Epoch: 3, SyncCommittee: &SyncCommittee{Expected: 1}},
+	}, records)
+
+	// Records are only read by validator.
+	_, err = storage.Records(nil, 0, 0)
+	require.Error(t, err)
+
+	// Records are pruned once they're older than the retention.
+	tracker.follower.ProcessUntil(ctx, 14*32)
+	records, err = storage.Records([]phase0.ValidatorIndex{1, 2, 3, 4}, 0, 0)
+	require.NoError(t, err)
+	require.Empty(t, records)
+}
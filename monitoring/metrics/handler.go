@@ -16,6 +16,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/bloxapp/ssv/logging/fields"
+	"github.com/bloxapp/ssv/nodeprobe"
 	"github.com/bloxapp/ssv/storage/basedb"
 )
 
@@ -31,10 +32,12 @@ type metricsHandler struct {
 	reporter      nodeMetrics
 	enableProf    bool
 	healthChecker HealthChecker
+	statusChecker StatusChecker
 }
 
 // NewMetricsHandler returns a new metrics handler.
-func NewMetricsHandler(ctx context.Context, db basedb.Database, reporter nodeMetrics, enableProf bool, healthChecker HealthChecker) Handler {
+// statusChecker is optional, without it readiness only depends on healthChecker.
+func NewMetricsHandler(ctx context.Context, db basedb.Database, reporter nodeMetrics, enableProf bool, healthChecker HealthChecker, statusChecker StatusChecker) Handler {
 	if reporter == nil {
 		reporter = nopMetrics{}
 	}
@@ -44,6 +47,7 @@ func NewMetricsHandler(ctx context.Context, db basedb.Database, reporter nodeMet
 		reporter:      reporter,
 		enableProf:    enableProf,
 		healthChecker: healthChecker,
+		statusChecker: statusChecker,
 	}
 	return &mh
 }
@@ -71,6 +75,9 @@ func (mh *metricsHandler) Start(logger *zap.Logger, mux *http.ServeMux, addr str
 	))
 	mux.HandleFunc("/database/count-by-collection", mh.handleCountByCollection)
 	mux.HandleFunc("/health", mh.handleHealth)
+	mux.HandleFunc("/health/live", mh.handleLiveness)
+	mux.HandleFunc("/health/ready", mh.handleReadiness)
+	mux.HandleFunc("/health/status", mh.handleStatus)
 
 	// Set a high timeout to allow for long-running pprof requests.
 	const timeout = 600 * time.Second
@@ -145,6 +152,59 @@ func (mh *metricsHandler) handleHealth(res http.ResponseWriter, req *http.Reques
 	}
 }
 
+// handleLiveness responds with 200 as long as the node is able to serve requests.
+func (mh *metricsHandler) handleLiveness(res http.ResponseWriter, req *http.Request) {
+	writeJSON(res, http.StatusOK, map[string]bool{"alive": true})
+}
+
+// handleReadiness responds with 200 if the node and all of its probed components are healthy,
+// otherwise it responds with 503 and the errors.
+func (mh *metricsHandler) handleReadiness(res http.ResponseWriter, req *http.Request) {
+	var errs []string
+	if err := mh.healthChecker.HealthCheck(); err != nil {
+		errs = append(errs, err.Error())
+	}
+	if mh.statusChecker != nil {
+		if err := mh.statusChecker.Ready(req.Context()); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		writeJSON(res, http.StatusServiceUnavailable, map[string][]string{"errors": errs})
+		return
+	}
+	writeJSON(res, http.StatusOK, map[string]bool{"ready": true})
+}
+
+// handleStatus responds with the status of every component of the node.
+// It always responds with 200, use handleReadiness to probe the node.
+func (mh *metricsHandler) handleStatus(res http.ResponseWriter, req *http.Request) {
+	var response struct {
+		Healthy    bool                                 `json:"healthy"`
+		Components map[string]nodeprobe.ComponentStatus `json:"components"`
+	}
+	response.Healthy = true
+	response.Components = map[string]nodeprobe.ComponentStatus{}
+	if mh.statusChecker != nil {
+		response.Components = mh.statusChecker.Status(req.Context())
+	}
+	for _, status := range response.Components {
+		response.Healthy = response.Healthy && status.Healthy
+	}
+	writeJSON(res, http.StatusOK, response)
+}
+
+func writeJSON(res http.ResponseWriter, status int, v any) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		http.Error(res, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	res.Header().Set("Content-Type", "application/json")
+	res.WriteHeader(status)
+	_, _ = res.Write(raw)
+}
+
 func (mh *metricsHandler) configureProfiling() {
 	runtime.SetBlockProfileRate(10000)
 	runtime.SetMutexProfileFraction(5)
@@ -0,0 +1,80 @@
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bloxapp/ssv/nodeprobe"
+)
+
+func TestHealthEndpoints(t *testing.T) {
+	health := &healthChecker{}
+	status := &statusChecker{
+		statuses: map[string]nodeprobe.ComponentStatus{
+			"execution client": {Healthy: true, Details: map[string]uint64{"head_block": 10}},
+			"p2p network":      {Healthy: true},
+		},
+	}
+	mh := NewMetricsHandler(context.Background(), nil, nil, false, health, status).(*metricsHandler)
+
+	request := func(handler http.HandlerFunc) (int, map[string]any) {
+		rec := httptest.NewRecorder()
+		handler(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		var body map[string]any
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		return rec.Code, body
+	}
+
+	code, _ := request(mh.handleLiveness)
+	require.Equal(t, http.StatusOK, code)
+
+	code, _ = request(mh.handleReadiness)
+	require.Equal(t, http.StatusOK, code)
+
+	code, body := request(mh.handleStatus)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, true, body["healthy"])
+	require.Len(t, body["components"], 2)
+
+	status.ready = fmt.Errorf("not all nodes are healthy")
+	status.statuses["p2p network"] = nodeprobe.ComponentStatus{Error: "network is not ready"}
+	health.err = fmt.Errorf("not healthy")
+
+	code, _ = request(mh.handleLiveness)
+	require.Equal(t, http.StatusOK, code)
+
+	code, body = request(mh.handleReadiness)
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.Equal(t, []any{"not healthy", "not all nodes are healthy"}, body["errors"])
+
+	code, body = request(mh.handleStatus)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, false, body["healthy"])
+}
+
+type healthChecker struct {
+	err error
+}
+
+func (hc *healthChecker) HealthCheck() error {
+	return hc.err
+}
+
+type statusChecker struct {
+	ready    error
+	statuses map[string]nodeprobe.ComponentStatus
+}
+
+func (sc *statusChecker) Ready(context.Context) error {
+	return sc.ready
+}
+
+func (sc *statusChecker) Status(context.Context) map[string]nodeprobe.ComponentStatus {
+	return sc.statuses
+}
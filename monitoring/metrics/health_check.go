@@ -1,6 +1,18 @@
 package metrics
 
+import (
+	"context"
+
+	"github.com/bloxapp/ssv/nodeprobe"
+)
+
 // HealthChecker represent an health-check agent
 type HealthChecker interface {
 	HealthCheck() error
 }
+
+// StatusChecker reports the readiness of the node and the status of its components
+type StatusChecker interface {
+	Ready(ctx context.Context) error
+	Status(ctx context.Context) map[string]nodeprobe.ComponentStatus
+}
@@ -0,0 +1,61 @@
+package notifier
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	spectypes "github.com/bloxapp/ssv-spec/types"
+)
+
+// DutyTracker counts the consecutive failed duties of each validator
+// and notifies once the count reaches the threshold, until a duty of the validator succeeds.
+type DutyTracker struct {
+	notifier  Notifier
+	threshold int
+
+	mu       sync.Mutex
+	failures map[string]int
+}
+
+// NewDutyTracker creates a DutyTracker, a threshold of 0 disables it.
+func NewDutyTracker(notifier Notifier, threshold int) *DutyTracker {
+	return &DutyTracker{
+		notifier:  notifier,
+		threshold: threshold,
+		failures:  make(map[string]int),
+	}
+}
+
+// DutyFinished records the result of a duty of the given validator, err is nil if it succeeded.
+func (t *DutyTracker) DutyFinished(pubKey []byte, role spectypes.BeaconRole, err error) {
+	if t == nil || t.threshold <= 0 {
+		return
+	}
+	pk := hex.EncodeToString(pubKey)
+
+	t.mu.Lock()
+	if err == nil {
+		delete(t.failures, pk)
+		t.mu.Unlock()
+		return
+	}
+	t.failures[pk]++
+	failures := t.failures[pk]
+	t.mu.Unlock()
+
+	if failures != t.threshold {
+		return
+	}
+	t.notifier.Notify(Event{
+		Type:     DutyFailures,
+		Severity: SeverityCritical,
+		Message:  fmt.Sprintf("%d consecutive duties of validator %s failed", failures, pk),
+		Fields: map[string]any{
+			"validator": pk,
+			"role":      role.String(),
+			"failures":  failures,
+			"error":     err.Error(),
+		},
+	})
+}
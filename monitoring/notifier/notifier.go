@@ -0,0 +1,203 @@
+// Package notifier notifies external systems, such as webhooks, about events that are relevant to the operator.
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/bloxapp/ssv/logging"
+)
+
+// queueSize is the amount of events that are buffered before new events are dropped.
+const queueSize = 256
+
+// closeTimeout limits the time it takes to deliver the buffered events when closing.
+const closeTimeout = 10 * time.Second
+
+// EventType is the type of notified event.
+type EventType string
+
+// Event types
+const (
+	ValidatorAdded      EventType = "validator_added"
+	ValidatorRemoved    EventType = "validator_removed"
+	ClusterLiquidated   EventType = "cluster_liquidated"
+	ClusterReactivated  EventType = "cluster_reactivated"
+	FeeRecipientChanged EventType = "fee_recipient_changed"
+	ValidatorSlashed    EventType = "validator_slashed"
+	ValidatorExited     EventType = "validator_exited"
+	DutyFailures        EventType = "duty_failures"
+	ComponentUnhealthy  EventType = "component_unhealthy"
+	ComponentRecovered  EventType = "component_recovered"
+	LowPeerCount        EventType = "low_peer_count"
+)
+
+// Severity is how urgently an event should be handled.
+type Severity string
+
+// Severities
+const (
+	SeverityInfo     Severity = "info"
+	SeverityWarning  Severity = "warning"
+	SeverityCritical Severity = "critical"
+)
+
+// Event is the JSON payload of a notification.
+type Event struct {
+	Type     EventType      `json:"type"`
+	Severity Severity       `json:"severity"`
+	Time     time.Time      `json:"time"`
+	Node     string         `json:"node,omitempty"`
+	Message  string         `json:"message"`
+	Fields   map[string]any `json:"fields,omitempty"`
+}
+
+// Notifier notifies about events, implementations must not block.
+type Notifier interface {
+	Notify(event Event)
+}
+
+// Nop is a Notifier that discards all events.
+type Nop struct{}
+
+func (Nop) Notify(Event) {}
+
+// Config configures the sinks of the notifications and the thresholds of the events.
+type Config struct {
+	Webhooks     []string `yaml:"Webhooks" env:"NOTIFIER_WEBHOOKS" env-description:"URLs to POST events to as JSON"`
+	File         string   `yaml:"File" env:"NOTIFIER_FILE" env-description:"Path to a file to append events to as JSON lines"`
+	Stdout       bool     `yaml:"Stdout" env:"NOTIFIER_STDOUT" env-description:"Write events to stdout as JSON lines"`
+	Events       []string `yaml:"Events" env:"NOTIFIER_EVENTS" env-description:"Types of events to notify about, all of them if empty"`
+	NodeName     string   `yaml:"NodeName" env:"NOTIFIER_NODE_NAME" env-description:"Name of the node in its events"`
+	MinPeers     int      `yaml:"MinPeers" env:"NOTIFIER_MIN_PEERS" env-default:"5" env-description:"Notify when the node has fewer peers, disabled if 0"`
+	DutyFailures int      `yaml:"DutyFailures" env:"NOTIFIER_DUTY_FAILURES" env-default:"3" env-description:"Notify when this many consecutive duties of a validator fail, disabled if 0"`
+}
+
+// Enabled returns whether any sink is configured.
+func (c Config) Enabled() bool {
+	return len(c.Webhooks) > 0 || c.File != "" || c.Stdout
+}
+
+// Dispatcher delivers events to the configured sinks in the background.
+type Dispatcher struct {
+	logger *zap.Logger
+	cfg    Config
+	sinks  []Sink
+	types  map[EventType]bool
+
+	queue     chan Event
+	done      chan struct{}
+	closeOnce sync.Once
+	mu        sync.RWMutex
+	closed    bool
+}
+
+// New creates a Dispatcher of the configured sinks and starts delivering events.
+func New(logger *zap.Logger, cfg Config) (*Dispatcher, error) {
+	d := &Dispatcher{
+		logger: logger.Named(logging.NameNotifier),
+		cfg:    cfg,
+		queue:  make(chan Event, queueSize),
+		done:   make(chan struct{}),
+	}
+	for _, url := range cfg.Webhooks {
+		d.sinks = append(d.sinks, NewWebhookSink(url))
+	}
+	if cfg.File != "" {
+		sink, err := NewFileSink(cfg.File)
+		if err != nil {
+			return nil, err
+		}
+		d.sinks = append(d.sinks, sink)
+	}
+	if cfg.Stdout {
+		d.sinks = append(d.sinks, NewStdoutSink())
+	}
+	if len(cfg.Events) > 0 {
+		d.types = make(map[EventType]bool, len(cfg.Events))
+		for _, t := range cfg.Events {
+			d.types[EventType(t)] = true
+		}
+	}
+
+	go d.run()
+	return d, nil
+}
+
+// Notify queues the event for delivery, the event is dropped if the queue is full.
+func (d *Dispatcher) Notify(event Event) {
+	if d.types != nil && !d.types[event.Type] {
+		return
+	}
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+	if event.Severity == "" {
+		event.Severity = SeverityInfo
+	}
+	if event.Node == "" {
+		event.Node = d.cfg.NodeName
+	}
+
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if d.closed {
+		return
+	}
+	select {
+	case d.queue <- event:
+	default:
+		d.logger.Warn("dropped notification, queue is full", zap.String("type", string(event.Type)))
+	}
+}
+
+// Close delivers the queued events and closes the sinks.
+func (d *Dispatcher) Close() error {
+	d.closeOnce.Do(func() {
+		d.mu.Lock()
+		d.closed = true
+		close(d.queue)
+		d.mu.Unlock()
+
+		select {
+		case <-d.done:
+		case <-time.After(closeTimeout):
+			d.logger.Warn("timed out delivering queued notifications")
+		}
+		for _, sink := range d.sinks {
+			if err := sink.Close(); err != nil {
+				d.logger.Warn("could not close notification sink", zap.String("sink", sink.String()), zap.Error(err))
+			}
+		}
+	})
+	return nil
+}
+
+func (d *Dispatcher) run() {
+	defer close(d.done)
+	for event := range d.queue {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			d.logger.Error("could not encode notification", zap.String("type", string(event.Type)), zap.Error(err))
+			continue
+		}
+		for _, sink := range d.sinks {
+			if err := d.send(sink, payload); err != nil {
+				d.logger.Warn("could not deliver notification",
+					zap.String("sink", sink.String()),
+					zap.String("type", string(event.Type)),
+					zap.Error(err))
+			}
+		}
+	}
+}
+
+func (d *Dispatcher) send(sink Sink, payload []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), sendTimeout)
+	defer cancel()
+	return sink.Send(ctx, payload)
+}
@@ -0,0 +1,144 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// sendTimeout limits the time it takes to deliver an event to a sink, including retries.
+	sendTimeout = 30 * time.Second
+	// webhookAttempts is the amount of times an event is POSTed before it's given up on.
+	webhookAttempts = 3
+	// webhookRetryDelay is the delay before the first retry, it's doubled on every retry.
+	webhookRetryDelay = time.Second
+)
+
+// Sink delivers the JSON payloads of events.
+type Sink interface {
+	fmt.Stringer
+	io.Closer
+	Send(ctx context.Context, payload []byte) error
+}
+
+// WebhookSink POSTs events to a URL.
+type WebhookSink struct {
+	url        string
+	client     *http.Client
+	retryDelay time.Duration
+}
+
+func NewWebhookSink(url string) *WebhookSink {
+	return &WebhookSink{
+		url:        url,
+		client:     &http.Client{Timeout: 10 * time.Second},
+		retryDelay: webhookRetryDelay,
+	}
+}
+
+// Send POSTs the payload and retries on network errors and 5xx responses.
+func (s *WebhookSink) Send(ctx context.Context, payload []byte) error {
+	delay := s.retryDelay
+	var err error
+	for attempt := 1; attempt <= webhookAttempts; attempt++ {
+		var retry bool
+		retry, err = s.post(ctx, payload)
+		if err == nil || !retry || attempt == webhookAttempts {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+			delay *= 2
+		}
+	}
+	return err
+}
+
+func (s *WebhookSink) post(ctx context.Context, payload []byte) (retry bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(payload))
+	if err != nil {
+		return false, errors.Wrap(err, "could not create request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return true, errors.Wrap(err, "could not send request")
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests,
+			errors.Errorf("unexpected status %s", resp.Status)
+	}
+	return false, nil
+}
+
+// String returns the URL without its user info, which may hold credentials.
+func (s *WebhookSink) String() string {
+	u, err := url.Parse(s.url)
+	if err != nil {
+		return "webhook"
+	}
+	return "webhook " + u.Redacted()
+}
+
+func (s *WebhookSink) Close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
+
+// WriterSink writes events as JSON lines.
+type WriterSink struct {
+	name   string
+	mu     sync.Mutex
+	w      io.Writer
+	closer io.Closer
+}
+
+// NewFileSink appends events to the file at the given path.
+func NewFileSink(path string) (*WriterSink, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open notifications file")
+	}
+	return &WriterSink{name: "file " + path, w: f, closer: f}, nil
+}
+
+// NewStdoutSink writes events to stdout.
+func NewStdoutSink() *WriterSink {
+	return &WriterSink{name: "stdout", w: os.Stdout}
+}
+
+func (s *WriterSink) Send(_ context.Context, payload []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	line := make([]byte, 0, len(payload)+1)
+	line = append(append(line, payload...), '\n')
+	_, err := s.w.Write(line)
+	return err
+}
+
+func (s *WriterSink) String() string {
+	return s.name
+}
+
+func (s *WriterSink) Close() error {
+	if s.closer == nil {
+		return nil
+	}
+	return s.closer.Close()
+}
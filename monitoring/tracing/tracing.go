@@ -0,0 +1,175 @@
+// Package tracing exports OpenTelemetry traces of the lifecycle of duties.
+//
+// Packages create their spans with Tracer, which returns a no-op tracer unless Setup was called.
+package tracing
+
+import (
+	"context"
+	"encoding/hex"
+	"os"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	specqbft "github.com/bloxapp/ssv-spec/qbft"
+	spectypes "github.com/bloxapp/ssv-spec/types"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/bloxapp/ssv/logging"
+	"github.com/bloxapp/ssv/utils/commons"
+)
+
+// instrumentationName is the name of the tracer of all packages.
+const instrumentationName = "github.com/bloxapp/ssv"
+
+// Config configures where traces are exported to.
+type Config struct {
+	OTLPEndpoint string  `yaml:"OTLPEndpoint" env:"TRACING_OTLP_ENDPOINT" env-description:"host:port of an OTLP/HTTP collector to export traces to"`
+	OTLPInsecure bool    `yaml:"OTLPInsecure" env:"TRACING_OTLP_INSECURE" env-description:"Export traces to the OTLP collector over plain HTTP"`
+	File         string  `yaml:"File" env:"TRACING_FILE" env-description:"Path to a file to write traces to as JSON"`
+	ServiceName  string  `yaml:"ServiceName" env:"TRACING_SERVICE_NAME" env-default:"ssv-node" env-description:"Name of the node in its traces"`
+	SampleRatio  float64 `yaml:"SampleRatio" env:"TRACING_SAMPLE_RATIO" env-default:"1" env-description:"Ratio of duties to trace, between 0 and 1"`
+}
+
+// Enabled returns whether any exporter is configured.
+func (c Config) Enabled() bool {
+	return c.OTLPEndpoint != "" || c.File != ""
+}
+
+// Setup registers a global tracer provider which exports to the configured exporters.
+// The returned function flushes the pending spans and shuts the exporters down.
+func Setup(ctx context.Context, logger *zap.Logger, cfg Config) (shutdown func(context.Context) error, err error) {
+	logger = logger.Named(logging.NameTracing)
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(cfg.ServiceName),
+		semconv.ServiceVersion(commons.GetNodeVersion()),
+	))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create resource")
+	}
+
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	}
+	var closers []func() error
+
+	if cfg.OTLPEndpoint != "" {
+		otlpOpts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.OTLPEndpoint)}
+		if cfg.OTLPInsecure {
+			otlpOpts = append(otlpOpts, otlptracehttp.WithInsecure())
+		}
+		exporter, err := otlptracehttp.New(ctx, otlpOpts...)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create OTLP exporter")
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+		logger.Info("exporting traces", zap.String("otlp_endpoint", cfg.OTLPEndpoint))
+	}
+
+	if cfg.File != "" {
+		f, err := os.OpenFile(cfg.File, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not open traces file")
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			_ = f.Close()
+			return nil, errors.Wrap(err, "could not create file exporter")
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+		closers = append(closers, f.Close)
+		logger.Info("exporting traces", zap.String("file", cfg.File))
+	}
+
+	provider := sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(provider)
+
+	return func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		for _, closer := range closers {
+			if closeErr := closer(); closeErr != nil && err == nil {
+				err = closeErr
+			}
+		}
+		return err
+	}, nil
+}
+
+// Tracer returns the tracer of the global tracer provider.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// Span attribute keys
+const (
+	SlotKey      = attribute.Key("ssv.slot")
+	RoleKey      = attribute.Key("ssv.role")
+	ValidatorKey = attribute.Key("ssv.validator")
+	HeightKey    = attribute.Key("ssv.height")
+	RoundKey     = attribute.Key("ssv.round")
+)
+
+func Slot(slot phase0.Slot) attribute.KeyValue {
+	return SlotKey.Int64(int64(slot))
+}
+
+func Role(role spectypes.BeaconRole) attribute.KeyValue {
+	return RoleKey.String(role.String())
+}
+
+func Validator(pubKey []byte) attribute.KeyValue {
+	return ValidatorKey.String(hex.EncodeToString(pubKey))
+}
+
+func Height(height specqbft.Height) attribute.KeyValue {
+	return HeightKey.Int64(int64(height))
+}
+
+func Round(round specqbft.Round) attribute.KeyValue {
+	return RoundKey.Int64(int64(round))
+}
+
+// DutyAttributes returns the attributes which identify the given duty.
+func DutyAttributes(duty *spectypes.Duty) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		Slot(duty.Slot),
+		Role(duty.Type),
+		Validator(duty.PubKey[:]),
+	}
+}
+
+// End ends the span, marking it as failed if err isn't nil.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+type queuedSpanKey struct{}
+
+// StartQueued starts a span of the time a message spends in a queue, which EndQueued ends once it's popped.
+// The returned context carries the span, while the span of ctx remains its active span.
+func StartQueued(ctx context.Context, name string) context.Context {
+	_, span := Tracer().Start(ctx, name)
+	return context.WithValue(ctx, queuedSpanKey{}, span)
+}
+
+// EndQueued ends the span started by StartQueued, if any.
+func EndQueued(ctx context.Context) {
+	if span, ok := ctx.Value(queuedSpanKey{}).(trace.Span); ok {
+		span.End()
+	}
+}
@@ -0,0 +1,69 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	spectypes "github.com/bloxapp/ssv-spec/types"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/zap"
+)
+
+// exportedSpan is the part of a span written by the file exporter which is checked by the tests.
+type exportedSpan struct {
+	Name        string
+	SpanContext struct{ TraceID string }
+	Attributes  []struct {
+		Key   string
+		Value struct{ Value any }
+	}
+}
+
+func TestSetup_File(t *testing.T) {
+	prev := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	file := filepath.Join(t.TempDir(), "traces.json")
+	cfg := Config{File: file, ServiceName: "ssv-node", SampleRatio: 1}
+	require.True(t, cfg.Enabled())
+
+	shutdown, err := Setup(context.Background(), zap.NewNop(), cfg)
+	require.NoError(t, err)
+
+	duty := &spectypes.Duty{Type: spectypes.BNRoleProposer, Slot: 7, PubKey: [48]byte{0xab}}
+	ctx, span := Tracer().Start(context.Background(), "duty")
+	span.SetAttributes(DutyAttributes(duty)...)
+	EndQueued(StartQueued(ctx, "queue"))
+	End(span, nil)
+	require.NoError(t, shutdown(context.Background()))
+
+	data, err := os.ReadFile(file)
+	require.NoError(t, err)
+	var spans []exportedSpan
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	for decoder.More() {
+		var span exportedSpan
+		require.NoError(t, decoder.Decode(&span))
+		spans = append(spans, span)
+	}
+	require.Len(t, spans, 2)
+	require.Equal(t, "queue", spans[0].Name)
+	require.Equal(t, "duty", spans[1].Name)
+	require.Equal(t, spans[0].SpanContext.TraceID, spans[1].SpanContext.TraceID)
+
+	attributes := map[string]any{}
+	for _, attr := range spans[1].Attributes {
+		attributes[attr.Key] = attr.Value.Value
+	}
+	require.Equal(t, map[string]any{
+		string(SlotKey):      float64(7),
+		string(RoleKey):      "PROPOSER",
+		string(ValidatorKey): "ab" + strings.Repeat("00", 47),
+	}, attributes)
+}
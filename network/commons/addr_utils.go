@@ -20,6 +20,25 @@ func IPAddr() (net.IP, error) {
 	return net.ParseIP(ip), nil
 }
 
+// IPv6Addr returns the first global unicast IPv6 address of this host,
+// it returns nil if the host has no such address
+func IPv6Addr() (net.IP, error) {
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get interface addresses")
+	}
+	for _, addr := range addrs {
+		ipNet, ok := addr.(*net.IPNet)
+		if !ok {
+			continue
+		}
+		if ip := ipNet.IP; ip.To4() == nil && ip.IsGlobalUnicast() {
+			return ip, nil
+		}
+	}
+	return nil, nil
+}
+
 // CheckAddress checks that some address is accessible and returns error accordingly
 func CheckAddress(addr string) error {
 	conn, err := net.DialTimeout("tcp", addr, time.Second*10)
@@ -47,3 +66,28 @@ func BuildMultiAddress(ipAddr, protocol string, port uint, id peer.ID) (ma.Multi
 	}
 	return ma.NewMultiaddr(maStr)
 }
+
+// BuildQUICMultiAddress creates a QUIC (v1) multiaddr from the given params
+func BuildQUICMultiAddress(ipAddr string, port uint, id peer.ID) (ma.Multiaddr, error) {
+	udpAddr, err := BuildMultiAddress(ipAddr, "udp", port, "")
+	if err != nil {
+		return nil, err
+	}
+	quicAddr := udpAddr.Encapsulate(ma.StringCast("/quic-v1"))
+	if len(id) > 0 {
+		quicAddr = quicAddr.Encapsulate(ma.StringCast(fmt.Sprintf("/p2p/%s", id.String())))
+	}
+	return quicAddr, nil
+}
+
+// IsQUICAddr returns true if the given multiaddr is a QUIC address
+func IsQUICAddr(addr ma.Multiaddr) bool {
+	_, err := addr.ValueForProtocol(ma.P_QUIC_V1)
+	return err == nil
+}
+
+// IsIPv6Addr returns true if the given multiaddr is an IPv6 address
+func IsIPv6Addr(addr ma.Multiaddr) bool {
+	_, err := addr.ValueForProtocol(ma.P_IP6)
+	return err == nil
+}
@@ -31,3 +31,33 @@ func Test_BuildMultiAddress(t *testing.T) {
 		require.Equal(t, expected, ma.String())
 	})
 }
+
+func Test_BuildQUICMultiAddress(t *testing.T) {
+	sk, _, err := crypto.GenerateSecp256k1Key(crand.Reader)
+	require.NoError(t, err)
+	id, err := peer.IDFromPrivateKey(sk)
+	require.NoError(t, err)
+
+	t.Run("IPv4", func(t *testing.T) {
+		ma, err := BuildQUICMultiAddress(DefaultIP, DefaultTCP, id)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("/ip4/%s/udp/%d/quic-v1/p2p/%s", DefaultIP, DefaultTCP, id.String())
+		require.Equal(t, expected, ma.String())
+		require.True(t, IsQUICAddr(ma))
+		require.False(t, IsIPv6Addr(ma))
+	})
+
+	t.Run("IPv6", func(t *testing.T) {
+		ma, err := BuildQUICMultiAddress("::1", DefaultTCP, "")
+		require.NoError(t, err)
+		require.Equal(t, fmt.Sprintf("/ip6/::1/udp/%d/quic-v1", DefaultTCP), ma.String())
+		require.True(t, IsQUICAddr(ma))
+		require.True(t, IsIPv6Addr(ma))
+	})
+
+	t.Run("TCP", func(t *testing.T) {
+		ma, err := BuildMultiAddress(DefaultIP, "tcp", DefaultTCP, "")
+		require.NoError(t, err)
+		require.False(t, IsQUICAddr(ma))
+	})
+}
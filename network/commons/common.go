@@ -13,22 +13,28 @@ import (
 	"github.com/libp2p/go-libp2p"
 	"github.com/libp2p/go-libp2p/core/protocol"
 
+	"github.com/bloxapp/ssv/networkconfig"
+	"github.com/bloxapp/ssv/protocol/v2/message"
 	p2pprotocol "github.com/bloxapp/ssv/protocol/v2/p2p"
 )
 
 const (
-	lastDecidedProtocol = "/ssv/sync/decided/last/0.0.1"
-	historyProtocol     = "/ssv/sync/decided/history/0.0.1"
+	lastDecidedProtocol = "/ssv/sync/decided/last"
+	historyProtocol     = "/ssv/sync/decided/history"
+	syncProtocolVersion = networkconfig.GenesisSyncProtocolVersion
 
 	peersForSync = 10
 
 	// subnetsCount returns the subnet count for genesis
-	subnetsCount uint64 = 128
+	subnetsCount = networkconfig.GenesisSubnetsCount
 
 	// UnknownSubnet is used when a validator public key is invalid
 	UnknownSubnet = "unknown"
 
-	topicPrefix = "ssv.v2"
+	topicPrefix = networkconfig.GenesisTopicPrefix
+
+	// snappyProtocolSuffix is the suffix of stream protocols whose payloads are SSZ encoded and compressed with snappy
+	snappyProtocolSuffix = "/" + string(networkconfig.EncodingSSZSnappy)
 )
 
 // SubnetTopicID returns the topic to use for the given subnet
@@ -46,14 +52,23 @@ func ValidatorTopicID(pkByts []byte) []string {
 	return []string{SubnetTopicID(subnet)}
 }
 
-// GetTopicFullName returns the topic full name, including prefix
+// GetTopicFullName returns the topic full name of the genesis fork, including prefix
 func GetTopicFullName(baseName string) string {
-	return fmt.Sprintf("%s.%s", topicPrefix, baseName)
+	return ForkTopicFullName(topicPrefix, baseName)
+}
+
+// ForkTopicFullName returns the topic full name with the given fork topic prefix
+func ForkTopicFullName(prefix, baseName string) string {
+	return fmt.Sprintf("%s.%s", prefix, baseName)
 }
 
-// GetTopicBaseName return the base topic name of the topic, w/o ssv prefix
+// GetTopicBaseName return the base topic name of the topic, w/o the fork prefix
 func GetTopicBaseName(topicName string) string {
-	return strings.Replace(topicName, fmt.Sprintf("%s.", topicPrefix), "", 1)
+	i := strings.LastIndex(topicName, ".")
+	if i < 0 {
+		return topicName
+	}
+	return topicName[i+1:]
 }
 
 // ValidatorSubnet returns the subnet for the given validator
@@ -61,8 +76,16 @@ func ValidatorSubnet(validatorPKHex string) int {
 	if len(validatorPKHex) < 10 {
 		return -1
 	}
+	return ForkValidatorSubnet(validatorPKHex, subnetsCount)
+}
+
+// ForkValidatorSubnet returns the subnet for the given validator, out of the given subnets count
+func ForkValidatorSubnet(validatorPKHex string, count uint64) int {
+	if len(validatorPKHex) < 10 || count == 0 {
+		return -1
+	}
 	val := hexToUint64(validatorPKHex[:10])
-	return int(val % subnetsCount)
+	return int(val % count)
 }
 
 // MsgIDFunc is the function that maps a message to a msg_id
@@ -87,9 +110,14 @@ func Subnets() int {
 
 // Topics returns the available topics for this fork.
 func Topics() []string {
-	topics := make([]string, Subnets())
-	for i := 0; i < Subnets(); i++ {
-		topics[i] = GetTopicFullName(SubnetTopicID(i))
+	return ForkTopics(topicPrefix, subnetsCount)
+}
+
+// ForkTopics returns the topics of a fork with the given topic prefix and subnets count
+func ForkTopics(prefix string, count uint64) []string {
+	topics := make([]string, count)
+	for i := 0; i < int(count); i++ {
+		topics[i] = ForkTopicFullName(prefix, SubnetTopicID(i))
 	}
 	return topics
 }
@@ -108,24 +136,53 @@ func EncodeNetworkMsg(msg *spectypes.SSVMessage) ([]byte, error) {
 	return msg.Encode()
 }
 
-// DecodeNetworkMsg decodes network message
+// EncodeNetworkMsgWith encodes network message with the given encoding
+func EncodeNetworkMsgWith(msg *spectypes.SSVMessage, encoding networkconfig.MessageEncoding) ([]byte, error) {
+	if !encoding.Compressed() {
+		return EncodeNetworkMsg(msg)
+	}
+	return message.EncodeVersioned(msg, true)
+}
+
+// DecodeNetworkMsg decodes network message, of any of the supported encodings
 func DecodeNetworkMsg(data []byte) (*spectypes.SSVMessage, error) {
 	msg := spectypes.SSVMessage{}
-	err := msg.Decode(data)
+	var err error
+	if message.IsVersioned(data) {
+		err = message.DecodeVersioned(data, &msg)
+	} else {
+		err = msg.Decode(data)
+	}
 	if err != nil {
 		return nil, err
 	}
 	return &msg, nil
 }
 
+// SnappyProtocolID returns the ID of the given stream protocol, with payloads compressed with snappy
+func SnappyProtocolID(pid protocol.ID) protocol.ID {
+	return protocol.ID(string(pid) + snappyProtocolSuffix)
+}
+
+// IsSnappyProtocol returns whether payloads of the given stream protocol are compressed with snappy
+func IsSnappyProtocol(pid protocol.ID) bool {
+	return strings.HasSuffix(string(pid), snappyProtocolSuffix)
+}
+
 // ProtocolID returns the protocol id of the given protocol,
 // and the amount of peers for distribution
 func ProtocolID(prot p2pprotocol.SyncProtocol) (protocol.ID, int) {
+	return ForkProtocolID(prot, syncProtocolVersion)
+}
+
+// ForkProtocolID returns the protocol id of the given protocol in the given version,
+// and the amount of peers for distribution
+func ForkProtocolID(prot p2pprotocol.SyncProtocol, version string) (protocol.ID, int) {
 	switch prot {
 	case p2pprotocol.LastDecidedProtocol:
-		return lastDecidedProtocol, peersForSync
+		return protocol.ID(fmt.Sprintf("%s/%s", lastDecidedProtocol, version)), peersForSync
 	case p2pprotocol.DecidedHistoryProtocol:
-		return historyProtocol, peersForSync
+		return protocol.ID(fmt.Sprintf("%s/%s", historyProtocol, version)), peersForSync
 	}
 	return "", 0
 }
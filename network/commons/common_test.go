@@ -0,0 +1,77 @@
+package commons
+
+import (
+	"bytes"
+	"testing"
+
+	spectypes "github.com/bloxapp/ssv-spec/types"
+	libp2pprotocol "github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bloxapp/ssv/networkconfig"
+	p2pprotocol "github.com/bloxapp/ssv/protocol/v2/p2p"
+)
+
+func TestForkTopics(t *testing.T) {
+	require.Equal(t, "ssv.v2.5", GetTopicFullName("5"))
+	require.Equal(t, "ssv.v3.5", ForkTopicFullName("ssv.v3", "5"))
+	require.Equal(t, "5", GetTopicBaseName("ssv.v2.5"))
+	require.Equal(t, "5", GetTopicBaseName("ssv.v3.5"))
+	require.Equal(t, "xxx", GetTopicBaseName("xxx"))
+
+	topics := ForkTopics("ssv.v3", 4)
+	require.Equal(t, []string{"ssv.v3.0", "ssv.v3.1", "ssv.v3.2", "ssv.v3.3"}, topics)
+	require.Len(t, Topics(), Subnets())
+}
+
+func TestForkValidatorSubnet(t *testing.T) {
+	pkHex := "b768cdc2b2e0a859052bf04d1cd66383c96d95096a5287d08151494ce709556ba39c1300fbb902a0e2ebb7c31dc4e400"
+	require.Equal(t, ValidatorSubnet(pkHex), ForkValidatorSubnet(pkHex, subnetsCount))
+	require.Less(t, ForkValidatorSubnet(pkHex, 16), 16)
+	require.Equal(t, -1, ForkValidatorSubnet("b768", 16))
+	require.Equal(t, -1, ForkValidatorSubnet(pkHex, 0))
+}
+
+func TestForkProtocolID(t *testing.T) {
+	pid, _ := ProtocolID(p2pprotocol.LastDecidedProtocol)
+	require.Equal(t, libp2pprotocol.ID("/ssv/sync/decided/last/0.0.1"), pid)
+	pid, _ = ProtocolID(p2pprotocol.DecidedHistoryProtocol)
+	require.Equal(t, libp2pprotocol.ID("/ssv/sync/decided/history/0.0.1"), pid)
+	pid, _ = ForkProtocolID(p2pprotocol.DecidedHistoryProtocol, "0.0.2")
+	require.Equal(t, libp2pprotocol.ID("/ssv/sync/decided/history/0.0.2"), pid)
+}
+
+func TestNetworkMsgEncoding(t *testing.T) {
+	msg := &spectypes.SSVMessage{
+		MsgType: spectypes.SSVConsensusMsgType,
+		MsgID:   spectypes.NewMsgID(spectypes.DomainType{0x0, 0x0, 0x5, 0x1}, make([]byte, 48), spectypes.BNRoleAttester),
+		Data:    bytes.Repeat([]byte{0x1}, 1024),
+	}
+
+	legacy, err := EncodeNetworkMsgWith(msg, networkconfig.EncodingSSZ)
+	require.NoError(t, err)
+	expected, err := msg.Encode()
+	require.NoError(t, err)
+	require.Equal(t, expected, legacy)
+
+	compressed, err := EncodeNetworkMsgWith(msg, networkconfig.EncodingSSZSnappy)
+	require.NoError(t, err)
+	require.Less(t, len(compressed), len(legacy))
+
+	for _, data := range [][]byte{legacy, compressed} {
+		decoded, err := DecodeNetworkMsg(data)
+		require.NoError(t, err)
+		require.Equal(t, msg, decoded)
+	}
+
+	_, err = DecodeNetworkMsg([]byte{0xff, 0x7, 0x1})
+	require.Error(t, err)
+}
+
+func TestSnappyProtocolID(t *testing.T) {
+	pid, _ := ProtocolID(p2pprotocol.LastDecidedProtocol)
+	require.False(t, IsSnappyProtocol(pid))
+	snappyPid := SnappyProtocolID(pid)
+	require.Equal(t, libp2pprotocol.ID("/ssv/sync/decided/last/0.0.1/ssz_snappy"), snappyPid)
+	require.True(t, IsSnappyProtocol(snappyPid))
+}
@@ -183,7 +183,7 @@ func (dvs *DiscV5Service) initDiscV5Listener(logger *zap.Logger, discOpts *Optio
 	dvs.dv5Listener = dv5Listener
 	dvs.bootnodes = dv5Cfg.Bootnodes
 
-	logger.Debug("started discv5 listener (UDP)", fields.BindIP(bindIP),
+	logger.Debug("started discv5 listener (UDP)", fields.BindIP(bindIP), zap.String("network", n),
 		zap.Int("UdpPort", opts.Port), fields.ENRLocalNode(localNode), fields.OperatorIDStr(opts.OperatorID))
 
 	return nil
@@ -302,6 +302,12 @@ func (dvs *DiscV5Service) createLocalNode(logger *zap.Logger, discOpts *Options,
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create local node")
 	}
+	if ip6 := opts.IPv6Addr(); ip6 != nil && ipAddr.To4() != nil {
+		addIPv6(localNode, ip6, opts.TCPPort)
+	}
+	if opts.QUICPort > 0 {
+		setQUICEntry(localNode, opts.QUICPort)
+	}
 	err = addAddresses(localNode, discOpts.HostAddress, discOpts.HostDNS)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not add configured addresses")
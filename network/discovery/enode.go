@@ -31,6 +31,29 @@ func createLocalNode(privKey *ecdsa.PrivateKey, storagePath string, ipAddr net.I
 	return localNode, nil
 }
 
+// addIPv6 adds the IPv6 entries of a dual-stack node,
+// the discv5 port is shared by both stacks, while tcp6 is set only if it's different from the IPv4 port
+func addIPv6(localNode *enode.LocalNode, ipAddr net.IP, tcpPort int) {
+	localNode.SetFallbackIP(ipAddr)
+	if localNode.Node().TCP() != tcpPort {
+		localNode.Set(enr.TCP6(tcpPort))
+	}
+}
+
+// setQUICEntry adds the QUIC port to the node's record
+func setQUICEntry(localNode *enode.LocalNode, port int) {
+	localNode.Set(enr.WithEntry(quic, uint16(port)))
+}
+
+// getQUICEntry returns the QUIC port of the given node, 0 means that the node doesn't support QUIC
+func getQUICEntry(node *enode.Node) int {
+	var port uint16
+	if err := node.Load(enr.WithEntry(quic, &port)); err != nil {
+		return 0
+	}
+	return int(port)
+}
+
 // addAddresses adds configured address and/or dns if configured
 func addAddresses(localNode *enode.LocalNode, hostAddr, hostDNS string) error {
 	if len(hostAddr) > 0 {
@@ -46,25 +69,42 @@ func addAddresses(localNode *enode.LocalNode, hostAddr, hostDNS string) error {
 		if err != nil {
 			return errors.Wrap(err, "could not resolve host address")
 		}
-		if len(ips) > 0 {
-			firstIP := ips[0]
-			localNode.SetFallbackIP(firstIP)
+		// the first address of each family is used as fallback
+		var has4, has6 bool
+		for _, ip := range ips {
+			if ip.To4() != nil && !has4 {
+				has4 = true
+				localNode.SetFallbackIP(ip)
+			} else if ip.To4() == nil && !has6 {
+				has6 = true
+				localNode.SetFallbackIP(ip)
+			}
 		}
 	}
 	return nil
 }
 
-// ToPeer creates peer info from the given node
+// ToPeer creates peer info from the given node, with all the addresses of the node
 func ToPeer(node *enode.Node) (*peer.AddrInfo, error) {
-	m, err := ToMultiAddr(node)
+	addrs, err := ToMultiAddrs(node)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create multiaddr")
 	}
-	pi, err := peer.AddrInfoFromP2pAddr(m)
+	pis, err := peer.AddrInfosFromP2pAddrs(addrs...)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create peer info")
 	}
-	return pi, nil
+	if len(pis) != 1 {
+		return nil, errors.Errorf("unexpected amount of peers: %d", len(pis))
+	}
+	pi := pis[0]
+	// AddrInfosFromP2pAddrs doesn't preserve order, so the addresses are set again
+	pi.Addrs = pi.Addrs[:0]
+	for _, addr := range addrs {
+		transport, _ := peer.SplitAddr(addr)
+		pi.Addrs = append(pi.Addrs, transport)
+	}
+	return &pi, nil
 }
 
 // PeerID returns the peer id of the node
@@ -76,8 +116,18 @@ func PeerID(node *enode.Node) (peer.ID, error) {
 	return peer.IDFromPublicKey(pk)
 }
 
-// ToMultiAddr returns the node's multiaddr.
+// ToMultiAddr returns the node's preferred multiaddr.
 func ToMultiAddr(node *enode.Node) (ma.Multiaddr, error) {
+	addrs, err := ToMultiAddrs(node)
+	if err != nil {
+		return nil, err
+	}
+	return addrs[0], nil
+}
+
+// ToMultiAddrs returns the node's multiaddrs, ordered by preference:
+// QUIC addresses (if advertised) come before TCP addresses, and IPv4 before IPv6.
+func ToMultiAddrs(node *enode.Node) ([]ma.Multiaddr, error) {
 	id, err := PeerID(node)
 	if err != nil {
 		return nil, err
@@ -85,19 +135,52 @@ func ToMultiAddr(node *enode.Node) (ma.Multiaddr, error) {
 	if id.String() == "" {
 		return nil, errors.New("empty peer id")
 	}
-	ipAddr := node.IP().String()
-	ip := net.ParseIP(ipAddr)
-	if ip.To4() == nil && ip.To16() == nil {
-		return nil, errors.Errorf("invalid ip address: %s", ipAddr)
+
+	var ip4 enr.IPv4
+	var ip6 enr.IPv6
+	var ips []net.IP
+	if node.Load(&ip4) == nil {
+		ips = append(ips, net.IP(ip4))
+	}
+	if node.Load(&ip6) == nil {
+		ips = append(ips, net.IP(ip6))
+	}
+	if len(ips) == 0 {
+		return nil, errors.Errorf("invalid ip address: %s", node.IP().String())
+	}
+
+	tcpPort := node.TCP()
+	quicPort := getQUICEntry(node)
+
+	var addrs []ma.Multiaddr
+	if quicPort > 0 {
+		for _, ip := range ips {
+			addr, err := commons.BuildQUICMultiAddress(ip.String(), uint(quicPort), id)
+			if err != nil {
+				return nil, err
+			}
+			addrs = append(addrs, addr)
+		}
+	}
+	for _, ip := range ips {
+		port := tcpPort
+		var tcp6Port enr.TCP6
+		if ip.To4() == nil && node.Load(&tcp6Port) == nil {
+			port = int(tcp6Port)
+		}
+		if port == 0 {
+			continue
+		}
+		addr, err := commons.BuildMultiAddress(ip.String(), tcp, uint(port), id)
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, addr)
 	}
-	port := uint(node.TCP())
-	var s string
-	if ip.To4() != nil {
-		s = fmt.Sprintf("/ip4/%s/%s/%d/p2p/%s", ipAddr, "tcp", port, id.String())
-	} else {
-		s = fmt.Sprintf("/ip6/%s/%s/%d/p2p/%s", ipAddr, "tcp", port, id.String())
+	if len(addrs) == 0 {
+		return nil, errors.New("no tcp or quic port")
 	}
-	return ma.NewMultiaddr(s)
+	return addrs, nil
 }
 
 // ParseENR takes a list of ENR strings and returns
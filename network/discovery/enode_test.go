@@ -2,6 +2,7 @@ package discovery
 
 import (
 	crand "crypto/rand"
+	"net"
 	"strings"
 	"testing"
 
@@ -29,6 +30,48 @@ func Test_ToPeer(t *testing.T) {
 	require.Equal(t, 1, len(ai.Addrs))
 }
 
+func Test_ToMultiAddrs(t *testing.T) {
+	t.Run("dual-stack with QUIC", func(t *testing.T) {
+		node := localNodeMock(t)
+		addIPv6(node, net.ParseIP("2001:db8::1"), 13002)
+		setQUICEntry(node, 13003)
+
+		addrs, err := ToMultiAddrs(node.Node())
+		require.NoError(t, err)
+		require.Len(t, addrs, 4)
+		require.True(t, commons.IsQUICAddr(addrs[0]))
+		require.False(t, commons.IsIPv6Addr(addrs[0]))
+		require.True(t, commons.IsQUICAddr(addrs[1]))
+		require.True(t, commons.IsIPv6Addr(addrs[1]))
+		require.False(t, commons.IsQUICAddr(addrs[2]))
+		require.Contains(t, addrs[3].String(), "/ip6/2001:db8::1/tcp/13002/")
+
+		ma, err := ToMultiAddr(node.Node())
+		require.NoError(t, err)
+		require.True(t, addrs[0].Equal(ma), "QUIC should be preferred")
+
+		ai, err := ToPeer(node.Node())
+		require.NoError(t, err)
+		require.Len(t, ai.Addrs, 4)
+		require.True(t, commons.IsQUICAddr(ai.Addrs[0]))
+	})
+
+	t.Run("IPv6 only", func(t *testing.T) {
+		sk, _, err := crypto.GenerateSecp256k1Key(crand.Reader)
+		require.NoError(t, err)
+		pk, err := commons.ConvertFromInterfacePrivKey(sk)
+		require.NoError(t, err)
+		node, err := createLocalNode(pk, "", net.ParseIP("2001:db8::2"), 12000, 13000)
+		require.NoError(t, err)
+
+		addrs, err := ToMultiAddrs(node.Node())
+		require.NoError(t, err)
+		require.Len(t, addrs, 1)
+		require.True(t, commons.IsIPv6Addr(addrs[0]))
+		require.Contains(t, addrs[0].String(), "/tcp/13000/")
+	})
+}
+
 func Test_ParseENR(t *testing.T) {
 	nodes, err := ParseENR(nil, true,
 		"enr:-Km4QH9oua5xsG_0IN3oxiv5PBb10QXMkMvDeg2IrSSDlRxtONu9hShTmAZm2LjjADQOxGzBxd8VzXYFukmJULzcwrkBh2"+
@@ -20,12 +20,17 @@ type DiscV5Options struct {
 	StoragePath string
 	// IP of the node
 	IP string
-	// BindIP is the IP to bind to the UDP listener
+	// IPv6 of the node (optional), exposed in the ENR next to IP for dual-stack nodes
+	IPv6 string
+	// BindIP is the IP to bind to the UDP listener,
+	// binding to the IPv6 unspecified address (::) with an IPv4 IP listens on both stacks
 	BindIP string
 	// Port is the UDP port used by discv5
 	Port int
 	// TCPPort is the TCP port exposed in the ENR
 	TCPPort int
+	// QUICPort is the QUIC (UDP) port exposed in the ENR, 0 means that QUIC is not supported
+	QUICPort int
 	// NetworkKey is the private key used to create the peer.ID if the node
 	NetworkKey *ecdsa.PrivateKey
 	// Bootnodes is a list of bootstrapper nodes
@@ -61,27 +66,39 @@ func (opts *DiscV5Options) Validate() error {
 	return nil
 }
 
-// IPs returns the external ip and bind ip
+// IPs returns the external ip, bind ip and the udp network to listen on
 func (opts *DiscV5Options) IPs() (net.IP, net.IP, string) {
 	ipAddr := net.ParseIP(opts.IP)
 	if ipAddr == nil {
 		ipAddr = net.ParseIP(commons.DefaultIP)
 	}
-	n := "udp6"
+	n := udp6
 	bindIP := net.ParseIP(opts.BindIP)
 	if len(bindIP) == 0 {
 		if ipAddr.To4() != nil {
 			bindIP = net.IPv4zero
-			n = "udp4"
+			n = udp4
 		} else {
 			bindIP = net.IPv6zero
 		}
 	} else if bindIP.To4() != nil {
-		n = "udp4"
+		n = udp4
+	} else if bindIP.IsUnspecified() && ipAddr.To4() != nil {
+		// dual-stack, IPv4 is still reachable when binding to the IPv6 unspecified address
+		n = udp
 	}
 	return ipAddr, bindIP, n
 }
 
+// IPv6Addr returns the external IPv6 of a dual-stack node, or nil if not configured
+func (opts *DiscV5Options) IPv6Addr() net.IP {
+	ip := net.ParseIP(opts.IPv6)
+	if ip == nil || ip.To4() != nil {
+		return nil
+	}
+	return ip
+}
+
 // DiscV5Cfg creates discv5 config from the options
 func (opts *DiscV5Options) DiscV5Cfg(logger *zap.Logger) (*discover.Config, error) {
 	dv5Cfg := discover.Config{
@@ -0,0 +1,32 @@
+package discovery
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscV5Options_IPs(t *testing.T) {
+	tests := []struct {
+		name    string
+		ip      string
+		bindIP  string
+		network string
+	}{
+		{"IPv4", "10.0.0.1", net.IPv4zero.String(), udp4},
+		{"IPv4 without bind ip", "10.0.0.1", "", udp4},
+		{"IPv6 only", "2001:db8::1", net.IPv6zero.String(), udp6},
+		{"IPv6 only without bind ip", "2001:db8::1", "", udp6},
+		{"dual-stack", "10.0.0.1", net.IPv6zero.String(), udp},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := &DiscV5Options{IP: test.ip, BindIP: test.bindIP}
+			ipAddr, bindIP, n := opts.IPs()
+			require.Equal(t, test.ip, ipAddr.String())
+			require.NotNil(t, bindIP)
+			require.Equal(t, test.network, n)
+		})
+	}
+}
@@ -14,9 +14,12 @@ import (
 )
 
 const (
-	// udp4 = "udp4"
-	// udp6 = "udp6"
-	tcp = "tcp"
+	udp  = "udp"
+	udp4 = "udp4"
+	udp6 = "udp6"
+	tcp  = "tcp"
+	// quic is the ENR key of the QUIC port
+	quic = "quic"
 )
 
 // CheckPeerLimit enables listener to check peers limit
@@ -31,8 +31,6 @@ type P2PNetwork interface {
 	Setup(logger *zap.Logger) error
 	// Start starts the network
 	Start(logger *zap.Logger) error
-	// UpdateSubnets will update the registered subnets according to active validators
-	UpdateSubnets(logger *zap.Logger)
 	// SubscribeAll subscribes to all subnets
 	SubscribeAll(logger *zap.Logger) error
 }
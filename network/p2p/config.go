@@ -4,11 +4,13 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"fmt"
+	"net"
 	"strings"
 	"time"
 
 	"github.com/libp2p/go-libp2p"
 	"github.com/libp2p/go-libp2p/p2p/security/noise"
+	libp2pquic "github.com/libp2p/go-libp2p/p2p/transport/quic"
 	libp2ptcp "github.com/libp2p/go-libp2p/p2p/transport/tcp"
 	ma "github.com/multiformats/go-multiaddr"
 	"github.com/pkg/errors"
@@ -16,6 +18,7 @@ import (
 
 	"github.com/bloxapp/ssv/network"
 	"github.com/bloxapp/ssv/network/commons"
+	"github.com/bloxapp/ssv/network/peers"
 	"github.com/bloxapp/ssv/networkconfig"
 	"github.com/bloxapp/ssv/operator/storage"
 	uc "github.com/bloxapp/ssv/utils/commons"
@@ -37,13 +40,36 @@ type Config struct {
 	HostAddress string `yaml:"HostAddress" env:"HOST_ADDRESS" env-description:"External ip node is exposed for discovery"`
 	HostDNS     string `yaml:"HostDNS" env:"HOST_DNS" env-description:"External DNS node is exposed for discovery"`
 
+	// EnableQUIC enables QUIC transport, which is advertised in the ENR and preferred over TCP by peers that support it
+	EnableQUIC bool `yaml:"EnableQUIC" env:"P2P_ENABLE_QUIC" env-description:"Flag to turn on/off QUIC transport"`
+	QUICPort   int  `yaml:"QuicPort" env:"QUIC_PORT" env-description:"UDP port for QUIC transport, defaults to the TCP port"`
+	// EnableIPv6 enables dual-stack, i.e. listening and advertising both IPv4 and IPv6 addresses
+	EnableIPv6    bool   `yaml:"EnableIPv6" env:"P2P_ENABLE_IPV6" env-description:"Flag to turn on/off dual-stack (IPv4 and IPv6)"`
+	HostAddressV6 string `yaml:"HostAddressV6" env:"HOST_ADDRESS_V6" env-description:"External IPv6 node is exposed for discovery, relevant only for dual-stack"`
+
 	RequestTimeout   time.Duration `yaml:"RequestTimeout" env:"P2P_REQUEST_TIMEOUT"  env-default:"10s"`
 	MaxBatchResponse uint64        `yaml:"MaxBatchResponse" env:"P2P_MAX_BATCH_RESPONSE" env-default:"25" env-description:"Maximum number of returned objects in a batch"`
 	MaxPeers         int           `yaml:"MaxPeers" env:"P2P_MAX_PEERS" env-default:"60" env-description:"Connected peers limit for connections"`
 	TopicMaxPeers    int           `yaml:"TopicMaxPeers" env:"P2P_TOPIC_MAX_PEERS" env-default:"10" env-description:"Connected peers limit per pubsub topic"`
 
+	SyncRequestsRate          float64 `yaml:"SyncRequestsRate" env:"P2P_SYNC_REQUESTS_RATE" env-default:"10" env-description:"Sync requests per second that are allowed per peer and protocol, 0 means no limit"`
+	SyncRequestsBurst         int     `yaml:"SyncRequestsBurst" env:"P2P_SYNC_REQUESTS_BURST" env-default:"100" env-description:"Sync requests that a peer can make at once, on top of the rate limit"`
+	SyncMaxConcurrentRequests int     `yaml:"SyncMaxConcurrentRequests" env:"P2P_SYNC_MAX_CONCURRENT_REQUESTS" env-default:"32" env-description:"Sync requests that are handled concurrently per protocol, 0 means no limit"`
+
+	// TrustedPeers is a list of peers that we always stay connected to, regardless of peers limit and subnets
+	TrustedPeers []string `yaml:"TrustedPeers" env:"TRUSTED_PEERS" env-description:"Multiaddrs of peers to always stay connected to (e.g. /ip4/1.2.3.4/tcp/13001/p2p/16Uiu2...), separated with ','"`
+	// PeerStore is used to persist known peers across restarts, optional
+	PeerStore peers.PeerStore
+	// BanList is used to ban peers, IPs and subnets, optional (bans are kept in memory if not provided)
+	BanList peers.BanList
+
+	MaxPeersPerIP     int `yaml:"MaxPeersPerIP" env:"P2P_MAX_PEERS_PER_IP" env-default:"5" env-description:"Inbound connections limit per IP, 0 means no limit"`
+	MaxPeersPerSubnet int `yaml:"MaxPeersPerSubnet" env:"P2P_MAX_PEERS_PER_SUBNET" env-default:"20" env-description:"Inbound connections limit per subnet (/24 for IPv4, /64 for IPv6), 0 means no limit"`
+
 	// Subnets is a static bit list of subnets that this node will register upon start.
 	Subnets string `yaml:"Subnets" env:"SUBNETS" env-description:"Hex string that represents the subnets that this node will join upon start"`
+	// SubnetsGracePeriod is the time to stay in a subnet after its last validator was stopped.
+	SubnetsGracePeriod time.Duration `yaml:"SubnetsGracePeriod" env:"P2P_SUBNETS_GRACE_PERIOD" env-default:"5m" env-description:"Time to stay in a subnet after its last validator was stopped"`
 	// PubSubScoring is a flag to turn on/off pubsub scoring
 	PubSubScoring bool `yaml:"PubSubScoring" env:"PUBSUB_SCORING" env-default:"true" env-description:"Flag to turn on/off pubsub scoring"`
 	// PubSubTrace is a flag to turn on/off pubsub tracing in logs
@@ -99,6 +125,12 @@ func (c *Config) Libp2pOptions(logger *zap.Logger) ([]libp2p.Option, error) {
 		libp2p.Transport(libp2ptcp.NewTCPTransport),
 		libp2p.UserAgent(c.UserAgent),
 	}
+	if c.EnableQUIC {
+		if c.quicPort() == c.UDPPort {
+			return nil, errors.New("QUIC port must be different from discovery UDP port")
+		}
+		opts = append(opts, libp2p.Transport(libp2pquic.NewTransport))
+	}
 
 	opts, err = c.configureAddrs(logger, opts)
 	if err != nil {
@@ -113,17 +145,24 @@ func (c *Config) Libp2pOptions(logger *zap.Logger) ([]libp2p.Option, error) {
 }
 
 func (c *Config) configureAddrs(logger *zap.Logger, opts []libp2p.Option) ([]libp2p.Option, error) {
-	addrs := make([]ma.Multiaddr, 0)
-	maZero, err := commons.BuildMultiAddress("0.0.0.0", "tcp", uint(c.TCPPort), "")
-	if err != nil {
-		return opts, errors.Wrap(err, "could not build multi address for zero address")
-	}
-	addrs = append(addrs, maZero)
 	ipAddr, err := commons.IPAddr()
 	if err != nil {
 		return opts, errors.Wrap(err, "could not get ip addr")
 	}
 
+	listenIPs := []string{net.IPv4zero.String()}
+	if c.EnableIPv6 || ipAddr.To4() == nil {
+		listenIPs = append(listenIPs, net.IPv6zero.String())
+	}
+	addrs := make([]ma.Multiaddr, 0)
+	for _, ip := range listenIPs {
+		maZero, err := c.transportAddrs(ip)
+		if err != nil {
+			return opts, errors.Wrap(err, "could not build multi address for zero address")
+		}
+		addrs = append(addrs, maZero...)
+	}
+
 	if c.Discovery != localDiscvery {
 		maIP, err := commons.BuildMultiAddress(ipAddr.String(), "tcp", uint(c.TCPPort), "")
 		if err != nil {
@@ -133,26 +172,49 @@ func (c *Config) configureAddrs(logger *zap.Logger, opts []libp2p.Option) ([]lib
 	}
 	opts = append(opts, libp2p.ListenAddrs(addrs...))
 
-	// AddrFactory for host address if provided
+	// AddrFactory for host addresses if provided
+	var hostAddrs []string
 	if c.HostAddress != "" {
+		hostAddrs = append(hostAddrs, c.HostAddress)
+	}
+	if c.EnableIPv6 && c.HostAddressV6 != "" {
+		hostAddrs = append(hostAddrs, c.HostAddressV6)
+	}
+	if len(hostAddrs) > 0 {
 		opts = append(opts, libp2p.AddrsFactory(func(addrs []ma.Multiaddr) []ma.Multiaddr {
-			external, err := commons.BuildMultiAddress(c.HostAddress, "tcp", uint(c.TCPPort), "")
-			if err != nil {
-				logger.Error("unable to create external multiaddress", zap.Error(err))
-			} else {
-				addrs = append(addrs, external)
+			for _, hostAddr := range hostAddrs {
+				external, err := c.transportAddrs(hostAddr)
+				if err != nil {
+					logger.Error("unable to create external multiaddress", zap.Error(err))
+				} else {
+					addrs = append(addrs, external...)
+				}
 			}
 			return addrs
 		}))
 	}
 	// AddrFactory for DNS address if provided
 	if c.HostDNS != "" {
+		dnsProtocols := []string{"dns4"}
+		if c.EnableIPv6 {
+			dnsProtocols = append(dnsProtocols, "dns6")
+		}
 		opts = append(opts, libp2p.AddrsFactory(func(addrs []ma.Multiaddr) []ma.Multiaddr {
-			external, err := ma.NewMultiaddr(fmt.Sprintf("/dns4/%s/tcp/%d", c.HostDNS, c.TCPPort))
-			if err != nil {
-				logger.Warn("unable to create external multiaddress", zap.Error(err))
-			} else {
+			for _, dnsProtocol := range dnsProtocols {
+				external, err := ma.NewMultiaddr(fmt.Sprintf("/%s/%s/tcp/%d", dnsProtocol, c.HostDNS, c.TCPPort))
+				if err != nil {
+					logger.Warn("unable to create external multiaddress", zap.Error(err))
+					continue
+				}
 				addrs = append(addrs, external)
+				if c.EnableQUIC {
+					externalQUIC, err := ma.NewMultiaddr(fmt.Sprintf("/%s/%s/udp/%d/quic-v1", dnsProtocol, c.HostDNS, c.quicPort()))
+					if err != nil {
+						logger.Warn("unable to create external QUIC multiaddress", zap.Error(err))
+						continue
+					}
+					addrs = append(addrs, externalQUIC)
+				}
 			}
 			return addrs
 		}))
@@ -161,6 +223,47 @@ func (c *Config) configureAddrs(logger *zap.Logger, opts []libp2p.Option) ([]lib
 	return opts, nil
 }
 
+// transportAddrs returns the multiaddrs of the given ip for each of the enabled transports
+func (c *Config) transportAddrs(ip string) ([]ma.Multiaddr, error) {
+	tcpAddr, err := commons.BuildMultiAddress(ip, "tcp", uint(c.TCPPort), "")
+	if err != nil {
+		return nil, err
+	}
+	addrs := []ma.Multiaddr{tcpAddr}
+	if c.EnableQUIC {
+		quicAddr, err := commons.BuildQUICMultiAddress(ip, uint(c.quicPort()), "")
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, quicAddr)
+	}
+	return addrs, nil
+}
+
+// quicPort returns the QUIC port, which defaults to the TCP port
+func (c *Config) quicPort() int {
+	if c.QUICPort > 0 {
+		return c.QUICPort
+	}
+	return c.TCPPort
+}
+
+// ipv6Addr returns the external IPv6 address of a dual-stack node,
+// or nil if dual-stack is disabled or no IPv6 address is available
+func (c *Config) ipv6Addr() (net.IP, error) {
+	if !c.EnableIPv6 {
+		return nil, nil
+	}
+	if c.HostAddressV6 != "" {
+		ip := net.ParseIP(c.HostAddressV6)
+		if ip == nil || ip.To4() != nil {
+			return nil, errors.Errorf("invalid IPv6 host address: %s", c.HostAddressV6)
+		}
+		return ip, nil
+	}
+	return commons.IPv6Addr()
+}
+
 // TransformBootnodes converts bootnodes string and convert it to slice
 func (c *Config) TransformBootnodes() []string {
 
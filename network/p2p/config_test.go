@@ -0,0 +1,56 @@
+package p2pv1
+
+import (
+	"testing"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bloxapp/ssv/logging"
+	"github.com/bloxapp/ssv/network/commons"
+)
+
+func TestConfig_Libp2pOptions(t *testing.T) {
+	logger := logging.TestLogger(t)
+	sk, err := commons.GenNetworkKey()
+	require.NoError(t, err)
+
+	t.Run("QUIC", func(t *testing.T) {
+		cfg := &Config{
+			NetworkPrivateKey: sk,
+			Discovery:         localDiscvery,
+			TCPPort:           13301,
+			UDPPort:           12301,
+			EnableQUIC:        true,
+		}
+		opts, err := cfg.Libp2pOptions(logger)
+		require.NoError(t, err)
+		host, err := libp2p.New(opts...)
+		require.NoError(t, err)
+		defer func() { _ = host.Close() }()
+
+		var hasTCP, hasQUIC bool
+		for _, addr := range host.Addrs() {
+			if commons.IsQUICAddr(addr) {
+				hasQUIC = true
+			} else {
+				hasTCP = true
+			}
+		}
+		require.True(t, hasTCP)
+		require.True(t, hasQUIC)
+	})
+
+	t.Run("QUIC port conflicts with discovery", func(t *testing.T) {
+		cfg := &Config{
+			NetworkPrivateKey: sk,
+			Discovery:         localDiscvery,
+			TCPPort:           13302,
+			UDPPort:           12302,
+			QUICPort:          12302,
+			EnableQUIC:        true,
+		}
+		_, err := cfg.Libp2pOptions(logger)
+		require.Error(t, err)
+	})
+}
@@ -2,6 +2,7 @@ package p2pv1
 
 import (
 	"context"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -10,23 +11,24 @@ import (
 
 	"github.com/bloxapp/ssv/logging"
 	"github.com/bloxapp/ssv/logging/fields"
-	"github.com/bloxapp/ssv/network/commons"
 
 	connmgrcore "github.com/libp2p/go-libp2p/core/connmgr"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/peer"
 	libp2pdiscbackoff "github.com/libp2p/go-libp2p/p2p/discovery/backoff"
+	"github.com/pkg/errors"
 	"go.uber.org/zap"
 
 	"github.com/bloxapp/ssv/network"
 	"github.com/bloxapp/ssv/network/discovery"
 	"github.com/bloxapp/ssv/network/peers"
 	"github.com/bloxapp/ssv/network/peers/connections"
-	"github.com/bloxapp/ssv/network/records"
 	"github.com/bloxapp/ssv/network/streams"
 	"github.com/bloxapp/ssv/network/syncing"
 	"github.com/bloxapp/ssv/network/topics"
+	"github.com/bloxapp/ssv/networkconfig"
 	operatorstorage "github.com/bloxapp/ssv/operator/storage"
+	p2pprotocol "github.com/bloxapp/ssv/protocol/v2/p2p"
 	"github.com/bloxapp/ssv/utils/async"
 	"github.com/bloxapp/ssv/utils/tasks"
 )
@@ -69,12 +71,25 @@ type p2pNetwork struct {
 
 	activeValidators *hashmap.Map[string, validatorStatus]
 
+	forkLock sync.RWMutex
+	fork     networkconfig.Fork
+	// nextFork is set once the topics of the next fork are subscribed, ahead of its activation
+	nextFork *networkconfig.Fork
+
 	backoffConnector *libp2pdiscbackoff.BackoffConnector
-	subnets          []byte
+	subnets          *subnetsTracker
+	subnetsMu        sync.Mutex // serializes joining and leaving subnets
 	libConnManager   connmgrcore.ConnManager
 	syncer           syncing.Syncer
 	nodeStorage      operatorstorage.Storage
 	operatorPKCache  sync.Map
+	trustedPeers     []peer.AddrInfo
+
+	syncLimiter *streams.RequestLimiter
+	// syncRequests maps the sync requests that are being handled to their senders
+	syncRequests      sync.Map
+	syncPenaltiesLock sync.Mutex
+	syncPenalties     map[peer.ID]float64
 }
 
 // New creates a new p2p network
@@ -83,7 +98,7 @@ func New(logger *zap.Logger, cfg *Config) network.P2PNetwork {
 
 	logger = logger.Named(logging.NameP2PNetwork)
 
-	return &p2pNetwork{
+	n := &p2pNetwork{
 		parentCtx:        cfg.Ctx,
 		ctx:              ctx,
 		cancel:           cancel,
@@ -92,9 +107,18 @@ func New(logger *zap.Logger, cfg *Config) network.P2PNetwork {
 		msgRouter:        cfg.Router,
 		state:            stateClosed,
 		activeValidators: hashmap.New[string, validatorStatus](),
+		subnets:          newSubnetsTracker(),
 		nodeStorage:      cfg.NodeStorage,
 		operatorPKCache:  sync.Map{},
+		syncLimiter: streams.NewRequestLimiter(streams.LimiterCfg{
+			Rate:          cfg.SyncRequestsRate,
+			Burst:         cfg.SyncRequestsBurst,
+			MaxConcurrent: cfg.SyncMaxConcurrentRequests,
+		}),
+		syncPenalties: make(map[peer.ID]float64),
 	}
+	n.fork = cfg.Network.ForkAtEpoch(n.currentEpoch())
+	return n
 }
 
 // Host implements HostProvider
@@ -126,9 +150,42 @@ func (n *p2pNetwork) PeersByTopic() ([]peer.ID, map[string][]peer.ID) {
 	return allpeers, peerz
 }
 
+// Status is the status of the network as reported by the status endpoint.
+type Status struct {
+	Peers               int      `json:"peers"`
+	Subnets             []string `json:"subnets"`
+	SubnetsWithoutPeers []string `json:"subnets_without_peers"`
+}
+
+// Status returns the number of connected peers and the subscribed subnets,
+// along with the subnets that have no peers.
+func (n *p2pNetwork) Status(context.Context) (any, error) {
+	if !n.isReady() {
+		return nil, p2pprotocol.ErrNetworkIsNotReady
+	}
+	status := Status{
+		Peers:               len(n.host.Network().Peers()),
+		Subnets:             n.topicsCtrl.Topics(),
+		SubnetsWithoutPeers: []string{},
+	}
+	sort.Strings(status.Subnets)
+	for _, topic := range status.Subnets {
+		peers, err := n.topicsCtrl.Peers(topic)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get peers of topic %s", topic)
+		}
+		if len(peers) == 0 {
+			status.SubnetsWithoutPeers = append(status.SubnetsWithoutPeers, topic)
+		}
+	}
+	return status, nil
+}
+
 // Close implements io.Closer
 func (n *p2pNetwork) Close() error {
-	atomic.SwapInt32(&n.state, stateClosing)
+	if atomic.SwapInt32(&n.state, stateClosing) == stateReady {
+		n.persistPeers(n.interfaceLogger)()
+	}
 	defer atomic.StoreInt32(&n.state, stateClosed)
 	n.cancel()
 	if err := n.libConnManager.Close(); err != nil {
@@ -167,6 +224,21 @@ func (n *p2pNetwork) Start(logger *zap.Logger) error {
 
 	async.Interval(n.ctx, topicsReportingInterval, n.reportTopics(logger))
 
+	if len(n.trustedPeers) > 0 {
+		n.connectTrustedPeers(logger)()
+		async.Interval(n.ctx, trustedPeersInterval, n.connectTrustedPeers(logger))
+	}
+
+	async.Interval(n.ctx, persistPeersInterval, n.persistPeers(logger))
+	async.Interval(n.ctx, pruneBansInterval, n.pruneBans(logger))
+	async.Interval(n.ctx, syncLimitsInterval, n.decaySyncLimits(logger))
+	async.Interval(n.ctx, releaseSubnetsInterval, n.releaseSubnets(logger))
+
+	if len(n.cfg.Network.ForkSchedule()) > 1 {
+		n.checkForks(logger)()
+		async.Interval(n.ctx, forksCheckInterval, n.checkForks(logger))
+	}
+
 	if err := n.subscribeToSubnets(logger); err != nil {
 		return err
 	}
@@ -191,7 +263,7 @@ func (n *p2pNetwork) peersBalancing(logger *zap.Logger) func() {
 		defer cancel()
 
 		connMgr := peers.NewConnManager(logger, n.libConnManager, n.idx)
-		mySubnets := records.Subnets(n.subnets).Clone()
+		mySubnets := n.subnets.Subnets()
 		connMgr.TagBestPeers(logger, n.cfg.MaxPeers-1, mySubnets, allPeers, n.cfg.TopicMaxPeers)
 		connMgr.TrimPeers(ctx, logger, n.host.Network())
 	}
@@ -207,6 +279,7 @@ func (n *p2pNetwork) startDiscovery(logger *zap.Logger) {
 		defer cancel()
 		n.backoffConnector.Connect(ctx, discoveredPeers)
 	}()
+	n.restorePeers(logger, discoveredPeers)
 	err := tasks.Retry(func() error {
 		return n.disc.Bootstrap(logger, func(e discovery.PeerEvent) {
 			if !n.idx.CanConnect(e.AddrInfo.ID) {
@@ -228,60 +301,6 @@ func (n *p2pNetwork) isReady() bool {
 	return atomic.LoadInt32(&n.state) == stateReady
 }
 
-// UpdateSubnets will update the registered subnets according to active validators
-// NOTE: it won't subscribe to the subnets (use subscribeToSubnets for that)
-func (n *p2pNetwork) UpdateSubnets(logger *zap.Logger) {
-	// TODO: this is a temporary fix to update subnets when validators are added/removed,
-	// there is a pending PR to replace this: https://github.com/bloxapp/ssv/pull/990
-	logger = logger.Named(logging.NameP2PNetwork)
-	ticker := time.NewTicker(2 * time.Second)
-	registeredSubnets := make([]byte, commons.Subnets())
-	defer ticker.Stop()
-	for range ticker.C {
-		start := time.Now()
-
-		// Compute the new subnets according to the active validators.
-		newSubnets := make([]byte, commons.Subnets())
-		n.activeValidators.Range(func(pkHex string, status validatorStatus) bool {
-			subnet := commons.ValidatorSubnet(pkHex)
-			newSubnets[subnet] = byte(1)
-			return true
-		})
-		n.subnets = newSubnets
-
-		// Compute the not yet registered subnets.
-		unregisteredSubnets := make([]int, 0)
-		for subnet, active := range newSubnets {
-			if active == byte(1) && registeredSubnets[subnet] == byte(0) {
-				unregisteredSubnets = append(unregisteredSubnets, subnet)
-			}
-		}
-		registeredSubnets = newSubnets
-
-		if len(unregisteredSubnets) == 0 {
-			continue
-		}
-
-		self := n.idx.Self()
-		self.Metadata.Subnets = records.Subnets(n.subnets).String()
-		n.idx.UpdateSelfRecord(self)
-
-		err := n.disc.RegisterSubnets(logger.Named(logging.NameDiscoveryService), unregisteredSubnets...)
-		if err != nil {
-			logger.Warn("could not register subnets", zap.Error(err))
-			continue
-		}
-		allSubs, _ := records.Subnets{}.FromString(records.AllSubnets)
-		subnetsList := records.SharedSubnets(allSubs, n.subnets, 0)
-		logger.Debug("updated subnets",
-			zap.Any("added", unregisteredSubnets),
-			zap.Any("subnets", subnetsList),
-			zap.Int("total_subnets", len(subnetsList)),
-			zap.Duration("took", time.Since(start)),
-		)
-	}
-}
-
 // getMaxPeers returns max peers of the given topic.
 func (n *p2pNetwork) getMaxPeers(topic string) int {
 	if len(topic) == 0 {
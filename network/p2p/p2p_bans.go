@@ -0,0 +1,44 @@
+package p2pv1
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"go.uber.org/zap"
+
+	"github.com/bloxapp/ssv/logging/fields"
+)
+
+// pruneBansInterval is the interval for pruning expired bans
+const pruneBansInterval = time.Hour
+
+// banPeer bans the given peer and closes its connections, trusted peers are never banned
+func (n *p2pNetwork) banPeer(logger *zap.Logger, id peer.ID, reason string) {
+	if n.idx.IsTrusted(id) {
+		return
+	}
+	ban, err := n.cfg.BanList.BanPeer(id, reason)
+	if err != nil {
+		logger.Warn("could not ban peer", fields.PeerID(id), zap.Error(err))
+		return
+	}
+	logger.Debug("banned peer", fields.PeerID(id), zap.String("reason", reason),
+		zap.Time("expiry", ban.Expiry), zap.Int("offenses", ban.Offenses))
+	if err := n.host.Network().ClosePeer(id); err != nil {
+		logger.Debug("could not close connection of banned peer", fields.PeerID(id), zap.Error(err))
+	}
+}
+
+// pruneBans removes expired bans from the ban list
+func (n *p2pNetwork) pruneBans(logger *zap.Logger) func() {
+	return func() {
+		pruned, err := n.cfg.BanList.Prune()
+		if err != nil {
+			logger.Warn("could not prune bans", zap.Error(err))
+			return
+		}
+		if pruned > 0 {
+			logger.Debug("pruned bans", zap.Int("pruned", pruned))
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package p2pv1
+
+import (
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"go.uber.org/zap"
+
+	"github.com/bloxapp/ssv/network/commons"
+	"github.com/bloxapp/ssv/networkconfig"
+)
+
+const (
+	// forkSubscriptionEpochs is the amount of epochs before a fork,
+	// in which the node subscribes to the topics of the next fork
+	forkSubscriptionEpochs = 2
+	// forksCheckInterval is the interval for checking the fork schedule
+	forksCheckInterval = 6 * time.Second
+)
+
+// currentEpoch returns the current epoch, or 0 if the beacon network is not configured (e.g. in tests)
+func (n *p2pNetwork) currentEpoch() phase0.Epoch {
+	if n.cfg.Network.Beacon == nil {
+		return 0
+	}
+	return n.cfg.Network.Beacon.EstimatedCurrentEpoch()
+}
+
+// activeFork returns the fork that is currently active
+func (n *p2pNetwork) activeFork() networkconfig.Fork {
+	n.forkLock.RLock()
+	defer n.forkLock.RUnlock()
+
+	return n.fork
+}
+
+// subscribedForks returns the forks whose topics are subscribed,
+// i.e. the active fork and the next fork once it is close enough
+func (n *p2pNetwork) subscribedForks() []networkconfig.Fork {
+	n.forkLock.RLock()
+	defer n.forkLock.RUnlock()
+
+	if n.nextFork != nil {
+		return []networkconfig.Fork{n.fork, *n.nextFork}
+	}
+	return []networkconfig.Fork{n.fork}
+}
+
+// forkValidatorTopics returns the topics of the given validator in the given fork
+func forkValidatorTopics(fork networkconfig.Fork, pk []byte) []string {
+	subnet := commons.ForkValidatorSubnet(hex.EncodeToString(pk), fork.SubnetsCount)
+	return []string{commons.ForkTopicFullName(fork.TopicPrefix, commons.SubnetTopicID(subnet))}
+}
+
+// forkSubnetTopic returns the topic of the given subnet in the given fork
+func forkSubnetTopic(fork networkconfig.Fork, subnet int) string {
+	return commons.ForkTopicFullName(fork.TopicPrefix, commons.SubnetTopicID(subnet))
+}
+
+// checkForks subscribes to the topics of the next fork ahead of time,
+// and switches to it once its activation epoch is reached
+func (n *p2pNetwork) checkForks(logger *zap.Logger) func() {
+	return func() {
+		epoch := n.currentEpoch()
+
+		if current, active := n.activeFork(), n.cfg.Network.ForkAtEpoch(epoch); active.Epoch != current.Epoch {
+			n.switchFork(logger, current, active)
+		}
+
+		next, ok := n.cfg.Network.NextFork(epoch)
+		if !ok || next.Epoch > epoch+forkSubscriptionEpochs {
+			return
+		}
+		n.forkLock.Lock()
+		subscribed := n.nextFork != nil
+		if !subscribed {
+			n.nextFork = &next
+		}
+		n.forkLock.Unlock()
+		if subscribed {
+			return
+		}
+		logger.Info("subscribing to topics of the next fork", zap.Stringer("fork", next), zap.Uint64("epoch", uint64(epoch)))
+		n.subscribeFork(logger, next)
+	}
+}
+
+// switchFork makes the given fork active and leaves the topics of the previous fork
+func (n *p2pNetwork) switchFork(logger *zap.Logger, previous, active networkconfig.Fork) {
+	n.forkLock.Lock()
+	presubscribed := n.nextFork != nil && n.nextFork.Epoch == active.Epoch
+	n.fork = active
+	n.nextFork = nil
+	n.forkLock.Unlock()
+
+	logger.Info("switching fork", zap.Stringer("previous", previous), zap.Stringer("active", active))
+	if previous.TopicPrefix == active.TopicPrefix {
+		// the topics didn't change, e.g. a fork of the domain type only
+		return
+	}
+	if !presubscribed {
+		n.subscribeFork(logger, active)
+	}
+	n.unsubscribeFork(logger, previous)
+}
+
+// subscribeFork subscribes to the topics of the active validators and subnets in the given fork.
+// subnets are mapped by index, which is exact as long as the subnets count doesn't change between forks,
+// otherwise the topics of the active validators cover the required subnets.
+func (n *p2pNetwork) subscribeFork(logger *zap.Logger, fork networkconfig.Fork) {
+	n.activeValidators.Range(func(pkHex string, status validatorStatus) bool {
+		if status != validatorStatusSubscribed {
+			return true
+		}
+		pk, err := hex.DecodeString(pkHex)
+		if err != nil {
+			return true
+		}
+		for _, topic := range forkValidatorTopics(fork, pk) {
+			if err := n.topicsCtrl.Subscribe(logger, topic); err != nil {
+				logger.Warn("could not subscribe to fork topic", zap.String("topic", topic), zap.Error(err))
+			}
+		}
+		return true
+	})
+	for i, val := range n.subnets.Subnets() {
+		if val == 0 || uint64(i) >= fork.SubnetsCount {
+			continue
+		}
+		topic := forkSubnetTopic(fork, i)
+		if err := n.topicsCtrl.Subscribe(logger, topic); err != nil {
+			logger.Warn("could not subscribe to fork topic", zap.String("topic", topic), zap.Error(err))
+		}
+	}
+}
+
+// unsubscribeFork leaves all the topics of the given fork
+func (n *p2pNetwork) unsubscribeFork(logger *zap.Logger, fork networkconfig.Fork) {
+	prefix := fork.TopicPrefix + "."
+	for _, topic := range n.topicsCtrl.Topics() {
+		if !strings.HasPrefix(topic, prefix) {
+			continue
+		}
+		if err := n.topicsCtrl.Unsubscribe(logger, topic, true); err != nil {
+			logger.Warn("could not unsubscribe from fork topic", zap.String("topic", topic), zap.Error(err))
+		}
+	}
+}
+
+// forksTopics returns the topics of all the forks in the schedule
+func (n *p2pNetwork) forksTopics() []string {
+	var topics []string
+	for _, fork := range n.cfg.Network.ForkSchedule() {
+		topics = append(topics, commons.ForkTopics(fork.TopicPrefix, fork.SubnetsCount)...)
+	}
+	return topics
+}
+
+// networkIDs returns the network IDs (domain types) of all the forks in the schedule,
+// peers that didn't switch to the active fork yet are still accepted
+func (n *p2pNetwork) networkIDs() []string {
+	var ids []string
+	for _, domain := range n.cfg.Network.Domains() {
+		ids = append(ids, "0x"+hex.EncodeToString(domain[:]))
+	}
+	return ids
+}
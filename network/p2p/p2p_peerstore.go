@@ -0,0 +1,149 @@
+package p2pv1
+
+import (
+	"context"
+	"time"
+
+	libp2pnetwork "github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/peerstore"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/bloxapp/ssv/logging/fields"
+	"github.com/bloxapp/ssv/network/peers"
+)
+
+const (
+	// trustedPeersInterval is the interval for re-connecting to disconnected trusted peers
+	trustedPeersInterval = 30 * time.Second
+	// persistPeersInterval is the interval for saving connected peers into the peer store
+	persistPeersInterval = 5 * time.Minute
+	// storedPeersTTL is the amount of time a stored peer is kept after it was last seen
+	storedPeersTTL = 7 * 24 * time.Hour
+	// maxStoredPeersFactor is the amount of stored peers, relative to max peers
+	maxStoredPeersFactor = 2
+)
+
+// parseTrustedPeers parses the configured trusted peers into peer.AddrInfo
+func parseTrustedPeers(addrs []string) ([]peer.AddrInfo, error) {
+	var trusted []peer.AddrInfo
+	for _, addr := range addrs {
+		if len(addr) == 0 {
+			continue
+		}
+		info, err := peer.AddrInfoFromString(addr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse trusted peer %s", addr)
+		}
+		trusted = append(trusted, *info)
+	}
+	return trusted, nil
+}
+
+func (n *p2pNetwork) trustedPeerIDs() []peer.ID {
+	ids := make([]peer.ID, 0, len(n.trustedPeers))
+	for _, info := range n.trustedPeers {
+		ids = append(ids, info.ID)
+	}
+	return ids
+}
+
+// setupTrustedPeers adds the trusted peers to the peerstore and protects them from trimming
+func (n *p2pNetwork) setupTrustedPeers() {
+	for _, info := range n.trustedPeers {
+		n.host.Peerstore().AddAddrs(info.ID, info.Addrs, peerstore.PermanentAddrTTL)
+		n.libConnManager.Protect(info.ID, peers.TrustedTag)
+	}
+}
+
+// connectTrustedPeers connects to trusted peers that are not connected
+func (n *p2pNetwork) connectTrustedPeers(logger *zap.Logger) func() {
+	return func() {
+		for _, info := range n.trustedPeers {
+			if n.host.Network().Connectedness(info.ID) == libp2pnetwork.Connected {
+				continue
+			}
+			go func(info peer.AddrInfo) {
+				ctx, cancel := context.WithTimeout(n.ctx, connectTimeout)
+				defer cancel()
+				if err := n.host.Connect(ctx, info); err != nil {
+					logger.Debug("could not connect to trusted peer", fields.PeerID(info.ID), zap.Error(err))
+				}
+			}(info)
+		}
+	}
+}
+
+// restorePeers passes the stored peers to the connector, so they are re-dialed on startup
+func (n *p2pNetwork) restorePeers(logger *zap.Logger, connector chan<- peer.AddrInfo) {
+	if n.cfg.PeerStore == nil {
+		return
+	}
+	storedPeers, err := n.cfg.PeerStore.Peers()
+	if err != nil {
+		logger.Warn("could not load stored peers", zap.Error(err))
+		return
+	}
+	var restored int
+	for _, sp := range storedPeers {
+		if sp.LastSeen.Before(time.Now().Add(-storedPeersTTL)) || !n.idx.CanConnect(sp.ID) {
+			continue
+		}
+		n.host.Peerstore().AddAddrs(sp.ID, sp.Addrs, peerstore.AddressTTL)
+		if len(sp.Subnets) > 0 {
+			n.idx.UpdatePeerSubnets(sp.ID, sp.Subnets)
+		}
+		select {
+		case connector <- sp.AddrInfo():
+			restored++
+		default:
+			logger.Debug("connector queue is full, skipping stored peers")
+			return
+		}
+	}
+	logger.Debug("restored stored peers", zap.Int("restored", restored), zap.Int("stored", len(storedPeers)))
+}
+
+// persistPeers saves the currently connected peers into the peer store and prunes old ones
+func (n *p2pNetwork) persistPeers(logger *zap.Logger) func() {
+	return func() {
+		if n.cfg.PeerStore == nil {
+			return
+		}
+		now := time.Now()
+		var toSave []*peers.StoredPeer
+		for _, pid := range n.host.Network().Peers() {
+			pi := n.idx.PeerInfo(pid)
+			if pi == nil || pi.State != peers.StateConnected || pi.NodeInfo == nil {
+				continue
+			}
+			addrs := n.host.Peerstore().Addrs(pid)
+			if len(addrs) == 0 {
+				continue
+			}
+			var score float64
+			if scores, err := n.idx.GetScore(pid, validationScoreName); err == nil && len(scores) > 0 {
+				score = scores[0].Value
+			}
+			toSave = append(toSave, &peers.StoredPeer{
+				ID:       pid,
+				Addrs:    addrs,
+				NodeInfo: pi.NodeInfo,
+				Subnets:  n.idx.GetPeerSubnets(pid),
+				LastSeen: now,
+				Score:    score,
+			})
+		}
+		if err := n.cfg.PeerStore.SavePeers(toSave...); err != nil {
+			logger.Warn("could not save peers", zap.Error(err))
+			return
+		}
+		pruned, err := n.cfg.PeerStore.Prune(now.Add(-storedPeersTTL), n.cfg.MaxPeers*maxStoredPeersFactor)
+		if err != nil {
+			logger.Warn("could not prune stored peers", zap.Error(err))
+			return
+		}
+		logger.Debug("persisted peers", zap.Int("saved", len(toSave)), zap.Int("pruned", pruned))
+	}
+}
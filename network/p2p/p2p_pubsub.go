@@ -2,7 +2,7 @@ package p2pv1
 
 import (
 	"encoding/hex"
-	"fmt"
+	"time"
 
 	spectypes "github.com/bloxapp/ssv-spec/types"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
@@ -35,7 +35,7 @@ func (n *p2pNetwork) UseMessageRouter(router network.MessageRouter) {
 // Peers registers a message router to handle incoming messages
 func (n *p2pNetwork) Peers(pk spectypes.ValidatorPK) ([]peer.ID, error) {
 	all := make([]peer.ID, 0)
-	topics := commons.ValidatorTopicID(pk)
+	topics := forkValidatorTopics(n.activeFork(), pk)
 	for _, topic := range topics {
 		peers, err := n.topicsCtrl.Peers(topic)
 		if err != nil {
@@ -52,13 +52,13 @@ func (n *p2pNetwork) Broadcast(msg *spectypes.SSVMessage) error {
 		return p2pprotocol.ErrNetworkIsNotReady
 	}
 
-	raw, err := commons.EncodeNetworkMsg(msg)
+	raw, err := commons.EncodeNetworkMsgWith(msg, n.activeFork().Encoding)
 	if err != nil {
-		return errors.Wrap(err, "could not decode msg")
+		return errors.Wrap(err, "could not encode msg")
 	}
 
 	vpk := msg.GetID().GetPubKey()
-	topics := commons.ValidatorTopicID(vpk)
+	topics := forkValidatorTopics(n.activeFork(), vpk)
 
 	for _, topic := range topics {
 		if err := n.topicsCtrl.Broadcast(topic, raw, n.cfg.RequestTimeout); err != nil {
@@ -73,11 +73,14 @@ func (n *p2pNetwork) SubscribeAll(logger *zap.Logger) error {
 	if !n.isReady() {
 		return p2pprotocol.ErrNetworkIsNotReady
 	}
-	n.subnets, _ = records.Subnets{}.FromString(records.AllSubnets)
-	for subnet := 0; subnet < commons.Subnets(); subnet++ {
-		err := n.topicsCtrl.Subscribe(logger, commons.SubnetTopicID(subnet))
-		if err != nil {
-			return err
+	allSubnets, _ := records.Subnets{}.FromString(records.AllSubnets)
+	n.subnets.SetStatic(allSubnets)
+	for _, fork := range n.subscribedForks() {
+		for subnet := 0; subnet < int(fork.SubnetsCount); subnet++ {
+			err := n.topicsCtrl.Subscribe(logger, forkSubnetTopic(fork, subnet))
+			if err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -93,7 +96,7 @@ func (n *p2pNetwork) Subscribe(pk spectypes.ValidatorPK) error {
 	if found && status != validatorStatusInactive {
 		return nil
 	}
-	err := n.subscribe(n.interfaceLogger, pk)
+	err := n.subscribeValidator(n.interfaceLogger, pk)
 	if err != nil {
 		return err
 	}
@@ -101,7 +104,8 @@ func (n *p2pNetwork) Subscribe(pk spectypes.ValidatorPK) error {
 	return nil
 }
 
-// Unsubscribe unsubscribes from the validator subnet
+// Unsubscribe releases the validator subnet, which is left after a grace period
+// unless it's still required by other validators or by the static subnets.
 func (n *p2pNetwork) Unsubscribe(logger *zap.Logger, pk spectypes.ValidatorPK) error {
 	if !n.isReady() {
 		return p2pprotocol.ErrNetworkIsNotReady
@@ -110,23 +114,19 @@ func (n *p2pNetwork) Unsubscribe(logger *zap.Logger, pk spectypes.ValidatorPK) e
 	if status, _ := n.activeValidators.Get(pkHex); status != validatorStatusSubscribed {
 		return nil
 	}
-	topics := commons.ValidatorTopicID(pk)
-	for _, topic := range topics {
-		if err := n.topicsCtrl.Unsubscribe(logger, topic, false); err != nil {
-			return err
-		}
-	}
 	n.activeValidators.Del(pkHex)
+	n.subnets.Remove(pkHex, time.Now())
 	return nil
 }
 
-// subscribe to validator topics, as defined in the fork
+// subscribe to validator topics, as defined in the subscribed forks
 func (n *p2pNetwork) subscribe(logger *zap.Logger, pk spectypes.ValidatorPK) error {
-	topics := commons.ValidatorTopicID(pk)
-	for _, topic := range topics {
-		if err := n.topicsCtrl.Subscribe(logger, topic); err != nil {
-			// return errors.Wrap(err, "could not broadcast message")
-			return err
+	for _, fork := range n.subscribedForks() {
+		for _, topic := range forkValidatorTopics(fork, pk) {
+			if err := n.topicsCtrl.Subscribe(logger, topic); err != nil {
+				// return errors.Wrap(err, "could not broadcast message")
+				return err
+			}
 		}
 	}
 	return nil
@@ -169,17 +169,17 @@ func (n *p2pNetwork) handlePubsubMessages(logger *zap.Logger) func(topic string,
 
 // subscribeToSubnets subscribes to all the node's subnets
 func (n *p2pNetwork) subscribeToSubnets(logger *zap.Logger) error {
-	if len(n.subnets) == 0 {
-		return nil
-	}
-	logger.Debug("subscribing to subnets", fields.Subnets(n.subnets))
-	for i, val := range n.subnets {
-		if val > 0 {
-			subnet := fmt.Sprintf("%d", i)
-			if err := n.topicsCtrl.Subscribe(logger, subnet); err != nil {
-				logger.Warn("could not subscribe to subnet",
-					zap.String("subnet", subnet), zap.Error(err))
-				// TODO: handle error
+	subnets := n.subnets.Subnets()
+	logger.Debug("subscribing to subnets", fields.Subnets(subnets))
+	for _, fork := range n.subscribedForks() {
+		for i, val := range subnets {
+			if val > 0 && uint64(i) < fork.SubnetsCount {
+				subnet := forkSubnetTopic(fork, i)
+				if err := n.topicsCtrl.Subscribe(logger, subnet); err != nil {
+					logger.Warn("could not subscribe to subnet",
+						zap.String("subnet", subnet), zap.Error(err))
+					// TODO: handle error
+				}
 			}
 		}
 	}
@@ -7,9 +7,11 @@ import (
 	"github.com/bloxapp/ssv/network/commons"
 
 	spectypes "github.com/bloxapp/ssv-spec/types"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"go.uber.org/zap"
 
 	ssvpeers "github.com/bloxapp/ssv/network/peers"
+	"github.com/bloxapp/ssv/networkconfig"
 	protocolp2p "github.com/bloxapp/ssv/protocol/v2/p2p"
 )
 
@@ -19,23 +21,39 @@ func (n *p2pNetwork) ReportValidation(logger *zap.Logger, msg *spectypes.SSVMess
 	if !n.isReady() {
 		return
 	}
-	data, err := commons.EncodeNetworkMsg(msg)
-	if err != nil {
-		logger.Warn("could not encode message", zap.Error(err))
+	if n.reportSyncValidation(logger, msg, res) {
 		return
 	}
-	peers := n.msgResolver.GetPeers(data)
+	// the message might have been received in the encoding of any of the subscribed forks
+	var peers []peer.ID
+	encodings := make(map[networkconfig.MessageEncoding]struct{})
+	for _, fork := range n.subscribedForks() {
+		if _, ok := encodings[fork.Encoding]; ok {
+			continue
+		}
+		encodings[fork.Encoding] = struct{}{}
+		data, err := commons.EncodeNetworkMsgWith(msg, fork.Encoding)
+		if err != nil {
+			logger.Warn("could not encode message", zap.Error(err))
+			return
+		}
+		peers = append(peers, n.msgResolver.GetPeers(data)...)
+	}
 	for _, pi := range peers {
-		err := n.idx.Score(pi, &ssvpeers.NodeScore{Name: "validation", Value: msgValidationScore(res)})
+		err := n.idx.Score(pi, &ssvpeers.NodeScore{Name: validationScoreName, Value: msgValidationScore(res)})
 		if err != nil {
 			logger.Warn("could not score peer", fields.PeerID(pi), zap.Error(err))
 			continue
 		}
+		if res == protocolp2p.ValidationRejectHigh {
+			n.banPeer(logger, pi, "message rejected by validation")
+		}
 	}
 }
 
 const (
-	validationScoreLow = 5.0
+	validationScoreName = "validation"
+	validationScoreLow  = 5.0
 )
 
 func msgValidationScore(res protocolp2p.MsgValidationResult) float64 {
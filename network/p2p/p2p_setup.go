@@ -84,6 +84,9 @@ func (n *p2pNetwork) initCfg() error {
 	if n.cfg.RequestTimeout == 0 {
 		n.cfg.RequestTimeout = defaultReqTimeout
 	}
+	if n.cfg.SubnetsGracePeriod == 0 {
+		n.cfg.SubnetsGracePeriod = defaultSubnetsGracePeriod
+	}
 	if len(n.cfg.UserAgent) == 0 {
 		n.cfg.UserAgent = userAgent(n.cfg.UserAgent)
 	}
@@ -93,7 +96,7 @@ func (n *p2pNetwork) initCfg() error {
 		if err != nil {
 			return fmt.Errorf("parse subnet: %w", err)
 		}
-		n.subnets = subnets
+		n.subnets.SetStatic(subnets)
 	}
 	if n.cfg.MaxPeers <= 0 {
 		n.cfg.MaxPeers = minPeersBuffer
@@ -101,6 +104,18 @@ func (n *p2pNetwork) initCfg() error {
 	if n.cfg.TopicMaxPeers <= 0 {
 		n.cfg.TopicMaxPeers = minPeersBuffer / 2
 	}
+	trustedPeers, err := parseTrustedPeers(n.cfg.TrustedPeers)
+	if err != nil {
+		return fmt.Errorf("parse trusted peers: %w", err)
+	}
+	n.trustedPeers = trustedPeers
+	if n.cfg.BanList == nil {
+		banList, err := peers.NewBanList(nil)
+		if err != nil {
+			return fmt.Errorf("create ban list: %w", err)
+		}
+		n.cfg.BanList = banList
+	}
 
 	return nil
 }
@@ -119,10 +134,26 @@ func (n *p2pNetwork) SetupHost(logger *zap.Logger) error {
 		return errors.Wrap(err, "could not create resource manager")
 	}
 	opts = append(opts, libp2p.ResourceManager(rmgr))
+
+	ipv6 := n.cfg.EnableIPv6
+	if ipAddr, err := p2pcommons.IPAddr(); err == nil && ipAddr.To4() == nil {
+		// IPv6-only host
+		ipv6 = true
+	}
+	connGater := connections.NewConnectionGater(logger, &connections.ConnGaterCfg{
+		BanList:           n.cfg.BanList,
+		TrustedPeers:      n.trustedPeerIDs(),
+		IPv6:              ipv6,
+		MaxPeersPerIP:     n.cfg.MaxPeersPerIP,
+		MaxPeersPerSubnet: n.cfg.MaxPeersPerSubnet,
+	})
+	opts = append(opts, libp2p.ConnectionGater(connGater))
+
 	host, err := libp2p.New(opts...)
 	if err != nil {
 		return errors.Wrap(err, "could not create p2p host")
 	}
+	host.Network().Notify(connGater)
 	n.host = host
 	n.libConnManager = host.ConnManager()
 
@@ -166,20 +197,23 @@ func (n *p2pNetwork) setupPeerServices(logger *zap.Logger) error {
 		return err
 	}
 
-	domain := "0x" + hex.EncodeToString(n.cfg.Network.Domain[:])
+	forkDomain := n.activeFork().Domain
+	domain := "0x" + hex.EncodeToString(forkDomain[:])
 	self := records.NewNodeInfo(domain)
 	self.Metadata = &records.NodeMetadata{
 		OperatorID:  n.cfg.OperatorID,
 		NodeVersion: commons.GetNodeVersion(),
-		Subnets:     records.Subnets(n.subnets).String(),
+		Subnets:     n.subnets.Subnets().String(),
 	}
 	getPrivKey := func() crypto.PrivKey {
 		return libPrivKey
 	}
 
-	n.idx = peers.NewPeersIndex(logger, n.host.Network(), self, n.getMaxPeers, getPrivKey, p2pcommons.Subnets(), 10*time.Minute)
+	n.idx = peers.NewPeersIndex(logger, n.host.Network(), self, n.getMaxPeers, getPrivKey, p2pcommons.Subnets(), 10*time.Minute, n.trustedPeerIDs()...)
 	logger.Debug("peers index is ready")
 
+	n.setupTrustedPeers()
+
 	var ids identify.IDService
 	if bh, ok := n.host.(*basichost.BasicHost); ok {
 		ids = bh.IDService()
@@ -192,12 +226,12 @@ func (n *p2pNetwork) setupPeerServices(logger *zap.Logger) error {
 	}
 
 	subnetsProvider := func() records.Subnets {
-		return n.subnets
+		return n.subnets.Subnets()
 	}
 
 	filters := func() []connections.HandshakeFilter {
 		filters := []connections.HandshakeFilter{
-			connections.NetworkIDFilter(domain),
+			connections.NetworkIDFilter(n.networkIDs()...),
 		}
 
 		if n.cfg.Permissioned() {
@@ -222,10 +256,12 @@ func (n *p2pNetwork) setupPeerServices(logger *zap.Logger) error {
 		Permissioned:    n.cfg.Permissioned,
 	}, filters)
 
-	n.host.SetStreamHandler(peers.NodeInfoProtocol, handshaker.Handler(logger))
+	handshakeHandler := handshaker.Handler(logger)
+	n.host.SetStreamHandler(peers.NodeInfoProtocol, handshakeHandler)
+	n.host.SetStreamHandler(peers.NodeInfoProtocolSSZSnappy, handshakeHandler)
 	logger.Debug("handshaker is ready")
 
-	n.connHandler = connections.NewConnHandler(n.ctx, handshaker, subnetsProvider, n.idx, n.idx, n.idx)
+	n.connHandler = connections.NewConnHandler(n.ctx, handshaker, subnetsProvider, n.idx, n.idx, n.idx, n.cfg.BanList)
 	n.host.Network().Notify(n.connHandler.Handle(logger))
 	logger.Debug("connection handler is ready")
 
@@ -239,9 +275,13 @@ func (n *p2pNetwork) setupDiscovery(logger *zap.Logger) error {
 	}
 	var discV5Opts *discovery.DiscV5Options
 	if n.cfg.Discovery != localDiscvery { // otherwise, we are in local scenario
+		bindIP := net.IPv4zero
+		if n.cfg.EnableIPv6 || ipAddr.To4() == nil {
+			bindIP = net.IPv6zero
+		}
 		discV5Opts = &discovery.DiscV5Options{
 			IP:            ipAddr.String(),
-			BindIP:        net.IPv4zero.String(),
+			BindIP:        bindIP.String(),
 			Port:          n.cfg.UDPPort,
 			TCPPort:       n.cfg.TCPPort,
 			NetworkKey:    n.cfg.NetworkPrivateKey,
@@ -249,9 +289,17 @@ func (n *p2pNetwork) setupDiscovery(logger *zap.Logger) error {
 			OperatorID:    n.cfg.OperatorID,
 			EnableLogging: n.cfg.DiscoveryTrace,
 		}
-		if len(n.subnets) > 0 {
-			discV5Opts.Subnets = n.subnets
+		if n.cfg.EnableQUIC {
+			discV5Opts.QUICPort = n.cfg.quicPort()
+		}
+		ipv6Addr, err := n.cfg.ipv6Addr()
+		if err != nil {
+			return errors.Wrap(err, "could not get ipv6 addr")
+		}
+		if ipv6Addr != nil {
+			discV5Opts.IPv6 = ipv6Addr.String()
 		}
+		discV5Opts.Subnets = n.subnets.Subnets()
 		logger.Info("discovery: using discv5", zap.Strings("bootnodes", discV5Opts.Bootnodes))
 	} else {
 		logger.Info("discovery: using mdns (local)")
@@ -290,6 +338,7 @@ func (n *p2pNetwork) setupPubsub(logger *zap.Logger) error {
 		ValidateThrottle:    n.cfg.PubsubValidateThrottle,
 		MsgIDCacheTTL:       n.cfg.PubsubMsgCacheTTL,
 		GetValidatorStats:   n.cfg.GetValidatorStats,
+		Topics:              n.forksTopics(),
 	}
 
 	if !n.cfg.PubSubScoring {
@@ -0,0 +1,202 @@
+package p2pv1
+
+import (
+	"encoding/hex"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/bloxapp/ssv/logging"
+	"github.com/bloxapp/ssv/network/commons"
+	"github.com/bloxapp/ssv/network/peers"
+	"github.com/bloxapp/ssv/network/records"
+)
+
+const (
+	// defaultSubnetsGracePeriod is the default time to stay in a subnet after its last validator was stopped
+	defaultSubnetsGracePeriod = 5 * time.Minute
+	// releaseSubnetsInterval is the interval for leaving the subnets whose grace period is over
+	releaseSubnetsInterval = 30 * time.Second
+)
+
+// subnetsTracker tracks the subnets of the node, which are its static subnets and the subnets of its active validators.
+// A subnet whose last validator was removed is released, and left only once its grace period is over,
+// so that validators which are restarted or reactivated shortly after they are stopped don't churn it.
+type subnetsTracker struct {
+	mu         sync.Mutex
+	static     records.Subnets
+	validators map[string]int
+	counts     []int
+	released   map[int]time.Time
+}
+
+func newSubnetsTracker() *subnetsTracker {
+	return &subnetsTracker{
+		static:     make(records.Subnets, commons.Subnets()),
+		validators: make(map[string]int),
+		counts:     make([]int, commons.Subnets()),
+		released:   make(map[int]time.Time),
+	}
+}
+
+// SetStatic sets the static subnets, which are never left.
+func (t *subnetsTracker) SetStatic(subnets records.Subnets) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.static = make(records.Subnets, commons.Subnets())
+	copy(t.static, subnets)
+	for subnet := range t.released {
+		if t.static[subnet] > 0 {
+			delete(t.released, subnet)
+		}
+	}
+}
+
+// Add adds the validator to the given subnet, and returns true if the node wasn't in the subnet.
+func (t *subnetsTracker) Add(pkHex string, subnet int) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.validators[pkHex]; ok {
+		return false
+	}
+	joined := !t.has(subnet)
+	t.validators[pkHex] = subnet
+	t.counts[subnet]++
+	delete(t.released, subnet)
+	return joined
+}
+
+// Remove removes the validator, and releases its subnet if no other validator is in it.
+func (t *subnetsTracker) Remove(pkHex string, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	subnet, ok := t.validators[pkHex]
+	if !ok {
+		return
+	}
+	delete(t.validators, pkHex)
+	t.counts[subnet]--
+	if t.counts[subnet] == 0 && t.static[subnet] == 0 {
+		t.released[subnet] = now
+	}
+}
+
+// Expire removes and returns the subnets which were released before the given time.
+func (t *subnetsTracker) Expire(before time.Time) []int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var expired []int
+	for subnet, releasedAt := range t.released {
+		if releasedAt.Before(before) {
+			expired = append(expired, subnet)
+			delete(t.released, subnet)
+		}
+	}
+	sort.Ints(expired)
+	return expired
+}
+
+// Subnets returns the subnets of the node, including released subnets which weren't left yet.
+func (t *subnetsTracker) Subnets() records.Subnets {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	subnets := make(records.Subnets, commons.Subnets())
+	for subnet := range subnets {
+		if t.has(subnet) {
+			subnets[subnet] = 1
+		}
+	}
+	return subnets
+}
+
+func (t *subnetsTracker) has(subnet int) bool {
+	_, released := t.released[subnet]
+	return t.static[subnet] > 0 || t.counts[subnet] > 0 || released
+}
+
+// subscribeValidator adds the validator to its subnet and subscribes to its topics,
+// the subnet is published if the node just joined it.
+func (n *p2pNetwork) subscribeValidator(logger *zap.Logger, pk []byte) error {
+	n.subnetsMu.Lock()
+	defer n.subnetsMu.Unlock()
+
+	pkHex := hex.EncodeToString(pk)
+	subnet := commons.ForkValidatorSubnet(pkHex, n.activeFork().SubnetsCount)
+	joined := n.subnets.Add(pkHex, subnet)
+	if err := n.subscribe(logger, pk); err != nil {
+		n.subnets.Remove(pkHex, time.Now())
+		return err
+	}
+	if joined {
+		n.publishSubnets(logger, []int{subnet}, nil)
+	}
+	return nil
+}
+
+// releaseSubnets leaves the subnets whose grace period is over.
+func (n *p2pNetwork) releaseSubnets(logger *zap.Logger) func() {
+	return func() {
+		n.subnetsMu.Lock()
+		defer n.subnetsMu.Unlock()
+
+		expired := n.subnets.Expire(time.Now().Add(-n.cfg.SubnetsGracePeriod))
+		if len(expired) == 0 {
+			return
+		}
+		for _, fork := range n.subscribedForks() {
+			for _, subnet := range expired {
+				if uint64(subnet) >= fork.SubnetsCount {
+					continue
+				}
+				topic := forkSubnetTopic(fork, subnet)
+				if err := n.topicsCtrl.Unsubscribe(logger, topic, false); err != nil {
+					logger.Warn("could not unsubscribe from subnet", zap.String("topic", topic), zap.Error(err))
+				}
+			}
+		}
+		n.publishSubnets(logger, nil, expired)
+	}
+}
+
+// publishSubnets publishes the subnets of the node once they changed,
+// and re-tags the best peers according to the new subnets. It must be called with subnetsMu held.
+func (n *p2pNetwork) publishSubnets(logger *zap.Logger, added, removed []int) {
+	start := time.Now()
+	subnets := n.subnets.Subnets()
+
+	self := n.idx.Self()
+	self.Metadata.Subnets = subnets.String()
+	n.idx.UpdateSelfRecord(self)
+
+	discLogger := logger.Named(logging.NameDiscoveryService)
+	if len(added) > 0 {
+		if err := n.disc.RegisterSubnets(discLogger, added...); err != nil {
+			logger.Warn("could not register subnets", zap.Ints("subnets", added), zap.Error(err))
+		}
+	}
+	if len(removed) > 0 {
+		if err := n.disc.DeregisterSubnets(discLogger, removed...); err != nil {
+			logger.Warn("could not deregister subnets", zap.Ints("subnets", removed), zap.Error(err))
+		}
+	}
+
+	connMgr := peers.NewConnManager(logger, n.libConnManager, n.idx)
+	connMgr.TagBestPeers(logger, n.cfg.MaxPeers-1, subnets, n.host.Network().Peers(), n.cfg.TopicMaxPeers)
+
+	allSubs, _ := records.Subnets{}.FromString(records.AllSubnets)
+	subnetsList := records.SharedSubnets(allSubs, subnets, 0)
+	logger.Debug("updated subnets",
+		zap.Ints("added", added),
+		zap.Ints("removed", removed),
+		zap.Any("subnets", subnetsList),
+		zap.Int("total_subnets", len(subnetsList)),
+		zap.Duration("took", time.Since(start)),
+	)
+}
@@ -0,0 +1,50 @@
+package p2pv1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bloxapp/ssv/network/records"
+)
+
+func TestSubnetsTracker(t *testing.T) {
+	tracker := newSubnetsTracker()
+	static := make(records.Subnets, len(tracker.counts))
+	static[5] = 1
+	tracker.SetStatic(static)
+
+	now := time.Now()
+	require.True(t, tracker.Add("aa", 1))
+	require.False(t, tracker.Add("bb", 1), "subnet is already joined")
+	require.False(t, tracker.Add("aa", 1), "validator is already added")
+	require.False(t, tracker.Add("cc", 5), "static subnets are always joined")
+	require.Equal(t, []byte{0, 1, 0, 0, 0, 1}, []byte(tracker.Subnets()[:6]))
+
+	// subnets are released once their last validator is removed
+	tracker.Remove("aa", now)
+	require.Empty(t, tracker.Expire(now.Add(time.Minute)))
+	tracker.Remove("bb", now)
+	tracker.Remove("cc", now)
+	tracker.Remove("unknown", now)
+	require.Empty(t, tracker.Expire(now), "grace period isn't over")
+	require.Equal(t, byte(1), tracker.Subnets()[1], "released subnets are kept until they expire")
+
+	// a validator that is added during the grace period keeps the subnet
+	require.False(t, tracker.Add("aa", 1))
+	require.Empty(t, tracker.Expire(now.Add(time.Minute)))
+	tracker.Remove("aa", now)
+
+	require.Equal(t, []int{1}, tracker.Expire(now.Add(time.Minute)))
+	require.Empty(t, tracker.Expire(now.Add(time.Minute)))
+	require.Equal(t, []byte{0, 0, 0, 0, 0, 1}, []byte(tracker.Subnets()[:6]))
+	require.True(t, tracker.Add("aa", 1))
+
+	// subnets which become static aren't released
+	tracker.Remove("aa", now)
+	static[1] = 1
+	tracker.SetStatic(static)
+	require.Empty(t, tracker.Expire(now.Add(time.Minute)))
+	require.Equal(t, byte(1), tracker.Subnets()[1])
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/bloxapp/ssv/logging/fields"
 	"github.com/bloxapp/ssv/network/commons"
+	"github.com/bloxapp/ssv/network/streams"
 
 	"github.com/multiformats/go-multistream"
 
@@ -21,6 +22,7 @@ import (
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 
+	"github.com/bloxapp/ssv/networkconfig"
 	"github.com/bloxapp/ssv/protocol/v2/message"
 	p2pprotocol "github.com/bloxapp/ssv/protocol/v2/p2p"
 )
@@ -78,7 +80,7 @@ func (n *p2pNetwork) LastDecided(logger *zap.Logger, mid spectypes.MessageID) ([
 	if !n.isReady() {
 		return nil, p2pprotocol.ErrNetworkIsNotReady
 	}
-	pid, maxPeers := commons.ProtocolID(p2pprotocol.LastDecidedProtocol)
+	pid, maxPeers := commons.ForkProtocolID(p2pprotocol.LastDecidedProtocol, n.activeFork().SyncProtocolVersion)
 	peers, err := waitSubsetOfPeers(logger, n.getSubsetOfPeers, mid.GetPubKey(), minPeers, maxPeers, waitTime, allPeersFilter)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get subset of peers")
@@ -100,7 +102,7 @@ func (n *p2pNetwork) GetHistory(logger *zap.Logger, mid spectypes.MessageID, fro
 	if !n.isReady() {
 		return nil, 0, p2pprotocol.ErrNetworkIsNotReady
 	}
-	protocolID, peerCount := commons.ProtocolID(p2pprotocol.DecidedHistoryProtocol)
+	protocolID, peerCount := commons.ForkProtocolID(p2pprotocol.DecidedHistoryProtocol, n.activeFork().SyncProtocolVersion)
 	peers := make([]peer.ID, 0)
 	for _, t := range targets {
 		p, err := peer.Decode(t)
@@ -138,17 +140,24 @@ func (n *p2pNetwork) GetHistory(logger *zap.Logger, mid spectypes.MessageID, fro
 	return results, currentEnd, nil
 }
 
-// RegisterHandlers registers the given handlers
+// RegisterHandlers registers the given handlers, for the protocol versions of all the forks in the schedule
 func (n *p2pNetwork) RegisterHandlers(logger *zap.Logger, handlers ...*p2pprotocol.SyncHandler) {
 	m := make(map[libp2p_protocol.ID][]p2pprotocol.RequestHandler)
-	for _, handler := range handlers {
-		pid, _ := commons.ProtocolID(handler.Protocol)
-		current, ok := m[pid]
-		if !ok {
-			current = make([]p2pprotocol.RequestHandler, 0)
+	versions := make(map[string]struct{})
+	for _, fork := range n.cfg.Network.ForkSchedule() {
+		if _, ok := versions[fork.SyncProtocolVersion]; ok {
+			continue
+		}
+		versions[fork.SyncProtocolVersion] = struct{}{}
+		for _, handler := range handlers {
+			pid, _ := commons.ForkProtocolID(handler.Protocol, fork.SyncProtocolVersion)
+			current, ok := m[pid]
+			if !ok {
+				current = make([]p2pprotocol.RequestHandler, 0)
+			}
+			current = append(current, handler.Handler)
+			m[pid] = current
 		}
-		current = append(current, handler.Handler)
-		m[pid] = current
 	}
 
 	for pid, phandlers := range m {
@@ -156,40 +165,71 @@ func (n *p2pNetwork) RegisterHandlers(logger *zap.Logger, handlers ...*p2pprotoc
 	}
 }
 
+// registerHandlers registers the given handlers for the given protocol, and for its snappy compressed variant
 func (n *p2pNetwork) registerHandlers(logger *zap.Logger, pid libp2p_protocol.ID, handlers ...p2pprotocol.RequestHandler) {
 	handler := p2pprotocol.CombineRequestHandlers(handlers...)
 	streamHandler := n.handleStream(logger, handler)
-	n.host.SetStreamHandler(pid, func(stream libp2pnetwork.Stream) {
-		err := streamHandler(stream)
-		if err != nil {
-			logger.Debug("stream handler failed", zap.Error(err))
-		}
-	})
+	for _, id := range []libp2p_protocol.ID{pid, commons.SnappyProtocolID(pid)} {
+		n.host.SetStreamHandler(id, func(stream libp2pnetwork.Stream) {
+			err := streamHandler(stream)
+			if err != nil {
+				logger.Debug("stream handler failed", zap.Error(err))
+			}
+		})
+	}
 }
 
 func (n *p2pNetwork) handleStream(logger *zap.Logger, handler p2pprotocol.RequestHandler) func(stream libp2pnetwork.Stream) error {
 	return func(stream libp2pnetwork.Stream) error {
+		pid := stream.Conn().RemotePeer()
+		prot := stream.Protocol()
+		release, err := n.syncLimiter.Acquire(pid, prot)
+		if err != nil {
+			// requests that exceeded the rate limit are only dropped, penalties are reserved for invalid requests
+			if errors.Is(err, streams.ErrRateLimited) {
+				reportSyncRequest(prot, syncResultRateLimited)
+			} else {
+				reportSyncRequest(prot, syncResultBusy)
+			}
+			_ = stream.Reset()
+			return errors.Wrap(err, "could not handle stream")
+		}
+		defer release()
+
 		req, respond, done, err := n.streamCtrl.HandleStream(logger, stream)
 		defer done()
 
 		if err != nil {
+			reportSyncRequest(prot, syncResultFailed)
 			return errors.Wrap(err, "could not handle stream")
 		}
 		smsg, err := commons.DecodeNetworkMsg(req)
 		if err != nil {
+			reportSyncRequest(prot, syncResultInvalid)
+			n.penalizeSyncPeer(logger, pid, -msgValidationScore(p2pprotocol.ValidationRejectMedium), "malformed sync request")
 			return errors.Wrap(err, "could not decode msg from stream")
 		}
+		syncReq := n.trackSyncRequest(smsg, pid)
 		result, err := handler(smsg)
+		n.untrackSyncRequest(smsg)
 		if err != nil {
+			reportSyncRequest(prot, syncResultFailed)
 			return errors.Wrap(err, "could not handle msg from stream")
 		}
-		resultBytes, err := commons.EncodeNetworkMsg(result)
+		resultBytes, err := commons.EncodeNetworkMsgWith(result, streamEncoding(prot))
 		if err != nil {
+			reportSyncRequest(prot, syncResultFailed)
 			return errors.Wrap(err, "could not encode msg")
 		}
 		if err := respond(resultBytes); err != nil {
+			reportSyncRequest(prot, syncResultFailed)
 			return errors.Wrap(err, "could not respond to stream")
 		}
+		if syncReq.rejected.Load() {
+			reportSyncRequest(prot, syncResultInvalid)
+		} else {
+			reportSyncRequest(prot, syncResultServed)
+		}
 		return nil
 	}
 }
@@ -198,7 +238,7 @@ func (n *p2pNetwork) handleStream(logger *zap.Logger, handler p2pprotocol.Reques
 func (n *p2pNetwork) getSubsetOfPeers(logger *zap.Logger, vpk spectypes.ValidatorPK, maxPeers int, filter func(peer.ID) bool) (peers []peer.ID, err error) {
 	var ps []peer.ID
 	seen := make(map[peer.ID]struct{})
-	topics := commons.ValidatorTopicID(vpk)
+	topics := forkValidatorTopics(n.activeFork(), vpk)
 	for _, topic := range topics {
 		ps, err = n.topicsCtrl.Peers(topic)
 		if err != nil {
@@ -226,9 +266,25 @@ func (n *p2pNetwork) getSubsetOfPeers(logger *zap.Logger, vpk spectypes.Validato
 	return peers[:maxPeers], nil
 }
 
-func (n *p2pNetwork) makeSyncRequest(logger *zap.Logger, peers []peer.ID, mid spectypes.MessageID, protocol libp2p_protocol.ID, syncMsg *message.SyncMessage) ([]p2pprotocol.SyncResult, error) {
-	var results []p2pprotocol.SyncResult
-	data, err := syncMsg.Encode()
+// streamEncoding returns the encoding of the payloads in the given stream protocol
+func streamEncoding(pid libp2p_protocol.ID) networkconfig.MessageEncoding {
+	if commons.IsSnappyProtocol(pid) {
+		return networkconfig.EncodingSSZSnappy
+	}
+	return networkconfig.EncodingSSZ
+}
+
+// encodeSyncRequest encodes the given sync message for the given stream protocol,
+// snappy protocols carry SSZ encoded sync messages while the legacy protocols carry JSON
+func encodeSyncRequest(mid spectypes.MessageID, syncMsg *message.SyncMessage, pid libp2p_protocol.ID) ([]byte, error) {
+	encoding := streamEncoding(pid)
+	var data []byte
+	var err error
+	if encoding.Compressed() {
+		data, err = syncMsg.EncodeSSZ(false)
+	} else {
+		data, err = syncMsg.Encode()
+	}
 	if err != nil {
 		return nil, errors.Wrap(err, "could not encode sync message")
 	}
@@ -237,16 +293,35 @@ func (n *p2pNetwork) makeSyncRequest(logger *zap.Logger, peers []peer.ID, mid sp
 		MsgID:   mid,
 		Data:    data,
 	}
-	encoded, err := commons.EncodeNetworkMsg(msg)
-	if err != nil {
+	return commons.EncodeNetworkMsgWith(msg, encoding)
+}
+
+func (n *p2pNetwork) makeSyncRequest(logger *zap.Logger, peers []peer.ID, mid spectypes.MessageID, protocol libp2p_protocol.ID, syncMsg *message.SyncMessage) ([]p2pprotocol.SyncResult, error) {
+	var results []p2pprotocol.SyncResult
+	// the encoded requests are cached by protocol, as they are the same for all peers
+	encoded := make(map[libp2p_protocol.ID][]byte)
+	encode := func(pid libp2p_protocol.ID) ([]byte, error) {
+		if data, ok := encoded[pid]; ok {
+			return data, nil
+		}
+		data, err := encodeSyncRequest(mid, syncMsg, pid)
+		if err != nil {
+			return nil, err
+		}
+		encoded[pid] = data
+		return data, nil
+	}
+	if _, err := encode(protocol); err != nil {
 		return nil, err
 	}
+	// prefer the snappy variant of the protocol, peers that don't support it yet fall back to the legacy protocol
+	protocols := []libp2p_protocol.ID{commons.SnappyProtocolID(protocol), protocol}
 	logger = logger.With(zap.String("protocol", string(protocol)))
 	msgID := commons.MsgID()
 	distinct := make(map[string]struct{})
 	for _, pid := range peers {
 		logger := logger.With(fields.PeerID(pid))
-		raw, err := n.streamCtrl.Request(logger, pid, protocol, encoded)
+		raw, _, err := n.streamCtrl.NegotiateRequest(logger, pid, protocols, encode)
 		if err != nil {
 			// TODO: is this how to check for ErrNotSupported?
 			var e multistream.ErrNotSupported[libp2p_protocol.ID]
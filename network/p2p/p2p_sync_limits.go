@@ -0,0 +1,130 @@
+package p2pv1
+
+import (
+	"sync/atomic"
+	"time"
+
+	spectypes "github.com/bloxapp/ssv-spec/types"
+	"github.com/libp2p/go-libp2p/core/peer"
+	libp2p_protocol "github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+
+	"github.com/bloxapp/ssv/logging/fields"
+	ssvpeers "github.com/bloxapp/ssv/network/peers"
+	protocolp2p "github.com/bloxapp/ssv/protocol/v2/p2p"
+)
+
+const (
+	// syncLimitsInterval is the interval for decaying sync penalties and pruning idle rate limiters
+	syncLimitsInterval = time.Minute
+	// syncPenaltyDecay is the factor that sync penalties are multiplied by on every interval
+	syncPenaltyDecay = 0.5
+	// syncPenaltyBanThreshold is the accumulated penalty that gets a peer banned,
+	// e.g. a single request rejected with high severity or 25 requests rejected with low severity
+	syncPenaltyBanThreshold = 125.0
+	// syncPenaltyMin is the penalty below which a decayed sync penalty is forgotten
+	syncPenaltyMin = 1.0
+	// syncScoreName is the name of the peers score that reflects the sync penalty
+	syncScoreName = "sync"
+)
+
+const (
+	syncResultServed      = "served"
+	syncResultRateLimited = "rate_limited"
+	syncResultBusy        = "busy"
+	syncResultInvalid     = "invalid"
+	syncResultFailed      = "failed"
+)
+
+var metricsSyncRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "ssv:network:sync:requests",
+	Help: "Counts incoming sync requests by protocol and result",
+}, []string{"protocol", "result"})
+
+func init() {
+	if err := prometheus.Register(metricsSyncRequests); err != nil {
+		zap.L().Debug("could not register prometheus collector")
+	}
+}
+
+func reportSyncRequest(prot libp2p_protocol.ID, result string) {
+	metricsSyncRequests.WithLabelValues(string(prot), result).Inc()
+}
+
+// syncRequest is a sync request that is being handled
+type syncRequest struct {
+	sender   peer.ID
+	rejected atomic.Bool
+}
+
+// trackSyncRequest maps the given request to its sender until untrack is called,
+// so validation results reported by handlers can be applied to the sender
+func (n *p2pNetwork) trackSyncRequest(msg *spectypes.SSVMessage, pid peer.ID) *syncRequest {
+	req := &syncRequest{sender: pid}
+	n.syncRequests.Store(msg, req)
+	return req
+}
+
+// untrackSyncRequest removes the given request once it was handled
+func (n *p2pNetwork) untrackSyncRequest(msg *spectypes.SSVMessage) {
+	n.syncRequests.Delete(msg)
+}
+
+// reportSyncValidation penalizes the sender of the given message in case it is a sync request that is being handled,
+// it returns false if the message is not a sync request
+func (n *p2pNetwork) reportSyncValidation(logger *zap.Logger, msg *spectypes.SSVMessage, res protocolp2p.MsgValidationResult) bool {
+	v, ok := n.syncRequests.Load(msg)
+	if !ok {
+		return false
+	}
+	req := v.(*syncRequest)
+	penalty := -msgValidationScore(res)
+	if penalty > 0 {
+		req.rejected.Store(true)
+		n.penalizeSyncPeer(logger, req.sender, penalty, "invalid sync request")
+	}
+	return true
+}
+
+// penalizeSyncPeer adds the given penalty to the peer, which gets banned once the accumulated penalty
+// reaches syncPenaltyBanThreshold. penalties decay over time, see decaySyncLimits
+func (n *p2pNetwork) penalizeSyncPeer(logger *zap.Logger, pid peer.ID, penalty float64, reason string) {
+	n.syncPenaltiesLock.Lock()
+	total := n.syncPenalties[pid] + penalty
+	ban := total >= syncPenaltyBanThreshold
+	if ban {
+		delete(n.syncPenalties, pid)
+	} else {
+		n.syncPenalties[pid] = total
+	}
+	n.syncPenaltiesLock.Unlock()
+
+	if err := n.idx.Score(pid, &ssvpeers.NodeScore{Name: syncScoreName, Value: -total}); err != nil {
+		logger.Warn("could not score peer", fields.PeerID(pid), zap.Error(err))
+	}
+	if ban {
+		n.banPeer(logger, pid, reason)
+	}
+}
+
+// decaySyncLimits decays the sync penalties of peers, and prunes the rate limiters of idle peers
+func (n *p2pNetwork) decaySyncLimits(logger *zap.Logger) func() {
+	return func() {
+		n.syncPenaltiesLock.Lock()
+		for pid, penalty := range n.syncPenalties {
+			penalty *= syncPenaltyDecay
+			if penalty < syncPenaltyMin {
+				delete(n.syncPenalties, pid)
+				continue
+			}
+			n.syncPenalties[pid] = penalty
+		}
+		n.syncPenaltiesLock.Unlock()
+
+		if pruned := n.syncLimiter.Prune(time.Now().Add(-syncLimitsInterval)); pruned > 0 {
+			logger.Debug("pruned idle sync rate limiters", zap.Int("pruned", pruned))
+		}
+	}
+}
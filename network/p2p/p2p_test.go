@@ -18,6 +18,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/bloxapp/ssv/network"
+	"github.com/bloxapp/ssv/protocol/v2/message"
 	protcolp2p "github.com/bloxapp/ssv/protocol/v2/p2p"
 	"github.com/bloxapp/ssv/protocol/v2/types"
 )
@@ -152,6 +153,43 @@ func TestP2pNetwork_Stream(t *testing.T) {
 	require.GreaterOrEqual(t, msgCounter, int64(2))
 }
 
+func TestP2pNetwork_SyncPenalties(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	logger := logging.TestLogger(t)
+
+	ln, err := CreateAndStartLocalNet(ctx, logger, 2, 1, false)
+	require.NoError(t, err)
+	node := ln.Nodes[0].(*p2pNetwork)
+	pid := ln.Nodes[1].(*p2pNetwork).host.ID()
+
+	msg := &spectypes.SSVMessage{MsgType: message.SSVSyncMsgType}
+	req := node.trackSyncRequest(msg, pid)
+	require.True(t, node.reportSyncValidation(logger, msg, protcolp2p.ValidationRejectMedium))
+	require.True(t, req.rejected.Load())
+	node.untrackSyncRequest(msg)
+	require.False(t, node.reportSyncValidation(logger, msg, protcolp2p.ValidationRejectMedium))
+
+	scores, err := node.idx.GetScore(pid, syncScoreName)
+	require.NoError(t, err)
+	require.Len(t, scores, 1)
+	require.Equal(t, msgValidationScore(protcolp2p.ValidationRejectMedium), scores[0].Value)
+	require.False(t, node.cfg.BanList.IsBannedPeer(pid))
+
+	// penalties decay over time
+	node.decaySyncLimits(logger)()
+	require.Equal(t, scores[0].Value*-syncPenaltyDecay, node.syncPenalties[pid])
+
+	// the accumulated penalty reaches the threshold
+	node.penalizeSyncPeer(logger, pid, syncPenaltyBanThreshold-node.syncPenalties[pid], "test")
+	require.True(t, node.cfg.BanList.IsBannedPeer(pid))
+	require.NotContains(t, node.syncPenalties, pid)
+
+	for _, n := range ln.Nodes {
+		require.NoError(t, n.(*p2pNetwork).Close())
+	}
+}
+
 func TestWaitSubsetOfPeers(t *testing.T) {
 	logger, _ := zap.NewProduction()
 
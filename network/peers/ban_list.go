@@ -0,0 +1,306 @@
+package peers
+
+import (
+	"encoding/json"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+
+	"github.com/bloxapp/ssv/storage/basedb"
+)
+
+const (
+	// banBaseDuration is the duration of the first automatic ban of a peer
+	banBaseDuration = 5 * time.Minute
+	// banMaxDuration is the maximum duration of an automatic ban
+	banMaxDuration = 24 * time.Hour
+	// banOffensesTTL is the amount of time after a ban expires, before the offenses of the peer are forgotten
+	banOffensesTTL = 24 * time.Hour
+)
+
+var (
+	// banListPrefix is the db prefix of bans
+	banListPrefix = []byte("p2p-bans/")
+)
+
+// Ban is a ban of a peer, an IP or a subnet (CIDR)
+type Ban struct {
+	// Target is the banned peer ID, IP or CIDR
+	Target string `json:"target"`
+	// Reason is the reason of the ban
+	Reason string `json:"reason"`
+	// Created is the time of the (last) ban
+	Created time.Time `json:"created"`
+	// Expiry is the time the ban expires, zero means that the ban is permanent
+	Expiry time.Time `json:"expiry,omitempty"`
+	// Offenses is the amount of times the target was banned automatically
+	Offenses int `json:"offenses"`
+
+	peerID peer.ID
+	ipNet  *net.IPNet
+}
+
+// Active returns whether the ban is active at the given time
+func (b *Ban) Active(t time.Time) bool {
+	return b.Expiry.IsZero() || t.Before(b.Expiry)
+}
+
+// Matches returns whether the given peer or IP is covered by the ban
+func (b *Ban) Matches(id peer.ID, ip net.IP) bool {
+	if len(b.peerID) > 0 {
+		return b.peerID == id
+	}
+	return ip != nil && b.ipNet != nil && b.ipNet.Contains(ip)
+}
+
+// parse parses the target of the ban into a peer ID or an IP network
+func (b *Ban) parse() error {
+	if id, err := peer.Decode(b.Target); err == nil {
+		b.peerID = id
+		return nil
+	}
+	if _, ipNet, err := net.ParseCIDR(b.Target); err == nil {
+		b.ipNet = ipNet
+		return nil
+	}
+	ip := net.ParseIP(b.Target)
+	if ip == nil {
+		return errors.Errorf("invalid ban target %s: expected a peer ID, IP or CIDR", b.Target)
+	}
+	bits := 8 * net.IPv6len
+	if ip4 := ip.To4(); ip4 != nil {
+		ip, bits = ip4, 8*net.IPv4len
+	}
+	b.ipNet = &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+	return nil
+}
+
+// BanList manages banned peers, IPs and subnets.
+// Bans are persisted, so they survive restarts.
+type BanList interface {
+	// BanPeer bans the given peer automatically,
+	// the duration of the ban grows exponentially with each offense
+	BanPeer(id peer.ID, reason string) (*Ban, error)
+	// AddBan bans the given target (peer ID, IP or CIDR) for the given duration, zero duration means a permanent ban
+	AddBan(target, reason string, duration time.Duration) (*Ban, error)
+	// RemoveBan removes the ban of the given target
+	RemoveBan(target string) error
+	// IsBannedPeer returns whether the given peer is banned
+	IsBannedPeer(id peer.ID) bool
+	// IsBannedIP returns whether the given IP is banned, either directly or by a banned subnet
+	IsBannedIP(ip net.IP) bool
+	// Bans returns the active bans
+	Bans() []*Ban
+	// Prune removes expired bans whose offenses are no longer relevant
+	Prune() (int, error)
+}
+
+type banList struct {
+	db basedb.Database
+
+	lock sync.RWMutex
+	bans map[string]*Ban
+}
+
+// NewBanList creates a new BanList and loads existing bans from the given database.
+// db is optional, if nil is passed then bans are kept only in memory.
+func NewBanList(db basedb.Database) (BanList, error) {
+	bl := &banList{
+		db:   db,
+		bans: make(map[string]*Ban),
+	}
+	if db == nil {
+		return bl, nil
+	}
+	err := db.GetAll(banListPrefix, func(i int, obj basedb.Obj) error {
+		ban := &Ban{}
+		if err := json.Unmarshal(obj.Value, ban); err != nil {
+			return errors.Wrap(err, "could not unmarshal ban")
+		}
+		if err := ban.parse(); err != nil {
+			return err
+		}
+		bl.bans[ban.Target] = ban
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load bans")
+	}
+	return bl, nil
+}
+
+func (bl *banList) BanPeer(id peer.ID, reason string) (*Ban, error) {
+	bl.lock.Lock()
+	defer bl.lock.Unlock()
+
+	now := time.Now()
+	target := id.String()
+	offenses := 1
+	if existing, ok := bl.bans[target]; ok {
+		if existing.Expiry.IsZero() || existing.Active(now) {
+			// already banned
+			return existing, nil
+		}
+		if now.Sub(existing.Expiry) < banOffensesTTL {
+			offenses = existing.Offenses + 1
+		}
+	}
+	duration := banBaseDuration
+	for i := 1; i < offenses && duration < banMaxDuration; i++ {
+		duration *= 2
+	}
+	if duration > banMaxDuration {
+		duration = banMaxDuration
+	}
+	ban := &Ban{
+		Target:   target,
+		Reason:   reason,
+		Created:  now,
+		Expiry:   now.Add(duration),
+		Offenses: offenses,
+		peerID:   id,
+	}
+	if err := bl.save(ban); err != nil {
+		return nil, err
+	}
+	return ban, nil
+}
+
+func (bl *banList) AddBan(target, reason string, duration time.Duration) (*Ban, error) {
+	ban := &Ban{
+		Target:  target,
+		Reason:  reason,
+		Created: time.Now(),
+	}
+	if duration > 0 {
+		ban.Expiry = ban.Created.Add(duration)
+	}
+	if err := ban.parse(); err != nil {
+		return nil, err
+	}
+	// the normalized form of the target is used, so it can be removed by the same string that is listed
+	if ban.ipNet != nil && len(ban.peerID) == 0 {
+		ban.Target = ban.ipNet.String()
+	}
+
+	bl.lock.Lock()
+	defer bl.lock.Unlock()
+
+	if existing, ok := bl.bans[ban.Target]; ok {
+		ban.Offenses = existing.Offenses
+	}
+	if err := bl.save(ban); err != nil {
+		return nil, err
+	}
+	return ban, nil
+}
+
+func (bl *banList) RemoveBan(target string) error {
+	bl.lock.Lock()
+	defer bl.lock.Unlock()
+
+	if _, ok := bl.bans[target]; !ok {
+		// the target might have been given in a non-normalized form
+		ban := &Ban{Target: target}
+		if err := ban.parse(); err != nil {
+			return err
+		}
+		if ban.ipNet != nil {
+			target = ban.ipNet.String()
+		}
+	}
+	if _, ok := bl.bans[target]; !ok {
+		return errors.Errorf("ban of %s was not found", target)
+	}
+	return bl.delete(target)
+}
+
+func (bl *banList) IsBannedPeer(id peer.ID) bool {
+	bl.lock.RLock()
+	defer bl.lock.RUnlock()
+
+	ban, ok := bl.bans[id.String()]
+	return ok && ban.Active(time.Now())
+}
+
+func (bl *banList) IsBannedIP(ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+
+	bl.lock.RLock()
+	defer bl.lock.RUnlock()
+
+	now := time.Now()
+	for _, ban := range bl.bans {
+		if ban.ipNet != nil && ban.Active(now) && ban.ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func (bl *banList) Bans() []*Ban {
+	bl.lock.RLock()
+	defer bl.lock.RUnlock()
+
+	now := time.Now()
+	bans := make([]*Ban, 0, len(bl.bans))
+	for _, ban := range bl.bans {
+		if ban.Active(now) {
+			bans = append(bans, ban)
+		}
+	}
+	sort.Slice(bans, func(i, j int) bool {
+		return bans[i].Created.After(bans[j].Created)
+	})
+	return bans
+}
+
+func (bl *banList) Prune() (int, error) {
+	bl.lock.Lock()
+	defer bl.lock.Unlock()
+
+	now := time.Now()
+	var pruned int
+	for target, ban := range bl.bans {
+		if ban.Expiry.IsZero() || now.Sub(ban.Expiry) < banOffensesTTL {
+			continue
+		}
+		if err := bl.delete(target); err != nil {
+			return pruned, err
+		}
+		pruned++
+	}
+	return pruned, nil
+}
+
+// save saves the given ban, the caller must hold the lock
+func (bl *banList) save(ban *Ban) error {
+	if bl.db != nil {
+		value, err := json.Marshal(ban)
+		if err != nil {
+			return errors.Wrap(err, "could not marshal ban")
+		}
+		if err := bl.db.Set(banListPrefix, []byte(ban.Target), value); err != nil {
+			return errors.Wrap(err, "could not save ban")
+		}
+	}
+	bl.bans[ban.Target] = ban
+	return nil
+}
+
+// delete deletes the ban of the given target, the caller must hold the lock
+func (bl *banList) delete(target string) error {
+	if bl.db != nil {
+		if err := bl.db.Delete(banListPrefix, []byte(target)); err != nil {
+			return errors.Wrap(err, "could not delete ban")
+		}
+	}
+	delete(bl.bans, target)
+	return nil
+}
@@ -0,0 +1,88 @@
+package peers
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bloxapp/ssv/logging"
+	"github.com/bloxapp/ssv/storage/basedb"
+	"github.com/bloxapp/ssv/storage/kv"
+)
+
+func TestBanList(t *testing.T) {
+	db, err := kv.NewInMemory(logging.TestLogger(t), basedb.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	bl, err := NewBanList(db)
+	require.NoError(t, err)
+
+	pids, err := createPeerIDs(2)
+	require.NoError(t, err)
+
+	t.Run("ban peer", func(t *testing.T) {
+		ban, err := bl.BanPeer(pids[0], "failed handshake")
+		require.NoError(t, err)
+		require.Equal(t, 1, ban.Offenses)
+		require.WithinDuration(t, time.Now().Add(banBaseDuration), ban.Expiry, time.Second)
+		require.True(t, bl.IsBannedPeer(pids[0]))
+		require.False(t, bl.IsBannedPeer(pids[1]))
+	})
+
+	t.Run("exponential duration", func(t *testing.T) {
+		// expire the previous ban
+		bl.(*banList).bans[pids[0].String()].Expiry = time.Now().Add(-time.Minute)
+		require.False(t, bl.IsBannedPeer(pids[0]))
+
+		ban, err := bl.BanPeer(pids[0], "failed handshake")
+		require.NoError(t, err)
+		require.Equal(t, 2, ban.Offenses)
+		require.WithinDuration(t, time.Now().Add(2*banBaseDuration), ban.Expiry, time.Second)
+	})
+
+	t.Run("ban ip and subnet", func(t *testing.T) {
+		_, err := bl.AddBan("10.0.0.1", "", 0)
+		require.NoError(t, err)
+		_, err = bl.AddBan("2001:db8::/64", "", time.Hour)
+		require.NoError(t, err)
+		_, err = bl.AddBan("not-a-target", "", 0)
+		require.Error(t, err)
+
+		require.True(t, bl.IsBannedIP(net.ParseIP("10.0.0.1")))
+		require.False(t, bl.IsBannedIP(net.ParseIP("10.0.0.2")))
+		require.True(t, bl.IsBannedIP(net.ParseIP("2001:db8::1")))
+		require.False(t, bl.IsBannedIP(net.ParseIP("2001:db9::1")))
+		require.Len(t, bl.Bans(), 3)
+	})
+
+	t.Run("persisted", func(t *testing.T) {
+		loaded, err := NewBanList(db)
+		require.NoError(t, err)
+		require.True(t, loaded.IsBannedPeer(pids[0]))
+		require.True(t, loaded.IsBannedIP(net.ParseIP("10.0.0.1")))
+		require.True(t, loaded.IsBannedIP(net.ParseIP("2001:db8::1")))
+		require.Len(t, loaded.Bans(), 3)
+	})
+
+	t.Run("remove ban", func(t *testing.T) {
+		require.NoError(t, bl.RemoveBan("10.0.0.1"))
+		require.False(t, bl.IsBannedIP(net.ParseIP("10.0.0.1")))
+		require.Error(t, bl.RemoveBan("10.0.0.1"))
+		require.NoError(t, bl.RemoveBan(pids[0].String()))
+		require.False(t, bl.IsBannedPeer(pids[0]))
+	})
+
+	t.Run("prune", func(t *testing.T) {
+		_, err := bl.BanPeer(pids[1], "rejected messages")
+		require.NoError(t, err)
+		bl.(*banList).bans[pids[1].String()].Expiry = time.Now().Add(-banOffensesTTL - time.Minute)
+
+		pruned, err := bl.Prune()
+		require.NoError(t, err)
+		require.Equal(t, 1, pruned)
+		require.Len(t, bl.Bans(), 1)
+	})
+}
@@ -16,6 +16,8 @@ import (
 
 const (
 	protectedTag = "ssv/subnets"
+	// TrustedTag is the tag used to protect trusted peers, which are never trimmed
+	TrustedTag = "ssv/trusted"
 )
 
 type PeerScore float64
@@ -71,7 +73,7 @@ func (c connManager) TrimPeers(ctx context.Context, logger *zap.Logger, net libp
 	// TODO: use libp2p's conn manager once ready
 	// c.connManager.TrimOpenConns(ctx)
 	for _, pid := range allPeers {
-		if !c.connManager.IsProtected(pid, protectedTag) {
+		if !c.connManager.IsProtected(pid, protectedTag) && !c.connManager.IsProtected(pid, TrustedTag) {
 			err := net.ClosePeer(pid)
 			logger.Debug("closing peer", zap.String("pid", pid.String()), zap.Error(err))
 			// if err != nil {
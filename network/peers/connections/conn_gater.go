@@ -1,28 +1,78 @@
 package connections
 
 import (
-	"github.com/bloxapp/ssv/network/peers"
+	"net"
+	"sync"
+
 	"github.com/libp2p/go-libp2p/core/connmgr"
 	"github.com/libp2p/go-libp2p/core/control"
 	libp2pnetwork "github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	ma "github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
 	"go.uber.org/zap"
+
+	"github.com/bloxapp/ssv/logging/fields"
+	"github.com/bloxapp/ssv/network/commons"
+	"github.com/bloxapp/ssv/network/peers"
 )
 
+const (
+	// ipv4SubnetBits is the prefix length of IPv4 subnets (/24) that are limited
+	ipv4SubnetBits = 24
+	// ipv6SubnetBits is the prefix length of IPv6 subnets (/64) that are limited
+	ipv6SubnetBits = 64
+)
+
+// ConnectionGater is a connmgr.ConnectionGater that also tracks connections (as a network.Notifiee),
+// in order to limit the amount of connections per IP and subnet
+type ConnectionGater interface {
+	connmgr.ConnectionGater
+	libp2pnetwork.Notifiee
+}
+
+// ConnGaterCfg is the configuration for creating a connection gater
+type ConnGaterCfg struct {
+	// BanList is used to reject banned peers, IPs and subnets
+	BanList peers.BanList
+	// TrustedPeers are exempt from bans and IP limits
+	TrustedPeers []peer.ID
+	// IPv6 is true if the node is able to dial IPv6 addresses
+	IPv6 bool
+	// MaxPeersPerIP is the maximum amount of inbound connections per IP, 0 means no limit
+	MaxPeersPerIP int
+	// MaxPeersPerSubnet is the maximum amount of inbound connections per subnet (/24 or /64), 0 means no limit
+	MaxPeersPerSubnet int
+}
+
 // connGater implements ConnectionGater interface:
 // https://github.com/libp2p/go-libp2p/core/blob/master/connmgr/gater.go
-// TODO: add IP limiting
 type connGater struct {
-	logger *zap.Logger // struct logger to implement connmgr.ConnectionGater
-	idx    peers.ConnectionIndex
+	libp2pnetwork.NoopNotifiee
+
+	logger  *zap.Logger // struct logger to implement connmgr.ConnectionGater
+	cfg     *ConnGaterCfg
+	trusted map[peer.ID]struct{}
+
+	lock         sync.Mutex
+	ipConns      map[string]int
+	subnetConns  map[string]int
+	trackedConns map[libp2pnetwork.Conn]net.IP
 }
 
 // NewConnectionGater creates a new instance of ConnectionGater
-func NewConnectionGater(logger *zap.Logger, idx peers.ConnectionIndex) connmgr.ConnectionGater {
+func NewConnectionGater(logger *zap.Logger, cfg *ConnGaterCfg) ConnectionGater {
+	trusted := make(map[peer.ID]struct{}, len(cfg.TrustedPeers))
+	for _, id := range cfg.TrustedPeers {
+		trusted[id] = struct{}{}
+	}
 	return &connGater{
-		logger: logger,
-		idx:    idx,
+		logger:       logger,
+		cfg:          cfg,
+		trusted:      trusted,
+		ipConns:      make(map[string]int),
+		subnetConns:  make(map[string]int),
+		trackedConns: make(map[libp2pnetwork.Conn]net.IP),
 	}
 }
 
@@ -30,14 +80,21 @@ func NewConnectionGater(logger *zap.Logger, idx peers.ConnectionIndex) connmgr.C
 // to the addresses of that peer being available/resolved. Blocking connections
 // at this stage is typical for blacklisting scenarios
 func (n *connGater) InterceptPeerDial(id peer.ID) bool {
-	return n.idx.Limit(libp2pnetwork.DirOutbound)
+	return !n.isBannedPeer(id)
 }
 
 // InterceptAddrDial is called on an imminent outbound dial to a peer on a
 // particular address. Blocking connections at this stage is typical for
 // address filtering.
 func (n *connGater) InterceptAddrDial(id peer.ID, multiaddr ma.Multiaddr) bool {
-	return true
+	// peers might advertise IPv6 addresses in their ENR, which are not reachable from an IPv4-only node
+	if !n.cfg.IPv6 && commons.IsIPv6Addr(multiaddr) {
+		return false
+	}
+	if n.isTrusted(id) {
+		return true
+	}
+	return !n.isBannedIP(multiaddr)
 }
 
 // InterceptAccept is called as soon as a transport listener receives an
@@ -45,13 +102,30 @@ func (n *connGater) InterceptAddrDial(id peer.ID, multiaddr ma.Multiaddr) bool {
 // accept already secure and/or multiplexed connections (e.g. possibly QUIC)
 // MUST call this method regardless, for correctness/consistency.
 func (n *connGater) InterceptAccept(multiaddrs libp2pnetwork.ConnMultiaddrs) bool {
-	return n.idx.Limit(libp2pnetwork.DirInbound)
+	// the peer is not known yet, therefore trusted peers are not exempt from IP bans
+	if n.isBannedIP(multiaddrs.RemoteMultiaddr()) {
+		n.logger.Debug("rejecting inbound connection from banned ip", fields.Address(multiaddrs.RemoteMultiaddr().String()))
+		return false
+	}
+	return true
 }
 
 // InterceptSecured is called for both inbound and outbound connections,
 // after a security handshake has taken place and we've authenticated the peer.
 func (n *connGater) InterceptSecured(direction libp2pnetwork.Direction, id peer.ID, multiaddrs libp2pnetwork.ConnMultiaddrs) bool {
-	return n.idx.IsBad(n.logger, id)
+	if n.isTrusted(id) {
+		return true
+	}
+	if n.isBannedPeer(id) {
+		n.logger.Debug("rejecting connection of banned peer", fields.PeerID(id))
+		return false
+	}
+	if direction == libp2pnetwork.DirInbound && !n.withinIPLimits(multiaddrs.RemoteMultiaddr()) {
+		n.logger.Debug("rejecting inbound connection, reached ip limits", fields.PeerID(id),
+			fields.Address(multiaddrs.RemoteMultiaddr().String()))
+		return false
+	}
+	return true
 }
 
 // InterceptUpgraded is called for inbound and outbound connections, after
@@ -60,3 +134,93 @@ func (n *connGater) InterceptSecured(direction libp2pnetwork.Direction, id peer.
 func (n *connGater) InterceptUpgraded(conn libp2pnetwork.Conn) (bool, control.DisconnectReason) {
 	return true, 0
 }
+
+// Connected tracks the IP of inbound connections of non-trusted peers
+func (n *connGater) Connected(_ libp2pnetwork.Network, conn libp2pnetwork.Conn) {
+	if conn.Stat().Direction != libp2pnetwork.DirInbound || n.isTrusted(conn.RemotePeer()) {
+		return
+	}
+	ip, err := manet.ToIP(conn.RemoteMultiaddr())
+	if err != nil {
+		return
+	}
+
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	n.trackedConns[conn] = ip
+	n.ipConns[ip.String()]++
+	n.subnetConns[subnetKey(ip)]++
+}
+
+// Disconnected stops tracking the given connection
+func (n *connGater) Disconnected(_ libp2pnetwork.Network, conn libp2pnetwork.Conn) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	ip, ok := n.trackedConns[conn]
+	if !ok {
+		return
+	}
+	delete(n.trackedConns, conn)
+	decrement(n.ipConns, ip.String())
+	decrement(n.subnetConns, subnetKey(ip))
+}
+
+// withinIPLimits checks that a new connection from the given address doesn't exceed the IP limits
+func (n *connGater) withinIPLimits(addr ma.Multiaddr) bool {
+	ip, err := manet.ToIP(addr)
+	if err != nil || ip.IsLoopback() {
+		return true
+	}
+
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	if n.cfg.MaxPeersPerIP > 0 && n.ipConns[ip.String()] >= n.cfg.MaxPeersPerIP {
+		return false
+	}
+	if n.cfg.MaxPeersPerSubnet > 0 && n.subnetConns[subnetKey(ip)] >= n.cfg.MaxPeersPerSubnet {
+		return false
+	}
+	return true
+}
+
+func (n *connGater) isTrusted(id peer.ID) bool {
+	_, ok := n.trusted[id]
+	return ok
+}
+
+func (n *connGater) isBannedPeer(id peer.ID) bool {
+	if n.cfg.BanList == nil || n.isTrusted(id) {
+		return false
+	}
+	return n.cfg.BanList.IsBannedPeer(id)
+}
+
+func (n *connGater) isBannedIP(addr ma.Multiaddr) bool {
+	if n.cfg.BanList == nil {
+		return false
+	}
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		return false
+	}
+	return n.cfg.BanList.IsBannedIP(ip)
+}
+
+// subnetKey returns the subnet (/24 or /64) of the given IP
+func subnetKey(ip net.IP) string {
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4.Mask(net.CIDRMask(ipv4SubnetBits, 8*net.IPv4len)).String()
+	}
+	return ip.Mask(net.CIDRMask(ipv6SubnetBits, 8*net.IPv6len)).String()
+}
+
+func decrement(counts map[string]int, key string) {
+	if counts[key] <= 1 {
+		delete(counts, key)
+		return
+	}
+	counts[key]--
+}
@@ -0,0 +1,116 @@
+package connections
+
+import (
+	"testing"
+	"time"
+
+	libp2pnetwork "github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bloxapp/ssv/logging"
+	"github.com/bloxapp/ssv/network/peers"
+)
+
+func TestConnGater(t *testing.T) {
+	logger := logging.TestLogger(t)
+
+	banList, err := peers.NewBanList(nil)
+	require.NoError(t, err)
+	trusted := peer.ID("trusted")
+	gater := NewConnectionGater(logger, &ConnGaterCfg{
+		BanList:           banList,
+		TrustedPeers:      []peer.ID{trusted},
+		MaxPeersPerIP:     2,
+		MaxPeersPerSubnet: 3,
+	})
+
+	addr := func(s string) testConnAddrs {
+		return testConnAddrs{remote: ma.StringCast(s)}
+	}
+	connect := func(id peer.ID, remote string) libp2pnetwork.Conn {
+		conn := &testConn{remotePeer: id, remoteAddr: ma.StringCast(remote), dir: libp2pnetwork.DirInbound}
+		require.True(t, gater.InterceptSecured(libp2pnetwork.DirInbound, id, addr(remote)))
+		gater.Connected(nil, conn)
+		return conn
+	}
+
+	t.Run("ip limit", func(t *testing.T) {
+		c1 := connect("peer1", "/ip4/10.0.0.1/tcp/13001")
+		connect("peer2", "/ip4/10.0.0.1/tcp/13002")
+		require.False(t, gater.InterceptSecured(libp2pnetwork.DirInbound, "peer3", addr("/ip4/10.0.0.1/tcp/13003")))
+		// outbound and trusted connections are not limited
+		require.True(t, gater.InterceptSecured(libp2pnetwork.DirOutbound, "peer3", addr("/ip4/10.0.0.1/tcp/13003")))
+		require.True(t, gater.InterceptSecured(libp2pnetwork.DirInbound, trusted, addr("/ip4/10.0.0.1/tcp/13003")))
+
+		gater.Disconnected(nil, c1)
+		require.True(t, gater.InterceptSecured(libp2pnetwork.DirInbound, "peer3", addr("/ip4/10.0.0.1/tcp/13003")))
+	})
+
+	t.Run("subnet limit", func(t *testing.T) {
+		// peer2 is still connected from 10.0.0.1
+		connect("peer4", "/ip4/10.0.0.2/tcp/13001")
+		connect("peer5", "/ip4/10.0.0.3/tcp/13001")
+		require.False(t, gater.InterceptSecured(libp2pnetwork.DirInbound, "peer6", addr("/ip4/10.0.0.4/tcp/13001")))
+		require.True(t, gater.InterceptSecured(libp2pnetwork.DirInbound, "peer6", addr("/ip4/10.0.1.4/tcp/13001")))
+
+		connect("peer7", "/ip6/2001:db8::1/tcp/13001")
+		connect("peer8", "/ip6/2001:db8::2/tcp/13001")
+		connect("peer9", "/ip6/2001:db8::3/tcp/13001")
+		require.False(t, gater.InterceptSecured(libp2pnetwork.DirInbound, "peer10", addr("/ip6/2001:db8::4/tcp/13001")))
+		require.True(t, gater.InterceptSecured(libp2pnetwork.DirInbound, "peer10", addr("/ip6/2001:db8:0:1::4/tcp/13001")))
+	})
+
+	t.Run("bans", func(t *testing.T) {
+		_, err := banList.BanPeer("banned", "test")
+		require.NoError(t, err)
+		_, err = banList.AddBan("192.168.0.0/16", "test", time.Hour)
+		require.NoError(t, err)
+
+		require.False(t, gater.InterceptPeerDial("banned"))
+		require.False(t, gater.InterceptSecured(libp2pnetwork.DirOutbound, "banned", addr("/ip4/10.0.5.1/tcp/13001")))
+		require.True(t, gater.InterceptPeerDial("peer11"))
+
+		require.False(t, gater.InterceptAccept(addr("/ip4/192.168.1.1/tcp/13001")))
+		require.False(t, gater.InterceptAddrDial("peer11", ma.StringCast("/ip4/192.168.1.1/tcp/13001")))
+		require.True(t, gater.InterceptAddrDial(trusted, ma.StringCast("/ip4/192.168.1.1/tcp/13001")))
+		require.True(t, gater.InterceptAccept(addr("/ip4/10.0.5.1/tcp/13001")))
+	})
+
+	t.Run("ipv6 dials", func(t *testing.T) {
+		require.False(t, gater.InterceptAddrDial("peer11", ma.StringCast("/ip6/2001:db8::1/tcp/13001")))
+	})
+}
+
+type testConnAddrs struct {
+	remote ma.Multiaddr
+}
+
+func (a testConnAddrs) LocalMultiaddr() ma.Multiaddr {
+	return ma.StringCast("/ip4/127.0.0.1/tcp/13000")
+}
+
+func (a testConnAddrs) RemoteMultiaddr() ma.Multiaddr {
+	return a.remote
+}
+
+type testConn struct {
+	libp2pnetwork.Conn
+
+	remotePeer peer.ID
+	remoteAddr ma.Multiaddr
+	dir        libp2pnetwork.Direction
+}
+
+func (c *testConn) RemotePeer() peer.ID {
+	return c.remotePeer
+}
+
+func (c *testConn) RemoteMultiaddr() ma.Multiaddr {
+	return c.remoteAddr
+}
+
+func (c *testConn) Stat() libp2pnetwork.ConnStats {
+	return libp2pnetwork.ConnStats{Stats: libp2pnetwork.Stats{Direction: c.dir}}
+}
@@ -30,10 +30,11 @@ type connHandler struct {
 	subnetsIndex    peers.SubnetsIndex
 	connIdx         peers.ConnectionIndex
 	peerInfos       peers.PeerInfoIndex
+	banList         peers.BanList
 }
 
 // NewConnHandler creates a new connection handler
-func NewConnHandler(ctx context.Context, handshaker Handshaker, subnetsProvider SubnetsProvider, subnetsIndex peers.SubnetsIndex, connIdx peers.ConnectionIndex, peerInfos peers.PeerInfoIndex) ConnHandler {
+func NewConnHandler(ctx context.Context, handshaker Handshaker, subnetsProvider SubnetsProvider, subnetsIndex peers.SubnetsIndex, connIdx peers.ConnectionIndex, peerInfos peers.PeerInfoIndex, banList peers.BanList) ConnHandler {
 	return &connHandler{
 		ctx:             ctx,
 		handshaker:      handshaker,
@@ -41,6 +42,7 @@ func NewConnHandler(ctx context.Context, handshaker Handshaker, subnetsProvider
 		subnetsIndex:    subnetsIndex,
 		connIdx:         connIdx,
 		peerInfos:       peerInfos,
+		banList:         banList,
 	}
 }
 
@@ -124,7 +126,7 @@ func (ch *connHandler) Handle(logger *zap.Logger) *libp2pnetwork.NotifyBundle {
 				}
 			}
 
-			if !ch.sharesEnoughSubnets(logger, conn) {
+			if !ch.connIdx.IsTrusted(pid) && !ch.sharesEnoughSubnets(logger, conn) {
 				return errors.New("peer doesn't share enough subnets")
 			}
 			return nil
@@ -158,7 +160,7 @@ func (ch *connHandler) Handle(logger *zap.Logger) *libp2pnetwork.NotifyBundle {
 			go func() {
 				logger := connLogger(conn)
 				err := acceptConnection(logger, net, conn)
-				if err == nil {
+				if err == nil && !ch.connIdx.IsTrusted(conn.RemotePeer()) {
 					if ch.connIdx.Limit(conn.Stat().Direction) {
 						err = errors.New("reached peers limit")
 					}
@@ -167,6 +169,7 @@ func (ch *connHandler) Handle(logger *zap.Logger) *libp2pnetwork.NotifyBundle {
 					return
 				}
 				if err != nil {
+					ch.banIfNeeded(logger, conn.RemotePeer(), err)
 					disconnect(logger, net, conn)
 					logger.Debug("failed to accept connection", zap.Error(err))
 					return
@@ -197,6 +200,19 @@ func (ch *connHandler) Handle(logger *zap.Logger) *libp2pnetwork.NotifyBundle {
 	}
 }
 
+// banIfNeeded bans the given peer if it was rejected during handshake
+func (ch *connHandler) banIfNeeded(logger *zap.Logger, pid peer.ID, err error) {
+	if ch.banList == nil || ch.connIdx.IsTrusted(pid) || !isBannableHandshakeError(err) {
+		return
+	}
+	ban, banErr := ch.banList.BanPeer(pid, err.Error())
+	if banErr != nil {
+		logger.Warn("could not ban peer", zap.Error(banErr))
+		return
+	}
+	logger.Debug("banned peer", zap.Time("expiry", ban.Expiry), zap.Int("offenses", ban.Offenses))
+}
+
 func (ch *connHandler) sharesEnoughSubnets(logger *zap.Logger, conn libp2pnetwork.Conn) bool {
 	pid := conn.RemotePeer()
 	subnets := ch.subnetsIndex.GetPeerSubnets(pid)
@@ -5,6 +5,7 @@ import (
 	"crypto/rsa"
 	"encoding/base64"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -17,14 +18,17 @@ import (
 
 var AllowedDifference = 30 * time.Second
 
-// NetworkIDFilter determines whether we will connect to the given node by the network ID
-func NetworkIDFilter(networkID string) HandshakeFilter {
+// NetworkIDFilter determines whether we will connect to the given node by the network ID,
+// multiple network IDs are accepted around forks of the domain type
+func NetworkIDFilter(networkIDs ...string) HandshakeFilter {
 	return func(sender peer.ID, ani records.AnyNodeInfo) error {
 		nid := ani.GetNodeInfo().NetworkID
-		if networkID != nid {
-			return errors.Errorf("networkID '%s' instead of '%s'", nid, networkID)
+		for _, networkID := range networkIDs {
+			if networkID == nid {
+				return nil
+			}
 		}
-		return nil
+		return errors.Errorf("networkID '%s' instead of '%s'", nid, strings.Join(networkIDs, "', '"))
 	}
 }
 
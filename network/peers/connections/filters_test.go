@@ -24,6 +24,15 @@ func TestNetworkIDFilter(t *testing.T) {
 		},
 	})
 	require.Error(t, err)
+
+	// around forks, the network IDs of all forks are accepted
+	f = NetworkIDFilter("xxx", "bbb")
+	err = f("", &records.SignedNodeInfo{
+		NodeInfo: &records.NodeInfo{
+			NetworkID: "bbb",
+		},
+	})
+	require.NoError(t, err)
 }
 
 func TestSenderRecipientIPsCheckFilter(t *testing.T) {
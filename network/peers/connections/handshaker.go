@@ -7,6 +7,7 @@ import (
 
 	libp2pnetwork "github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
 	"github.com/libp2p/go-libp2p/p2p/protocol/identify"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
@@ -25,6 +26,13 @@ var errPeerWasFiltered = errors.New("peer was filtered during handshake")
 // example: the Node in NON-Permissoned mode receives SignedNodeInfo; the Node in Permissoned mode receives NodeInfo
 var errConsumingMessage = errors.New("error consuming message")
 
+// isBannableHandshakeError returns whether the given handshake error should get the peer banned,
+// i.e. the peer was rejected by the handshake filters or sent an invalid node info.
+// other errors (e.g. timeouts) might be temporary and therefore are not considered.
+func isBannableHandshakeError(err error) bool {
+	return errors.Is(err, errPeerWasFiltered) || errors.Is(err, errConsumingMessage)
+}
+
 // HandshakeFilter can be used to filter nodes once we handshaked with them
 type HandshakeFilter func(senderID peer.ID, sni records.AnyNodeInfo) error
 
@@ -122,7 +130,8 @@ func (h *handshaker) Handler(logger *zap.Logger) libp2pnetwork.StreamHandler {
 		if !found {
 			return errors.Wrap(err, "could not get private key")
 		}
-		self, err := h.nodeInfos.SelfSealed(h.net.LocalPeer(), pid, permissioned, privateKey)
+		// Respond with the encoding of the negotiated protocol.
+		self, err := h.nodeInfos.SelfSealed(h.net.LocalPeer(), pid, permissioned, privateKey, protocolEncoding(stream.Protocol()))
 		if err != nil {
 			return errors.Wrap(err, "could not seal self node info")
 		}
@@ -232,12 +241,11 @@ func (h *handshaker) requestNodeInfo(logger *zap.Logger, conn libp2pnetwork.Conn
 	if !found {
 		return nil, errors.New("could not get private key")
 	}
-	data, err := h.nodeInfos.SelfSealed(h.net.LocalPeer(), conn.RemotePeer(), permissioned, privateKey)
-	if err != nil {
-		return nil, err
-	}
-
-	resBytes, err := h.streams.Request(logger, conn.RemotePeer(), peers.NodeInfoProtocol, data)
+	// Prefer SSZ encoded records, peers that don't support it yet fall back to the legacy protocol.
+	protocols := []protocol.ID{peers.NodeInfoProtocolSSZSnappy, peers.NodeInfoProtocol}
+	resBytes, _, err := h.streams.NegotiateRequest(logger, conn.RemotePeer(), protocols, func(pid protocol.ID) ([]byte, error) {
+		return h.nodeInfos.SelfSealed(h.net.LocalPeer(), conn.RemotePeer(), permissioned, privateKey, protocolEncoding(pid))
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -254,6 +262,14 @@ func (h *handshaker) requestNodeInfo(logger *zap.Logger, conn libp2pnetwork.Conn
 	return nodeInfo, nil
 }
 
+// protocolEncoding returns the encoding of node info records in the given handshake protocol
+func protocolEncoding(pid protocol.ID) records.Encoding {
+	if pid == peers.NodeInfoProtocolSSZSnappy {
+		return records.EncodingSSZSnappy
+	}
+	return records.EncodingJSON
+}
+
 func (h *handshaker) applyFilters(sender peer.ID, ani records.AnyNodeInfo) error {
 	fltrs := h.filters()
 	for i := range fltrs {
@@ -23,6 +23,16 @@ func (m StreamController) Request(logger *zap.Logger, peerID peer.ID, protocol p
 	}
 }
 
+func (m StreamController) NegotiateRequest(logger *zap.Logger, peerID peer.ID, protocols []protocol.ID, encode func(protocol.ID) ([]byte, error)) ([]byte, protocol.ID, error) {
+	if len(m.MockRequest) == 0 || len(protocols) == 0 {
+		return nil, "", errors.New("error")
+	}
+	if _, err := encode(protocols[0]); err != nil {
+		return nil, "", err
+	}
+	return m.MockRequest, protocols[0], nil
+}
+
 func (m StreamController) HandleStream(logger *zap.Logger, stream core.Stream) ([]byte, streams.StreamResponder, func(), error) {
 	//TODO implement me
 	panic("implement me")
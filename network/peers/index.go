@@ -16,6 +16,8 @@ import (
 const (
 	// NodeInfoProtocol is the protocol.ID used for handshake
 	NodeInfoProtocol = "/ssv/info/0.0.1"
+	// NodeInfoProtocolSSZSnappy is the protocol.ID used for handshake with SSZ encoded records, compressed with snappy
+	NodeInfoProtocolSSZSnappy = NodeInfoProtocol + "/ssz_snappy"
 )
 
 var (
@@ -43,6 +45,10 @@ type ConnectionIndex interface {
 
 	// IsBad returns whether the given peer is bad
 	IsBad(logger *zap.Logger, id peer.ID) bool
+
+	// IsTrusted returns whether the given peer is trusted,
+	// trusted peers are never considered bad and are exempt from peers limit
+	IsTrusted(id peer.ID) bool
 }
 
 // ScoreIndex is an interface for managing peers scores
@@ -56,7 +62,7 @@ type ScoreIndex interface {
 // NodeInfoIndex is an interface for managing records.NodeInfo of network peers
 type NodeInfoIndex interface {
 	// SelfSealed returns a sealed, encoded of self node info
-	SelfSealed(sender, recipient peer.ID, permissioned bool, operatorPrivateKey *rsa.PrivateKey) ([]byte, error)
+	SelfSealed(sender, recipient peer.ID, permissioned bool, operatorPrivateKey *rsa.PrivateKey, encoding records.Encoding) ([]byte, error)
 
 	// Self returns the current node info
 	Self() *records.NodeInfo
@@ -0,0 +1,169 @@
+package peers
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+
+	"github.com/bloxapp/ssv/network/records"
+	"github.com/bloxapp/ssv/storage/basedb"
+)
+
+var (
+	// peerStorePrefix is the db prefix of stored peers
+	peerStorePrefix = []byte("p2p-peers/")
+)
+
+// StoredPeer is a known peer that is persisted across restarts
+type StoredPeer struct {
+	ID       peer.ID
+	Addrs    []ma.Multiaddr
+	NodeInfo *records.NodeInfo
+	Subnets  records.Subnets
+	LastSeen time.Time
+	Score    float64
+}
+
+// AddrInfo returns the peer.AddrInfo of the stored peer
+func (sp *StoredPeer) AddrInfo() peer.AddrInfo {
+	return peer.AddrInfo{
+		ID:    sp.ID,
+		Addrs: sp.Addrs,
+	}
+}
+
+// storedPeerJSON is the json representation of StoredPeer
+type storedPeerJSON struct {
+	ID       string            `json:"id"`
+	Addrs    []string          `json:"addrs"`
+	NodeInfo *records.NodeInfo `json:"node_info,omitempty"`
+	Subnets  string            `json:"subnets"`
+	LastSeen time.Time         `json:"last_seen"`
+	Score    float64           `json:"score"`
+}
+
+// MarshalJSON implements json.Marshaler
+func (sp *StoredPeer) MarshalJSON() ([]byte, error) {
+	addrs := make([]string, 0, len(sp.Addrs))
+	for _, addr := range sp.Addrs {
+		addrs = append(addrs, addr.String())
+	}
+	return json.Marshal(&storedPeerJSON{
+		ID:       sp.ID.String(),
+		Addrs:    addrs,
+		NodeInfo: sp.NodeInfo,
+		Subnets:  sp.Subnets.String(),
+		LastSeen: sp.LastSeen,
+		Score:    sp.Score,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (sp *StoredPeer) UnmarshalJSON(data []byte) error {
+	var raw storedPeerJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	id, err := peer.Decode(raw.ID)
+	if err != nil {
+		return errors.Wrap(err, "could not decode peer id")
+	}
+	addrs := make([]ma.Multiaddr, 0, len(raw.Addrs))
+	for _, addr := range raw.Addrs {
+		maddr, err := ma.NewMultiaddr(addr)
+		if err != nil {
+			return errors.Wrap(err, "could not decode multiaddr")
+		}
+		addrs = append(addrs, maddr)
+	}
+	subnets, err := records.Subnets{}.FromString(raw.Subnets)
+	if err != nil {
+		return errors.Wrap(err, "could not decode subnets")
+	}
+	*sp = StoredPeer{
+		ID:       id,
+		Addrs:    addrs,
+		NodeInfo: raw.NodeInfo,
+		Subnets:  subnets,
+		LastSeen: raw.LastSeen,
+		Score:    raw.Score,
+	}
+	return nil
+}
+
+// PeerStore persists known peers, so they can be re-dialed on startup
+type PeerStore interface {
+	// SavePeers saves (or overrides) the given peers
+	SavePeers(peers ...*StoredPeer) error
+	// DeletePeer deletes the given peer
+	DeletePeer(id peer.ID) error
+	// Peers returns the stored peers, ordered by score (highest first)
+	Peers() ([]*StoredPeer, error)
+	// Prune deletes peers that weren't seen since the given time, and keeps at most maxPeers with the highest score
+	Prune(seenSince time.Time, maxPeers int) (int, error)
+}
+
+type peerStore struct {
+	db basedb.Database
+}
+
+// NewPeerStore creates a new PeerStore on top of the given database
+func NewPeerStore(db basedb.Database) PeerStore {
+	return &peerStore{db: db}
+}
+
+func (ps *peerStore) SavePeers(peers ...*StoredPeer) error {
+	return ps.db.SetMany(peerStorePrefix, len(peers), func(i int) (basedb.Obj, error) {
+		value, err := json.Marshal(peers[i])
+		if err != nil {
+			return basedb.Obj{}, errors.Wrap(err, "could not marshal peer")
+		}
+		return basedb.Obj{Key: []byte(peers[i].ID), Value: value}, nil
+	})
+}
+
+func (ps *peerStore) DeletePeer(id peer.ID) error {
+	return ps.db.Delete(peerStorePrefix, []byte(id))
+}
+
+func (ps *peerStore) Peers() ([]*StoredPeer, error) {
+	var peers []*StoredPeer
+	err := ps.db.GetAll(peerStorePrefix, func(i int, obj basedb.Obj) error {
+		sp := &StoredPeer{}
+		if err := json.Unmarshal(obj.Value, sp); err != nil {
+			return errors.Wrap(err, "could not unmarshal peer")
+		}
+		peers = append(peers, sp)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(peers, func(i, j int) bool {
+		return peers[i].Score > peers[j].Score
+	})
+	return peers, nil
+}
+
+func (ps *peerStore) Prune(seenSince time.Time, maxPeers int) (int, error) {
+	peers, err := ps.Peers()
+	if err != nil {
+		return 0, err
+	}
+	var kept, pruned int
+	for _, sp := range peers {
+		if kept < maxPeers && !sp.LastSeen.Before(seenSince) {
+			kept++
+			continue
+		}
+		if err := ps.DeletePeer(sp.ID); err != nil {
+			return pruned, err
+		}
+		pruned++
+	}
+	return pruned, nil
+}
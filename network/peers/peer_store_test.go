@@ -0,0 +1,78 @@
+package peers
+
+import (
+	"testing"
+	"time"
+
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bloxapp/ssv/logging"
+	"github.com/bloxapp/ssv/network/records"
+	"github.com/bloxapp/ssv/storage/basedb"
+	"github.com/bloxapp/ssv/storage/kv"
+)
+
+func TestPeerStore(t *testing.T) {
+	db, err := kv.NewInMemory(logging.TestLogger(t), basedb.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := NewPeerStore(db)
+
+	pids, err := createPeerIDs(3)
+	require.NoError(t, err)
+	addr, err := ma.NewMultiaddr("/ip4/127.0.0.1/tcp/13001")
+	require.NoError(t, err)
+	subnets, err := records.Subnets{}.FromString("0x57b080fffd743d9878dc41a184ab160a")
+	require.NoError(t, err)
+
+	now := time.Now().Round(time.Second)
+	nodeInfo := records.NewNodeInfo("0x00000000")
+	nodeInfo.Metadata = &records.NodeMetadata{NodeVersion: "v1.0.0", Subnets: subnets.String()}
+
+	require.NoError(t, store.SavePeers(
+		&StoredPeer{ID: pids[0], Addrs: []ma.Multiaddr{addr}, NodeInfo: nodeInfo, Subnets: subnets, LastSeen: now, Score: 5},
+		&StoredPeer{ID: pids[1], Addrs: []ma.Multiaddr{addr}, Subnets: subnets, LastSeen: now, Score: 25},
+		&StoredPeer{ID: pids[2], Addrs: []ma.Multiaddr{addr}, Subnets: subnets, LastSeen: now.Add(-time.Hour), Score: 50},
+	))
+
+	stored, err := store.Peers()
+	require.NoError(t, err)
+	require.Len(t, stored, 3)
+	require.Equal(t, pids[2], stored[0].ID, "peers should be sorted by score")
+	require.Equal(t, pids[0], stored[2].ID)
+	require.Equal(t, subnets, stored[2].Subnets)
+	require.True(t, addr.Equal(stored[2].Addrs[0]))
+	require.Equal(t, nodeInfo.Metadata.NodeVersion, stored[2].NodeInfo.Metadata.NodeVersion)
+	require.True(t, now.Equal(stored[2].LastSeen))
+
+	t.Run("prune old peers", func(t *testing.T) {
+		pruned, err := store.Prune(now.Add(-time.Minute), 10)
+		require.NoError(t, err)
+		require.Equal(t, 1, pruned)
+
+		stored, err := store.Peers()
+		require.NoError(t, err)
+		require.Len(t, stored, 2)
+	})
+
+	t.Run("prune lowest scores", func(t *testing.T) {
+		pruned, err := store.Prune(now.Add(-time.Minute), 1)
+		require.NoError(t, err)
+		require.Equal(t, 1, pruned)
+
+		stored, err := store.Peers()
+		require.NoError(t, err)
+		require.Len(t, stored, 1)
+		require.Equal(t, pids[1], stored[0].ID)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, store.DeletePeer(pids[1]))
+
+		stored, err := store.Peers()
+		require.NoError(t, err)
+		require.Len(t, stored, 0)
+	})
+}
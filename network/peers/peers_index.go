@@ -37,11 +37,17 @@ type peersIndex struct {
 	self     *records.NodeInfo
 
 	maxPeers MaxPeersProvider
+
+	trusted map[peer.ID]struct{}
 }
 
 // NewPeersIndex creates a new Index
 func NewPeersIndex(logger *zap.Logger, network libp2pnetwork.Network, self *records.NodeInfo, maxPeers MaxPeersProvider,
-	netKeyProvider NetworkKeyProvider, subnetsCount int, pruneTTL time.Duration) *peersIndex {
+	netKeyProvider NetworkKeyProvider, subnetsCount int, pruneTTL time.Duration, trustedPeers ...peer.ID) *peersIndex {
+	trusted := make(map[peer.ID]struct{}, len(trustedPeers))
+	for _, id := range trustedPeers {
+		trusted[id] = struct{}{}
+	}
 	return &peersIndex{
 		network:        network,
 		scoreIdx:       newScoreIndex(),
@@ -51,6 +57,7 @@ func NewPeersIndex(logger *zap.Logger, network libp2pnetwork.Network, self *reco
 		selfLock:       &sync.RWMutex{},
 		maxPeers:       maxPeers,
 		netKeyProvider: netKeyProvider,
+		trusted:        trusted,
 	}
 }
 
@@ -59,6 +66,9 @@ func NewPeersIndex(logger *zap.Logger, network libp2pnetwork.Network, self *reco
 // - pruned (that was not expired)
 // - bad score
 func (pi *peersIndex) IsBad(logger *zap.Logger, id peer.ID) bool {
+	if pi.IsTrusted(id) {
+		return false
+	}
 	// TODO: check scores
 	threshold := -10000.0
 	scores, err := pi.GetScore(id, "")
@@ -91,10 +101,21 @@ func (pi *peersIndex) CanConnect(id peer.ID) bool {
 	return true
 }
 
+// Limit checks if the node has reached peers limit, trusted peers are not counted.
 func (pi *peersIndex) Limit(dir libp2pnetwork.Direction) bool {
 	maxPeers := pi.maxPeers("")
-	peers := pi.network.Peers()
-	return len(peers) > maxPeers
+	var count int
+	for _, id := range pi.network.Peers() {
+		if !pi.IsTrusted(id) {
+			count++
+		}
+	}
+	return count > maxPeers
+}
+
+func (pi *peersIndex) IsTrusted(id peer.ID) bool {
+	_, ok := pi.trusted[id]
+	return ok
 }
 
 func (pi *peersIndex) UpdateSelfRecord(newSelf *records.NodeInfo) {
@@ -108,7 +129,7 @@ func (pi *peersIndex) Self() *records.NodeInfo {
 	return pi.self
 }
 
-func (pi *peersIndex) SelfSealed(sender, recipient peer.ID, permissioned bool, operatorPrivateKey *rsa.PrivateKey) ([]byte, error) {
+func (pi *peersIndex) SelfSealed(sender, recipient peer.ID, permissioned bool, operatorPrivateKey *rsa.PrivateKey, encoding records.Encoding) ([]byte, error) {
 	pi.selfLock.Lock()
 	defer pi.selfLock.Unlock()
 
@@ -131,11 +152,11 @@ func (pi *peersIndex) SelfSealed(sender, recipient peer.ID, permissioned bool, o
 			return nil, err
 		}
 
-		signedNodeInfo := &records.SignedNodeInfo{
+		signedNodeInfo := (&records.SignedNodeInfo{
 			NodeInfo:      pi.self,
 			HandshakeData: handshakeData,
 			Signature:     signature,
-		}
+		}).WithEncoding(encoding)
 
 		sealed, err := signedNodeInfo.Seal(pi.netKeyProvider())
 		if err != nil {
@@ -145,7 +166,7 @@ func (pi *peersIndex) SelfSealed(sender, recipient peer.ID, permissioned bool, o
 		return sealed, nil
 	}
 
-	sealed, err := pi.self.Seal(pi.netKeyProvider())
+	sealed, err := pi.self.WithEncoding(encoding).Seal(pi.netKeyProvider())
 	if err != nil {
 		return nil, err
 	}
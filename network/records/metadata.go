@@ -18,49 +18,13 @@ type NodeMetadata struct {
 	Subnets string
 }
 
-// Encode encodes the metadata into bytes
-// TODO: switch to SSZ
+// Encode encodes the metadata into bytes.
+// it is used by the legacy JSON encoding of NodeInfo, see records_ssz.go for the SSZ encoding
 func (nm *NodeMetadata) Encode() ([]byte, error) {
-	// ser := newSerializable(
-	//	nm.NodeVersion,
-	//	nm.OperatorID,
-	//	nm.ConsensusNode,
-	//	nm.ExecutionNode,
-	//)
-
 	return json.Marshal(nm)
 }
 
 // Decode decodes a raw payload into metadata
-// TODO: switch to SSZ
 func (nm *NodeMetadata) Decode(data []byte) error {
-	// var ser serializable
-
-	if err := json.Unmarshal(data, nm); err != nil {
-		return err
-	}
-
-	// nm.NodeVersion = ""
-	// nm.ConsensusNode = ""
-	// nm.ExecutionNode = ""
-	// nm.OperatorID = ""
-	//
-	// if len(ser.Entries) < 1 {
-	//	return errors.New("not enough entries in node metadata, node version is required")
-	//}
-	// nm.NodeVersion = ser.Entries[0]
-	// if len(ser.Entries) < 2 {
-	//	return nil
-	//}
-	//nm.ConsensusNode = ser.Entries[1]
-	//if len(ser.Entries) < 3 {
-	//	return nil
-	//}
-	//nm.ExecutionNode = ser.Entries[2]
-	//if len(ser.Entries) < 4 {
-	//	return nil
-	//}
-	//nm.OperatorID = ser.Entries[3]
-
-	return nil
+	return json.Unmarshal(data, nm)
 }
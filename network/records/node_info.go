@@ -6,6 +6,8 @@ import (
 	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/record"
 	"github.com/pkg/errors"
+
+	"github.com/bloxapp/ssv/protocol/v2/message"
 )
 
 const domain = "ssv"
@@ -20,6 +22,9 @@ type NodeInfo struct {
 	NetworkID string
 	// Metadata holds node's general information
 	Metadata *NodeMetadata
+
+	// encoding is the encoding of the record, EncodingJSON by default
+	encoding Encoding
 }
 
 // NewNodeInfo creates a new node info
@@ -29,6 +34,13 @@ func NewNodeInfo(networkID string) *NodeInfo {
 	}
 }
 
+// WithEncoding returns a copy of the node info that is encoded with the given encoding
+func (ni *NodeInfo) WithEncoding(encoding Encoding) *NodeInfo {
+	cp := *ni
+	cp.encoding = encoding
+	return &cp
+}
+
 // Seal seals and encodes the record to be sent to other peers
 func (ni *NodeInfo) Seal(privateKey crypto.PrivKey) ([]byte, error) {
 	ev, err := record.Seal(ni, privateKey)
@@ -78,6 +90,9 @@ func (ni *NodeInfo) Codec() []byte {
 
 // MarshalRecord converts a Record instance to a []byte, so that it can be used as an Envelope payload
 func (ni *NodeInfo) MarshalRecord() ([]byte, error) {
+	if ni.encoding == EncodingSSZSnappy {
+		return message.EncodeVersioned(ni.toSSZ(), true)
+	}
 	parts := []string{
 		"", // Deprecated: ForkVersion is no longer used. Left for backward compatibility.
 		ni.NetworkID,
@@ -95,7 +110,18 @@ func (ni *NodeInfo) MarshalRecord() ([]byte, error) {
 }
 
 // UnmarshalRecord unmarshals a []byte payload into an instance of a particular Record type
+// it accepts both JSON and SSZ encoded payloads
 func (ni *NodeInfo) UnmarshalRecord(data []byte) error {
+	if message.IsVersioned(data) {
+		obj := new(nodeInfoSSZ)
+		if err := message.DecodeVersioned(data, obj); err != nil {
+			return errors.Wrap(err, "could not decode node info")
+		}
+		ni.fromSSZ(obj)
+		ni.encoding = EncodingSSZSnappy
+		return nil
+	}
+
 	var ser serializable
 
 	if err := json.Unmarshal(data, &ser); err != nil {
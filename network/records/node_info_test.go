@@ -7,6 +7,8 @@ import (
 
 	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/stretchr/testify/require"
+
+	"github.com/bloxapp/ssv/protocol/v2/message"
 )
 
 func TestNodeInfo_Seal_Consume(t *testing.T) {
@@ -50,3 +52,42 @@ func TestNodeInfo_Marshal_Unmarshal(t *testing.T) {
 
 	require.True(t, reflect.DeepEqual(ni, parsedRec))
 }
+
+func TestNodeInfo_SSZ(t *testing.T) {
+	netKey, _, err := crypto.GenerateSecp256k1Key(crand.Reader)
+	require.NoError(t, err)
+	ni := (&NodeInfo{
+		NetworkID: "testnet",
+		Metadata: &NodeMetadata{
+			NodeVersion:   "v0.1.12",
+			ExecutionNode: "geth/x",
+			ConsensusNode: "prysm/x",
+			OperatorID:    "xxx",
+			Subnets:       "ffffffffffffffffffffffffffffffff",
+		},
+	}).WithEncoding(EncodingSSZSnappy)
+
+	data, err := ni.MarshalRecord()
+	require.NoError(t, err)
+	require.True(t, message.IsVersioned(data))
+
+	parsedRec := &NodeInfo{}
+	require.NoError(t, parsedRec.UnmarshalRecord(data))
+	require.True(t, reflect.DeepEqual(ni, parsedRec))
+
+	sealed, err := ni.Seal(netKey)
+	require.NoError(t, err)
+	parsedRec = &NodeInfo{}
+	require.NoError(t, parsedRec.Consume(sealed))
+	require.True(t, reflect.DeepEqual(ni, parsedRec))
+
+	t.Run("no metadata", func(t *testing.T) {
+		ni := NewNodeInfo("testnet").WithEncoding(EncodingSSZSnappy)
+		data, err := ni.MarshalRecord()
+		require.NoError(t, err)
+		parsedRec := &NodeInfo{}
+		require.NoError(t, parsedRec.UnmarshalRecord(data))
+		require.Nil(t, parsedRec.Metadata)
+		require.Equal(t, "testnet", parsedRec.NetworkID)
+	})
+}
@@ -0,0 +1,76 @@
+package records
+
+// Encoding is the encoding of records payloads
+type Encoding byte
+
+const (
+	// EncodingJSON is the legacy encoding of records, based on serializable
+	EncodingJSON Encoding = iota
+	// EncodingSSZSnappy is versioned SSZ encoding, compressed with snappy
+	EncodingSSZSnappy
+)
+
+// nodeMetadataSSZ is the SSZ representation of NodeMetadata
+type nodeMetadataSSZ struct {
+	NodeVersion   []byte `ssz-max:"256"`
+	OperatorID    []byte `ssz-max:"256"`
+	ExecutionNode []byte `ssz-max:"256"`
+	ConsensusNode []byte `ssz-max:"256"`
+	Subnets       []byte `ssz-max:"256"`
+}
+
+// nodeInfoSSZ is the SSZ representation of NodeInfo
+type nodeInfoSSZ struct {
+	NetworkID   []byte `ssz-max:"256"`
+	HasMetadata bool
+	Metadata    *nodeMetadataSSZ
+}
+
+// signedNodeInfoSSZ is the SSZ representation of SignedNodeInfo
+type signedNodeInfoSSZ struct {
+	SenderPeerID    []byte `ssz-max:"128"`
+	RecipientPeerID []byte `ssz-max:"128"`
+	Timestamp       uint64
+	SenderPublicKey []byte `ssz-max:"2048"`
+	Signature       []byte `ssz-max:"1024"`
+	NodeInfo        *nodeInfoSSZ
+}
+
+func (nm *NodeMetadata) toSSZ() *nodeMetadataSSZ {
+	return &nodeMetadataSSZ{
+		NodeVersion:   []byte(nm.NodeVersion),
+		OperatorID:    []byte(nm.OperatorID),
+		ExecutionNode: []byte(nm.ExecutionNode),
+		ConsensusNode: []byte(nm.ConsensusNode),
+		Subnets:       []byte(nm.Subnets),
+	}
+}
+
+func (nm *NodeMetadata) fromSSZ(obj *nodeMetadataSSZ) {
+	nm.NodeVersion = string(obj.NodeVersion)
+	nm.OperatorID = string(obj.OperatorID)
+	nm.ExecutionNode = string(obj.ExecutionNode)
+	nm.ConsensusNode = string(obj.ConsensusNode)
+	nm.Subnets = string(obj.Subnets)
+}
+
+func (ni *NodeInfo) toSSZ() *nodeInfoSSZ {
+	obj := &nodeInfoSSZ{
+		NetworkID: []byte(ni.NetworkID),
+		Metadata:  new(nodeMetadataSSZ),
+	}
+	if ni.Metadata != nil {
+		obj.HasMetadata = true
+		obj.Metadata = ni.Metadata.toSSZ()
+	}
+	return obj
+}
+
+func (ni *NodeInfo) fromSSZ(obj *nodeInfoSSZ) {
+	ni.NetworkID = string(obj.NetworkID)
+	ni.Metadata = nil
+	if obj.HasMetadata && obj.Metadata != nil {
+		ni.Metadata = new(NodeMetadata)
+		ni.Metadata.fromSSZ(obj.Metadata)
+	}
+}
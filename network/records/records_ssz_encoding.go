@@ -0,0 +1,699 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: 4d331b340dbe16ff67279f5db92ca4b514b7b7e2d7be8bb3f95e7362057df060
+// Version: 0.1.3
+package records
+
+import (
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MarshalSSZ ssz marshals the nodeMetadataSSZ object
+func (n *nodeMetadataSSZ) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(n)
+}
+
+// MarshalSSZTo ssz marshals the nodeMetadataSSZ object to a target array
+func (n *nodeMetadataSSZ) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(20)
+
+	// Offset (0) 'NodeVersion'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(n.NodeVersion)
+
+	// Offset (1) 'OperatorID'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(n.OperatorID)
+
+	// Offset (2) 'ExecutionNode'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(n.ExecutionNode)
+
+	// Offset (3) 'ConsensusNode'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(n.ConsensusNode)
+
+	// Offset (4) 'Subnets'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(n.Subnets)
+
+	// Field (0) 'NodeVersion'
+	if size := len(n.NodeVersion); size > 256 {
+		err = ssz.ErrBytesLengthFn("nodeMetadataSSZ.NodeVersion", size, 256)
+		return
+	}
+	dst = append(dst, n.NodeVersion...)
+
+	// Field (1) 'OperatorID'
+	if size := len(n.OperatorID); size > 256 {
+		err = ssz.ErrBytesLengthFn("nodeMetadataSSZ.OperatorID", size, 256)
+		return
+	}
+	dst = append(dst, n.OperatorID...)
+
+	// Field (2) 'ExecutionNode'
+	if size := len(n.ExecutionNode); size > 256 {
+		err = ssz.ErrBytesLengthFn("nodeMetadataSSZ.ExecutionNode", size, 256)
+		return
+	}
+	dst = append(dst, n.ExecutionNode...)
+
+	// Field (3) 'ConsensusNode'
+	if size := len(n.ConsensusNode); size > 256 {
+		err = ssz.ErrBytesLengthFn("nodeMetadataSSZ.ConsensusNode", size, 256)
+		return
+	}
+	dst = append(dst, n.ConsensusNode...)
+
+	// Field (4) 'Subnets'
+	if size := len(n.Subnets); size > 256 {
+		err = ssz.ErrBytesLengthFn("nodeMetadataSSZ.Subnets", size, 256)
+		return
+	}
+	dst = append(dst, n.Subnets...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the nodeMetadataSSZ object
+func (n *nodeMetadataSSZ) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 20 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1, o2, o3, o4 uint64
+
+	// Offset (0) 'NodeVersion'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 20 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'OperatorID'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (2) 'ExecutionNode'
+	if o2 = ssz.ReadOffset(buf[8:12]); o2 > size || o1 > o2 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (3) 'ConsensusNode'
+	if o3 = ssz.ReadOffset(buf[12:16]); o3 > size || o2 > o3 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (4) 'Subnets'
+	if o4 = ssz.ReadOffset(buf[16:20]); o4 > size || o3 > o4 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'NodeVersion'
+	{
+		buf = tail[o0:o1]
+		if len(buf) > 256 {
+			return ssz.ErrBytesLength
+		}
+		if cap(n.NodeVersion) == 0 {
+			n.NodeVersion = make([]byte, 0, len(buf))
+		}
+		n.NodeVersion = append(n.NodeVersion, buf...)
+	}
+
+	// Field (1) 'OperatorID'
+	{
+		buf = tail[o1:o2]
+		if len(buf) > 256 {
+			return ssz.ErrBytesLength
+		}
+		if cap(n.OperatorID) == 0 {
+			n.OperatorID = make([]byte, 0, len(buf))
+		}
+		n.OperatorID = append(n.OperatorID, buf...)
+	}
+
+	// Field (2) 'ExecutionNode'
+	{
+		buf = tail[o2:o3]
+		if len(buf) > 256 {
+			return ssz.ErrBytesLength
+		}
+		if cap(n.ExecutionNode) == 0 {
+			n.ExecutionNode = make([]byte, 0, len(buf))
+		}
+		n.ExecutionNode = append(n.ExecutionNode, buf...)
+	}
+
+	// Field (3) 'ConsensusNode'
+	{
+		buf = tail[o3:o4]
+		if len(buf) > 256 {
+			return ssz.ErrBytesLength
+		}
+		if cap(n.ConsensusNode) == 0 {
+			n.ConsensusNode = make([]byte, 0, len(buf))
+		}
+		n.ConsensusNode = append(n.ConsensusNode, buf...)
+	}
+
+	// Field (4) 'Subnets'
+	{
+		buf = tail[o4:]
+		if len(buf) > 256 {
+			return ssz.ErrBytesLength
+		}
+		if cap(n.Subnets) == 0 {
+			n.Subnets = make([]byte, 0, len(buf))
+		}
+		n.Subnets = append(n.Subnets, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the nodeMetadataSSZ object
+func (n *nodeMetadataSSZ) SizeSSZ() (size int) {
+	size = 20
+
+	// Field (0) 'NodeVersion'
+	size += len(n.NodeVersion)
+
+	// Field (1) 'OperatorID'
+	size += len(n.OperatorID)
+
+	// Field (2) 'ExecutionNode'
+	size += len(n.ExecutionNode)
+
+	// Field (3) 'ConsensusNode'
+	size += len(n.ConsensusNode)
+
+	// Field (4) 'Subnets'
+	size += len(n.Subnets)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the nodeMetadataSSZ object
+func (n *nodeMetadataSSZ) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(n)
+}
+
+// HashTreeRootWith ssz hashes the nodeMetadataSSZ object with a hasher
+func (n *nodeMetadataSSZ) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'NodeVersion'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(n.NodeVersion))
+		if byteLen > 256 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(n.NodeVersion)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (256+31)/32)
+	}
+
+	// Field (1) 'OperatorID'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(n.OperatorID))
+		if byteLen > 256 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(n.OperatorID)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (256+31)/32)
+	}
+
+	// Field (2) 'ExecutionNode'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(n.ExecutionNode))
+		if byteLen > 256 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(n.ExecutionNode)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (256+31)/32)
+	}
+
+	// Field (3) 'ConsensusNode'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(n.ConsensusNode))
+		if byteLen > 256 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(n.ConsensusNode)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (256+31)/32)
+	}
+
+	// Field (4) 'Subnets'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(n.Subnets))
+		if byteLen > 256 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(n.Subnets)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (256+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the nodeMetadataSSZ object
+func (n *nodeMetadataSSZ) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(n)
+}
+
+// MarshalSSZ ssz marshals the nodeInfoSSZ object
+func (n *nodeInfoSSZ) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(n)
+}
+
+// MarshalSSZTo ssz marshals the nodeInfoSSZ object to a target array
+func (n *nodeInfoSSZ) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(9)
+
+	// Offset (0) 'NetworkID'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(n.NetworkID)
+
+	// Field (1) 'HasMetadata'
+	dst = ssz.MarshalBool(dst, n.HasMetadata)
+
+	// Offset (2) 'Metadata'
+	dst = ssz.WriteOffset(dst, offset)
+	if n.Metadata == nil {
+		n.Metadata = new(nodeMetadataSSZ)
+	}
+	offset += n.Metadata.SizeSSZ()
+
+	// Field (0) 'NetworkID'
+	if size := len(n.NetworkID); size > 256 {
+		err = ssz.ErrBytesLengthFn("nodeInfoSSZ.NetworkID", size, 256)
+		return
+	}
+	dst = append(dst, n.NetworkID...)
+
+	// Field (2) 'Metadata'
+	if dst, err = n.Metadata.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the nodeInfoSSZ object
+func (n *nodeInfoSSZ) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 9 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o2 uint64
+
+	// Offset (0) 'NetworkID'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 9 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (1) 'HasMetadata'
+	n.HasMetadata = ssz.UnmarshalBool(buf[4:5])
+
+	// Offset (2) 'Metadata'
+	if o2 = ssz.ReadOffset(buf[5:9]); o2 > size || o0 > o2 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'NetworkID'
+	{
+		buf = tail[o0:o2]
+		if len(buf) > 256 {
+			return ssz.ErrBytesLength
+		}
+		if cap(n.NetworkID) == 0 {
+			n.NetworkID = make([]byte, 0, len(buf))
+		}
+		n.NetworkID = append(n.NetworkID, buf...)
+	}
+
+	// Field (2) 'Metadata'
+	{
+		buf = tail[o2:]
+		if n.Metadata == nil {
+			n.Metadata = new(nodeMetadataSSZ)
+		}
+		if err = n.Metadata.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the nodeInfoSSZ object
+func (n *nodeInfoSSZ) SizeSSZ() (size int) {
+	size = 9
+
+	// Field (0) 'NetworkID'
+	size += len(n.NetworkID)
+
+	// Field (2) 'Metadata'
+	if n.Metadata == nil {
+		n.Metadata = new(nodeMetadataSSZ)
+	}
+	size += n.Metadata.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the nodeInfoSSZ object
+func (n *nodeInfoSSZ) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(n)
+}
+
+// HashTreeRootWith ssz hashes the nodeInfoSSZ object with a hasher
+func (n *nodeInfoSSZ) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'NetworkID'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(n.NetworkID))
+		if byteLen > 256 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(n.NetworkID)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (256+31)/32)
+	}
+
+	// Field (1) 'HasMetadata'
+	hh.PutBool(n.HasMetadata)
+
+	// Field (2) 'Metadata'
+	if err = n.Metadata.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the nodeInfoSSZ object
+func (n *nodeInfoSSZ) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(n)
+}
+
+// MarshalSSZ ssz marshals the signedNodeInfoSSZ object
+func (s *signedNodeInfoSSZ) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the signedNodeInfoSSZ object to a target array
+func (s *signedNodeInfoSSZ) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(28)
+
+	// Offset (0) 'SenderPeerID'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(s.SenderPeerID)
+
+	// Offset (1) 'RecipientPeerID'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(s.RecipientPeerID)
+
+	// Field (2) 'Timestamp'
+	dst = ssz.MarshalUint64(dst, s.Timestamp)
+
+	// Offset (3) 'SenderPublicKey'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(s.SenderPublicKey)
+
+	// Offset (4) 'Signature'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(s.Signature)
+
+	// Offset (5) 'NodeInfo'
+	dst = ssz.WriteOffset(dst, offset)
+	if s.NodeInfo == nil {
+		s.NodeInfo = new(nodeInfoSSZ)
+	}
+	offset += s.NodeInfo.SizeSSZ()
+
+	// Field (0) 'SenderPeerID'
+	if size := len(s.SenderPeerID); size > 128 {
+		err = ssz.ErrBytesLengthFn("signedNodeInfoSSZ.SenderPeerID", size, 128)
+		return
+	}
+	dst = append(dst, s.SenderPeerID...)
+
+	// Field (1) 'RecipientPeerID'
+	if size := len(s.RecipientPeerID); size > 128 {
+		err = ssz.ErrBytesLengthFn("signedNodeInfoSSZ.RecipientPeerID", size, 128)
+		return
+	}
+	dst = append(dst, s.RecipientPeerID...)
+
+	// Field (3) 'SenderPublicKey'
+	if size := len(s.SenderPublicKey); size > 2048 {
+		err = ssz.ErrBytesLengthFn("signedNodeInfoSSZ.SenderPublicKey", size, 2048)
+		return
+	}
+	dst = append(dst, s.SenderPublicKey...)
+
+	// Field (4) 'Signature'
+	if size := len(s.Signature); size > 1024 {
+		err = ssz.ErrBytesLengthFn("signedNodeInfoSSZ.Signature", size, 1024)
+		return
+	}
+	dst = append(dst, s.Signature...)
+
+	// Field (5) 'NodeInfo'
+	if dst, err = s.NodeInfo.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the signedNodeInfoSSZ object
+func (s *signedNodeInfoSSZ) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 28 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1, o3, o4, o5 uint64
+
+	// Offset (0) 'SenderPeerID'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 28 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'RecipientPeerID'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Field (2) 'Timestamp'
+	s.Timestamp = ssz.UnmarshallUint64(buf[8:16])
+
+	// Offset (3) 'SenderPublicKey'
+	if o3 = ssz.ReadOffset(buf[16:20]); o3 > size || o1 > o3 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (4) 'Signature'
+	if o4 = ssz.ReadOffset(buf[20:24]); o4 > size || o3 > o4 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (5) 'NodeInfo'
+	if o5 = ssz.ReadOffset(buf[24:28]); o5 > size || o4 > o5 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'SenderPeerID'
+	{
+		buf = tail[o0:o1]
+		if len(buf) > 128 {
+			return ssz.ErrBytesLength
+		}
+		if cap(s.SenderPeerID) == 0 {
+			s.SenderPeerID = make([]byte, 0, len(buf))
+		}
+		s.SenderPeerID = append(s.SenderPeerID, buf...)
+	}
+
+	// Field (1) 'RecipientPeerID'
+	{
+		buf = tail[o1:o3]
+		if len(buf) > 128 {
+			return ssz.ErrBytesLength
+		}
+		if cap(s.RecipientPeerID) == 0 {
+			s.RecipientPeerID = make([]byte, 0, len(buf))
+		}
+		s.RecipientPeerID = append(s.RecipientPeerID, buf...)
+	}
+
+	// Field (3) 'SenderPublicKey'
+	{
+		buf = tail[o3:o4]
+		if len(buf) > 2048 {
+			return ssz.ErrBytesLength
+		}
+		if cap(s.SenderPublicKey) == 0 {
+			s.SenderPublicKey = make([]byte, 0, len(buf))
+		}
+		s.SenderPublicKey = append(s.SenderPublicKey, buf...)
+	}
+
+	// Field (4) 'Signature'
+	{
+		buf = tail[o4:o5]
+		if len(buf) > 1024 {
+			return ssz.ErrBytesLength
+		}
+		if cap(s.Signature) == 0 {
+			s.Signature = make([]byte, 0, len(buf))
+		}
+		s.Signature = append(s.Signature, buf...)
+	}
+
+	// Field (5) 'NodeInfo'
+	{
+		buf = tail[o5:]
+		if s.NodeInfo == nil {
+			s.NodeInfo = new(nodeInfoSSZ)
+		}
+		if err = s.NodeInfo.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the signedNodeInfoSSZ object
+func (s *signedNodeInfoSSZ) SizeSSZ() (size int) {
+	size = 28
+
+	// Field (0) 'SenderPeerID'
+	size += len(s.SenderPeerID)
+
+	// Field (1) 'RecipientPeerID'
+	size += len(s.RecipientPeerID)
+
+	// Field (3) 'SenderPublicKey'
+	size += len(s.SenderPublicKey)
+
+	// Field (4) 'Signature'
+	size += len(s.Signature)
+
+	// Field (5) 'NodeInfo'
+	if s.NodeInfo == nil {
+		s.NodeInfo = new(nodeInfoSSZ)
+	}
+	size += s.NodeInfo.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the signedNodeInfoSSZ object
+func (s *signedNodeInfoSSZ) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the signedNodeInfoSSZ object with a hasher
+func (s *signedNodeInfoSSZ) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'SenderPeerID'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(s.SenderPeerID))
+		if byteLen > 128 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(s.SenderPeerID)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (128+31)/32)
+	}
+
+	// Field (1) 'RecipientPeerID'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(s.RecipientPeerID))
+		if byteLen > 128 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(s.RecipientPeerID)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (128+31)/32)
+	}
+
+	// Field (2) 'Timestamp'
+	hh.PutUint64(s.Timestamp)
+
+	// Field (3) 'SenderPublicKey'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(s.SenderPublicKey))
+		if byteLen > 2048 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(s.SenderPublicKey)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (2048+31)/32)
+	}
+
+	// Field (4) 'Signature'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(s.Signature))
+		if byteLen > 1024 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(s.Signature)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (1024+31)/32)
+	}
+
+	// Field (5) 'NodeInfo'
+	if err = s.NodeInfo.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the signedNodeInfoSSZ object
+func (s *signedNodeInfoSSZ) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
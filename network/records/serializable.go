@@ -2,8 +2,8 @@ package records
 
 // serializable is a struct that can be encoded w/o worries of different encoding implementations,
 // e.g. JSON where an unordered map can be different across environments.
-// it uses a slice of entries to keep ordered values
-// TODO: use SSZ
+// it uses a slice of entries to keep ordered values.
+// it is the legacy (EncodingJSON) encoding of records, see records_ssz.go for the SSZ encoding
 type serializable struct {
 	Entries []string
 }
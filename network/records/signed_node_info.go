@@ -10,6 +10,8 @@ import (
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/core/record"
 	"github.com/pkg/errors"
+
+	"github.com/bloxapp/ssv/protocol/v2/message"
 )
 
 type AnyNodeInfo interface {
@@ -26,6 +28,16 @@ type SignedNodeInfo struct {
 	NodeInfo      *NodeInfo
 	HandshakeData HandshakeData
 	Signature     []byte
+
+	// encoding is the encoding of the record, EncodingJSON by default
+	encoding Encoding
+}
+
+// WithEncoding returns a copy of the signed node info that is encoded with the given encoding
+func (sni *SignedNodeInfo) WithEncoding(encoding Encoding) *SignedNodeInfo {
+	cp := *sni
+	cp.encoding = encoding
+	return &cp
 }
 
 // GetNodeInfo returns inner representation of the info
@@ -44,6 +56,17 @@ func (sni *SignedNodeInfo) Codec() []byte {
 // MarshalRecord serializes SignedNodeInfo
 // IMPORTANT: MarshalRecord rounds SignedNodeInfo.HandshakeData.Timestamp to seconds
 func (sni *SignedNodeInfo) MarshalRecord() ([]byte, error) {
+	if sni.encoding == EncodingSSZSnappy {
+		return message.EncodeVersioned(&signedNodeInfoSSZ{
+			SenderPeerID:    []byte(sni.HandshakeData.SenderPeerID),
+			RecipientPeerID: []byte(sni.HandshakeData.RecipientPeerID),
+			Timestamp:       uint64(sni.HandshakeData.Timestamp.Unix()),
+			SenderPublicKey: sni.HandshakeData.SenderPublicKey,
+			Signature:       sni.Signature,
+			NodeInfo:        sni.NodeInfo.toSSZ(),
+		}, true)
+	}
+
 	parts := []string{
 		base64.StdEncoding.EncodeToString([]byte(sni.HandshakeData.SenderPeerID)),
 		base64.StdEncoding.EncodeToString([]byte(sni.HandshakeData.RecipientPeerID)),
@@ -62,7 +85,26 @@ func (sni *SignedNodeInfo) MarshalRecord() ([]byte, error) {
 	return json.Marshal(ser)
 }
 
+// UnmarshalRecord deserializes SignedNodeInfo, it accepts both JSON and SSZ encoded payloads
 func (sni *SignedNodeInfo) UnmarshalRecord(data []byte) error {
+	if message.IsVersioned(data) {
+		obj := new(signedNodeInfoSSZ)
+		if err := message.DecodeVersioned(data, obj); err != nil {
+			return errors.Wrap(err, "could not decode signed node info")
+		}
+		sni.HandshakeData.SenderPeerID = peer.ID(obj.SenderPeerID)
+		sni.HandshakeData.RecipientPeerID = peer.ID(obj.RecipientPeerID)
+		sni.HandshakeData.Timestamp = time.Unix(int64(obj.Timestamp), 0)
+		sni.HandshakeData.SenderPublicKey = obj.SenderPublicKey
+		sni.Signature = obj.Signature
+		sni.NodeInfo = &NodeInfo{}
+		if obj.NodeInfo != nil {
+			sni.NodeInfo.fromSSZ(obj.NodeInfo)
+		}
+		sni.encoding = EncodingSSZSnappy
+		return nil
+	}
+
 	ser := serializable{}
 	if err := json.Unmarshal(data, &ser); err != nil {
 		return err
@@ -115,3 +115,62 @@ func TestSignedNodeInfo_Marshal_Unmarshal(t *testing.T) {
 
 	require.True(t, reflect.DeepEqual(sni, parsedRec))
 }
+
+func TestSignedNodeInfo_SSZ(t *testing.T) {
+	nodeInfo := &NodeInfo{
+		NetworkID: "testnet",
+		Metadata: &NodeMetadata{
+			NodeVersion:   "v0.1.12",
+			ExecutionNode: "geth/x",
+			ConsensusNode: "prysm/x",
+			OperatorID:    "xxx",
+			Subnets:       "some-subnets",
+		},
+	}
+
+	_, senderPrivateKeyPem, err := rsaencryption.GenerateKeys()
+	require.NoError(t, err)
+
+	senderPrivateKey, err := rsaencryption.ConvertPemToPrivateKey(string(senderPrivateKeyPem))
+	require.NoError(t, err)
+
+	senderBase64PublicKeyPem, err := rsaencryption.ExtractPublicKey(senderPrivateKey)
+	require.NoError(t, err)
+
+	handshakeData := HandshakeData{
+		SenderPeerID:    peer.ID("1.1.1.1"),
+		RecipientPeerID: peer.ID("2.2.2.2"),
+		Timestamp:       time.Now().Round(time.Second),
+		SenderPublicKey: []byte(senderBase64PublicKeyPem),
+	}
+	hashed := handshakeData.Hash()
+
+	signature, err := rsa.SignPKCS1v15(nil, senderPrivateKey, crypto.SHA256, hashed[:])
+	require.NoError(t, err)
+
+	sni := (&SignedNodeInfo{
+		NodeInfo:      nodeInfo,
+		HandshakeData: handshakeData,
+		Signature:     signature,
+	}).WithEncoding(EncodingSSZSnappy)
+
+	netKey, _, err := libp2pcrypto.GenerateSecp256k1Key(rand.Reader)
+	require.NoError(t, err)
+
+	data, err := sni.Seal(netKey)
+	require.NoError(t, err)
+
+	parsedRec := &SignedNodeInfo{}
+	require.NoError(t, parsedRec.Consume(data))
+	require.True(t, reflect.DeepEqual(sni, parsedRec))
+
+	legacy, err := (&SignedNodeInfo{
+		NodeInfo:      nodeInfo,
+		HandshakeData: handshakeData,
+		Signature:     signature,
+	}).MarshalRecord()
+	require.NoError(t, err)
+	encoded, err := sni.MarshalRecord()
+	require.NoError(t, err)
+	require.Less(t, len(encoded), len(legacy))
+}
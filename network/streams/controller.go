@@ -21,6 +21,9 @@ type StreamResponder func([]byte) error
 type StreamController interface {
 	// Request sends a message to the given stream and returns the response
 	Request(logger *zap.Logger, peerID peer.ID, protocol protocol.ID, msg []byte) ([]byte, error)
+	// NegotiateRequest opens a stream with the first of the given protocols that is supported by the peer,
+	// sends the message created by encode for that protocol and returns the response and the negotiated protocol
+	NegotiateRequest(logger *zap.Logger, peerID peer.ID, protocols []protocol.ID, encode func(protocol.ID) ([]byte, error)) ([]byte, protocol.ID, error)
 	// HandleStream is called at the beginning of stream handlers to create a wrapper stream and read first message
 	HandleStream(logger *zap.Logger, stream core.Stream) ([]byte, StreamResponder, func(), error)
 }
@@ -47,37 +50,55 @@ type streamCtrl struct {
 }
 
 // Request sends a message to the given stream and returns the response
-func (n *streamCtrl) Request(logger *zap.Logger, peerID peer.ID, protocol protocol.ID, data []byte) ([]byte, error) {
+func (n *streamCtrl) Request(logger *zap.Logger, peerID peer.ID, pid protocol.ID, data []byte) ([]byte, error) {
+	res, _, err := n.request(logger, peerID, []protocol.ID{pid}, func(protocol.ID) ([]byte, error) {
+		return data, nil
+	})
+	return res, err
+}
+
+// NegotiateRequest opens a stream with the first of the given protocols that is supported by the peer,
+// sends the message created by encode for that protocol and returns the response and the negotiated protocol
+func (n *streamCtrl) NegotiateRequest(logger *zap.Logger, peerID peer.ID, protocols []protocol.ID, encode func(protocol.ID) ([]byte, error)) ([]byte, protocol.ID, error) {
+	return n.request(logger, peerID, protocols, encode)
+}
+
+func (n *streamCtrl) request(logger *zap.Logger, peerID peer.ID, protocols []protocol.ID, encode func(protocol.ID) ([]byte, error)) ([]byte, protocol.ID, error) {
 	// Dial with timeout.
 	ctx, cancel := context.WithTimeout(n.ctx, n.dialTimeout)
 	defer cancel()
 
-	s, err := n.host.NewStream(ctx, peerID, protocol)
+	s, err := n.host.NewStream(ctx, peerID, protocols...)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	defer func() {
 		if err := s.Close(); err != nil {
 			logger.Debug("could not close stream", zap.Error(err))
 		}
 	}()
+	protocol := s.Protocol()
+	data, err := encode(protocol)
+	if err != nil {
+		return nil, protocol, errors.Wrap(err, "could not encode request")
+	}
 	stream := NewStream(s)
 	metricsStreamOutgoingRequests.WithLabelValues(string(protocol)).Inc()
 	metricsStreamRequestsActive.WithLabelValues(string(protocol)).Inc()
 	defer metricsStreamRequestsActive.WithLabelValues(string(protocol)).Dec()
 
 	if err := stream.WriteWithTimeout(data, n.readWriteTimeout); err != nil {
-		return nil, errors.Wrap(err, "could not write to stream")
+		return nil, protocol, errors.Wrap(err, "could not write to stream")
 	}
 	if err := s.CloseWrite(); err != nil {
-		return nil, errors.Wrap(err, "could not close write stream")
+		return nil, protocol, errors.Wrap(err, "could not close write stream")
 	}
 	res, err := stream.ReadWithTimeout(n.readWriteTimeout)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not read stream msg")
+		return nil, protocol, errors.Wrap(err, "could not read stream msg")
 	}
 	metricsStreamRequestsSuccess.WithLabelValues(string(protocol)).Inc()
-	return res, nil
+	return res, protocol, nil
 }
 
 // HandleStream is called at the beginning of stream handlers to create a wrapper stream and read first message
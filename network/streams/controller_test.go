@@ -41,6 +41,26 @@ func TestStreamCtrl(t *testing.T) {
 		require.True(t, bytes.Equal(res, d))
 	})
 
+	t.Run("negotiate request", func(t *testing.T) {
+		d, err := dummyMsg().Encode()
+		require.NoError(t, err)
+		unsupported := protocol.ID("/test/protocol/unsupported")
+		var encodedFor protocol.ID
+		res, negotiated, err := ctrl1.NegotiateRequest(logger, hosts[0].ID(), []protocol.ID{unsupported, prot}, func(pid protocol.ID) ([]byte, error) {
+			encodedFor = pid
+			return d, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, prot, negotiated)
+		require.Equal(t, prot, encodedFor)
+		require.True(t, bytes.Equal(res, d))
+
+		_, _, err = ctrl1.NegotiateRequest(logger, hosts[0].ID(), []protocol.ID{unsupported}, func(pid protocol.ID) ([]byte, error) {
+			return d, nil
+		})
+		require.Error(t, err)
+	})
+
 	t.Run("request deadline", func(t *testing.T) {
 		timeout := time.Millisecond * 10
 		ctrl0.(*streamCtrl).readWriteTimeout = timeout
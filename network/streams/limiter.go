@@ -0,0 +1,119 @@
+package streams
+
+import (
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+)
+
+var (
+	// ErrRateLimited is returned when a peer exceeded its requests rate limit
+	ErrRateLimited = errors.New("requests rate limit exceeded")
+	// ErrTooManyRequests is returned when the protocol is handling too many requests concurrently
+	ErrTooManyRequests = errors.New("too many concurrent requests")
+)
+
+// LimiterCfg is the configuration for creating a RequestLimiter
+type LimiterCfg struct {
+	// Rate is the amount of requests per second that are allowed per peer and protocol, 0 means no limit
+	Rate float64
+	// Burst is the amount of requests that a peer can make at once, on top of Rate
+	Burst int
+	// MaxConcurrent is the amount of requests that are handled concurrently per protocol, 0 means no limit
+	MaxConcurrent int
+}
+
+type limiterKey struct {
+	peer     peer.ID
+	protocol protocol.ID
+}
+
+type peerLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// RequestLimiter limits the rate of incoming stream requests per peer and protocol,
+// and the amount of requests that are handled concurrently per protocol
+type RequestLimiter struct {
+	cfg LimiterCfg
+
+	lock     sync.Mutex
+	peers    map[limiterKey]*peerLimiter
+	inflight map[protocol.ID]chan struct{}
+}
+
+// NewRequestLimiter creates a new instance of RequestLimiter
+func NewRequestLimiter(cfg LimiterCfg) *RequestLimiter {
+	return &RequestLimiter{
+		cfg:      cfg,
+		peers:    make(map[limiterKey]*peerLimiter),
+		inflight: make(map[protocol.ID]chan struct{}),
+	}
+}
+
+// Acquire checks the rate limit of the given peer and reserves a slot for handling its request.
+// the returned release function must be called once the request was handled
+func (l *RequestLimiter) Acquire(pid peer.ID, prot protocol.ID) (func(), error) {
+	l.lock.Lock()
+	key := limiterKey{peer: pid, protocol: prot}
+	pl, ok := l.peers[key]
+	if !ok {
+		limit := rate.Inf
+		if l.cfg.Rate > 0 {
+			limit = rate.Limit(l.cfg.Rate)
+		}
+		pl = &peerLimiter{limiter: rate.NewLimiter(limit, l.cfg.Burst)}
+		l.peers[key] = pl
+	}
+	pl.lastSeen = time.Now()
+	allowed := pl.limiter.Allow()
+	slots := l.slots(prot)
+	l.lock.Unlock()
+
+	if !allowed {
+		return nil, ErrRateLimited
+	}
+	if slots == nil {
+		return func() {}, nil
+	}
+	select {
+	case slots <- struct{}{}:
+		return func() { <-slots }, nil
+	default:
+		return nil, ErrTooManyRequests
+	}
+}
+
+// slots returns the concurrency slots of the given protocol, or nil if there is no limit.
+// MUST be called with the lock held
+func (l *RequestLimiter) slots(prot protocol.ID) chan struct{} {
+	if l.cfg.MaxConcurrent <= 0 {
+		return nil
+	}
+	slots, ok := l.inflight[prot]
+	if !ok {
+		slots = make(chan struct{}, l.cfg.MaxConcurrent)
+		l.inflight[prot] = slots
+	}
+	return slots
+}
+
+// Prune removes the limiters of peers that didn't make requests since the given time
+func (l *RequestLimiter) Prune(idleSince time.Time) int {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	pruned := 0
+	for key, pl := range l.peers {
+		if pl.lastSeen.Before(idleSince) {
+			delete(l.peers, key)
+			pruned++
+		}
+	}
+	return pruned
+}
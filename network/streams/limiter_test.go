@@ -0,0 +1,63 @@
+package streams
+
+import (
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestLimiter(t *testing.T) {
+	prot := protocol.ID("/test/protocol")
+	p1, p2 := peer.ID("peer-1"), peer.ID("peer-2")
+
+	t.Run("rate limit", func(t *testing.T) {
+		l := NewRequestLimiter(LimiterCfg{Rate: 0.001, Burst: 2})
+		for i := 0; i < 2; i++ {
+			release, err := l.Acquire(p1, prot)
+			require.NoError(t, err)
+			release()
+		}
+		_, err := l.Acquire(p1, prot)
+		require.ErrorIs(t, err, ErrRateLimited)
+
+		// other peers and protocols are not affected
+		_, err = l.Acquire(p2, prot)
+		require.NoError(t, err)
+		_, err = l.Acquire(p1, protocol.ID("/test/other"))
+		require.NoError(t, err)
+	})
+
+	t.Run("max concurrent", func(t *testing.T) {
+		l := NewRequestLimiter(LimiterCfg{Rate: 100, Burst: 100, MaxConcurrent: 1})
+		release, err := l.Acquire(p1, prot)
+		require.NoError(t, err)
+		_, err = l.Acquire(p2, prot)
+		require.ErrorIs(t, err, ErrTooManyRequests)
+		release()
+		release, err = l.Acquire(p2, prot)
+		require.NoError(t, err)
+		release()
+	})
+
+	t.Run("no limits", func(t *testing.T) {
+		l := NewRequestLimiter(LimiterCfg{})
+		for i := 0; i < 100; i++ {
+			_, err := l.Acquire(p1, prot)
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("prune", func(t *testing.T) {
+		l := NewRequestLimiter(LimiterCfg{Rate: 0.001, Burst: 1})
+		_, err := l.Acquire(p1, prot)
+		require.NoError(t, err)
+		require.Equal(t, 0, l.Prune(time.Now().Add(-time.Minute)))
+		require.Equal(t, 1, l.Prune(time.Now().Add(time.Minute)))
+		// the limiter was reset
+		_, err = l.Acquire(p1, prot)
+		require.NoError(t, err)
+	})
+}
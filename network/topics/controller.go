@@ -20,7 +20,8 @@ var (
 	ErrTopicNotReady = errors.New("topic is not ready")
 )
 
-// Controller is an interface for managing pubsub topics
+// Controller is an interface for managing pubsub topics.
+// Topics are referred by their full name, which includes the prefix of the fork (see commons.ForkTopicFullName)
 type Controller interface {
 	// Subscribe subscribes to the given topic
 	Subscribe(logger *zap.Logger, name string) error
@@ -98,7 +99,7 @@ func (ctrl *topicsCtrl) onNewTopic(logger *zap.Logger) onTopicJoined {
 func (ctrl *topicsCtrl) Close() error {
 	topics := ctrl.ps.GetTopics()
 	for _, tp := range topics {
-		_ = ctrl.Unsubscribe(ctrl.logger, commons.GetTopicBaseName(tp), true)
+		_ = ctrl.Unsubscribe(ctrl.logger, tp, true)
 		_ = ctrl.container.Leave(tp)
 	}
 	return nil
@@ -109,7 +110,6 @@ func (ctrl *topicsCtrl) Peers(name string) ([]peer.ID, error) {
 	if name == "" {
 		return ctrl.ps.ListPeers(""), nil
 	}
-	name = commons.GetTopicFullName(name)
 	topic := ctrl.container.Get(name)
 	if topic == nil {
 		return nil, nil
@@ -119,17 +119,12 @@ func (ctrl *topicsCtrl) Peers(name string) ([]peer.ID, error) {
 
 // Topics lists all the available topics
 func (ctrl *topicsCtrl) Topics() []string {
-	topics := ctrl.ps.GetTopics()
-	for i, tp := range topics {
-		topics[i] = commons.GetTopicBaseName(tp)
-	}
-	return topics
+	return ctrl.ps.GetTopics()
 }
 
 // Subscribe subscribes to the given topic, it can handle multiple concurrent calls.
 // it will create a single goroutine and channel for every topic
 func (ctrl *topicsCtrl) Subscribe(logger *zap.Logger, name string) error {
-	name = commons.GetTopicFullName(name)
 	ctrl.subFilter.(Whitelist).Register(name)
 	sub, err := ctrl.container.Subscribe(name)
 	defer logger.Debug("subscribing to topic", zap.String("topic", name), zap.Bool("already_subscribed", sub == nil), zap.Error(err))
@@ -146,8 +141,6 @@ func (ctrl *topicsCtrl) Subscribe(logger *zap.Logger, name string) error {
 
 // Broadcast publishes the message on the given topic
 func (ctrl *topicsCtrl) Broadcast(name string, data []byte, timeout time.Duration) error {
-	name = commons.GetTopicFullName(name)
-
 	topic, err := ctrl.container.Join(name)
 	if err != nil {
 		return err
@@ -54,7 +54,7 @@ func baseTest(t *testing.T, ctx context.Context, logger *zap.Logger, peers []*P,
 		if err != nil {
 			return "invalid"
 		}
-		return commons.ValidatorTopicID(pk)[0]
+		return commons.GetTopicFullName(commons.ValidatorTopicID(pk)[0])
 	}
 
 	t.Log("subscribing to topics")
@@ -109,11 +109,11 @@ func baseTest(t *testing.T, ctx context.Context, logger *zap.Logger, peers []*P,
 				defer wg.Done()
 				for _, p := range peers {
 					// wait for messages
-					for ctxReadMessages.Err() == nil && p.getCount(commons.GetTopicFullName(validatorTopic(pk))) < minMsgCount {
+					for ctxReadMessages.Err() == nil && p.getCount(validatorTopic(pk)) < minMsgCount {
 						time.Sleep(time.Millisecond * 100)
 					}
 					require.NoError(t, ctxReadMessages.Err())
-					c := p.getCount(commons.GetTopicFullName(validatorTopic(pk)))
+					c := p.getCount(validatorTopic(pk))
 					require.GreaterOrEqual(t, c, minMsgCount)
 					// require.LessOrEqual(t, c, maxMsgCount)
 				}
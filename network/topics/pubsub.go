@@ -60,6 +60,9 @@ type PububConfig struct {
 	MsgIDCacheTTL       time.Duration
 
 	GetValidatorStats network.GetValidatorStats
+	// Topics are the known topics, of all the forks in the schedule.
+	// if not set, the topics of the genesis fork are used
+	Topics []string
 }
 
 // ScoringConfig is the configuration for peer scoring
@@ -110,7 +113,11 @@ func NewPubsub(ctx context.Context, logger *zap.Logger, cfg *PububConfig) (*pubs
 
 	// Set up a SubFilter with a whitelist of known topics.
 	sf := newSubFilter(logger, subscriptionRequestLimit)
-	for _, topic := range commons.Topics() {
+	topics := cfg.Topics
+	if len(topics) == 0 {
+		topics = commons.Topics()
+	}
+	for _, topic := range topics {
 		sf.(Whitelist).Register(topic)
 	}
 
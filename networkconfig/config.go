@@ -36,6 +36,8 @@ type NetworkConfig struct {
 	RegistryContractAddr    string // TODO: ethcommon.Address
 	Bootnodes               []string
 	WhitelistedOperatorKeys []string
+	// Forks is the schedule of SSV network forks, see ForkSchedule
+	Forks []Fork
 }
 
 func (n NetworkConfig) String() string {
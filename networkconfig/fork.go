@@ -0,0 +1,158 @@
+package networkconfig
+
+import (
+	"fmt"
+	"sort"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	spectypes "github.com/bloxapp/ssv-spec/types"
+)
+
+const (
+	// GenesisForkName is the name of the fork that is active from genesis
+	GenesisForkName = "genesis"
+	// GenesisTopicPrefix is the topic prefix of the genesis fork
+	GenesisTopicPrefix = "ssv.v2"
+	// GenesisSubnetsCount is the subnets count of the genesis fork
+	GenesisSubnetsCount uint64 = 128
+	// GenesisSyncProtocolVersion is the version of the sync protocols of the genesis fork
+	GenesisSyncProtocolVersion = "0.0.1"
+)
+
+// MessageEncoding is the encoding of pubsub messages
+type MessageEncoding string
+
+const (
+	// EncodingSSZ is plain SSZ encoding, as used by the genesis fork
+	EncodingSSZ MessageEncoding = "ssz"
+	// EncodingSSZSnappy is versioned SSZ encoding, compressed with snappy
+	EncodingSSZSnappy MessageEncoding = "ssz_snappy"
+)
+
+// Compressed returns whether the encoding is compressed with snappy
+func (e MessageEncoding) Compressed() bool {
+	return e == EncodingSSZSnappy
+}
+
+// Fork is an SSV network fork, which switches protocol parameters at a given epoch
+type Fork struct {
+	// Name is the name of the fork
+	Name string
+	// Epoch is the activation epoch of the fork
+	Epoch spec.Epoch
+	// Domain is the domain type used for signatures and message IDs
+	Domain spectypes.DomainType
+	// TopicPrefix is the prefix of the pubsub topics
+	TopicPrefix string
+	// SubnetsCount is the amount of subnets (pubsub topics)
+	SubnetsCount uint64
+	// SyncProtocolVersion is the version of the sync (decided history) protocols
+	SyncProtocolVersion string
+	// Encoding is the encoding of pubsub messages, defaults to EncodingSSZ.
+	// messages of both encodings are accepted regardless of the active fork
+	Encoding MessageEncoding
+}
+
+func (f Fork) String() string {
+	return fmt.Sprintf("%s@%d", f.Name, f.Epoch)
+}
+
+// ForkSchedule returns the forks of the network, ordered by activation epoch.
+// The first fork is always active from epoch 0, if no forks are configured
+// then the genesis fork is built from the network's domain.
+func (n NetworkConfig) ForkSchedule() []Fork {
+	genesis := Fork{
+		Name:                GenesisForkName,
+		Domain:              n.Domain,
+		TopicPrefix:         GenesisTopicPrefix,
+		SubnetsCount:        GenesisSubnetsCount,
+		SyncProtocolVersion: GenesisSyncProtocolVersion,
+		Encoding:            EncodingSSZ,
+	}
+	if len(n.Forks) == 0 {
+		return []Fork{genesis}
+	}
+
+	forks := make([]Fork, len(n.Forks))
+	copy(forks, n.Forks)
+	sort.SliceStable(forks, func(i, j int) bool {
+		return forks[i].Epoch < forks[j].Epoch
+	})
+	for i := range forks {
+		if forks[i].Encoding == "" {
+			forks[i].Encoding = EncodingSSZ
+		}
+	}
+	if forks[0].Epoch != 0 {
+		forks = append([]Fork{genesis}, forks...)
+	}
+	return forks
+}
+
+// ForkAtEpoch returns the fork that is active at the given epoch
+func (n NetworkConfig) ForkAtEpoch(epoch spec.Epoch) Fork {
+	forks := n.ForkSchedule()
+	active := forks[0]
+	for _, f := range forks[1:] {
+		if f.Epoch > epoch {
+			break
+		}
+		active = f
+	}
+	return active
+}
+
+// ForkAtSlot returns the fork that is active at the given slot
+func (n NetworkConfig) ForkAtSlot(slot spec.Slot) Fork {
+	return n.ForkAtEpoch(n.Beacon.EstimatedEpochAtSlot(slot))
+}
+
+// NextFork returns the first fork that activates after the given epoch, if any
+func (n NetworkConfig) NextFork(epoch spec.Epoch) (Fork, bool) {
+	for _, f := range n.ForkSchedule() {
+		if f.Epoch > epoch {
+			return f, true
+		}
+	}
+	return Fork{}, false
+}
+
+// DomainAtSlot returns the domain type that is active at the given slot
+func (n NetworkConfig) DomainAtSlot(slot spec.Slot) spectypes.DomainType {
+	return n.ForkAtSlot(slot).Domain
+}
+
+// Domains returns the domain types of all forks in the schedule
+func (n NetworkConfig) Domains() []spectypes.DomainType {
+	forks := n.ForkSchedule()
+	domains := make([]spectypes.DomainType, 0, len(forks))
+	for _, f := range forks {
+		domains = append(domains, f.Domain)
+	}
+	return domains
+}
+
+// ValidateForks checks that the fork schedule is well-formed
+func (n NetworkConfig) ValidateForks() error {
+	seen := make(map[spec.Epoch]string)
+	for _, f := range n.ForkSchedule() {
+		if other, ok := seen[f.Epoch]; ok {
+			return fmt.Errorf("forks %s and %s activate at the same epoch %d", other, f.Name, f.Epoch)
+		}
+		seen[f.Epoch] = f.Name
+		if f.TopicPrefix == "" {
+			return fmt.Errorf("fork %s has no topic prefix", f.Name)
+		}
+		if f.SubnetsCount == 0 || f.SubnetsCount > GenesisSubnetsCount {
+			// subnets are advertised in ENR and node info as a bitfield of the genesis size
+			return fmt.Errorf("fork %s has %d subnets, expected 1-%d", f.Name, f.SubnetsCount, GenesisSubnetsCount)
+		}
+		if f.SyncProtocolVersion == "" {
+			return fmt.Errorf("fork %s has no sync protocol version", f.Name)
+		}
+		if f.Encoding != EncodingSSZ && f.Encoding != EncodingSSZSnappy {
+			return fmt.Errorf("fork %s has unknown encoding %q", f.Name, f.Encoding)
+		}
+	}
+	return nil
+}
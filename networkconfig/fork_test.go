@@ -0,0 +1,81 @@
+package networkconfig
+
+import (
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	spectypes "github.com/bloxapp/ssv-spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkConfig_ForkSchedule(t *testing.T) {
+	genesisDomain := spectypes.DomainType{0x0, 0x0, 0x5, 0x1}
+	nextDomain := spectypes.DomainType{0x0, 0x0, 0x5, 0x2}
+
+	t.Run("genesis only", func(t *testing.T) {
+		n := NetworkConfig{Beacon: TestNetwork.Beacon, Domain: genesisDomain}
+		forks := n.ForkSchedule()
+		require.Len(t, forks, 1)
+		require.Equal(t, GenesisForkName, forks[0].Name)
+		require.Equal(t, genesisDomain, forks[0].Domain)
+		require.Equal(t, GenesisTopicPrefix, forks[0].TopicPrefix)
+		require.Equal(t, GenesisSubnetsCount, forks[0].SubnetsCount)
+		require.Equal(t, EncodingSSZ, forks[0].Encoding)
+		require.NoError(t, n.ValidateForks())
+
+		_, ok := n.NextFork(0)
+		require.False(t, ok)
+		require.Equal(t, genesisDomain, n.DomainAtSlot(1000000))
+	})
+
+	t.Run("scheduled fork", func(t *testing.T) {
+		n := NetworkConfig{
+			Beacon: TestNetwork.Beacon,
+			Domain: genesisDomain,
+			Forks: []Fork{{
+				Name:                "v3",
+				Epoch:               100,
+				Domain:              nextDomain,
+				TopicPrefix:         "ssv.v3",
+				SubnetsCount:        64,
+				SyncProtocolVersion: "0.0.2",
+				Encoding:            EncodingSSZSnappy,
+			}},
+		}
+		require.NoError(t, n.ValidateForks())
+		require.Len(t, n.ForkSchedule(), 2)
+		require.True(t, n.ForkAtEpoch(100).Encoding.Compressed())
+
+		require.Equal(t, GenesisForkName, n.ForkAtEpoch(99).Name)
+		require.Equal(t, "v3", n.ForkAtEpoch(100).Name)
+		require.Equal(t, "v3", n.ForkAtEpoch(1000).Name)
+
+		next, ok := n.NextFork(99)
+		require.True(t, ok)
+		require.Equal(t, spec.Epoch(100), next.Epoch)
+		_, ok = n.NextFork(100)
+		require.False(t, ok)
+
+		slotsPerEpoch := spec.Slot(n.SlotsPerEpoch())
+		require.Equal(t, genesisDomain, n.DomainAtSlot(100*slotsPerEpoch-1))
+		require.Equal(t, nextDomain, n.DomainAtSlot(100*slotsPerEpoch))
+		require.Equal(t, []spectypes.DomainType{genesisDomain, nextDomain}, n.Domains())
+	})
+
+	t.Run("invalid schedule", func(t *testing.T) {
+		n := NetworkConfig{
+			Domain: genesisDomain,
+			Forks: []Fork{
+				{Name: "a", Epoch: 10, TopicPrefix: "ssv.a", SubnetsCount: 128, SyncProtocolVersion: "0.0.1"},
+				{Name: "b", Epoch: 10, TopicPrefix: "ssv.b", SubnetsCount: 128, SyncProtocolVersion: "0.0.1"},
+			},
+		}
+		require.Error(t, n.ValidateForks())
+
+		n.Forks = []Fork{{Name: "a", Epoch: 10, TopicPrefix: "ssv.a", SubnetsCount: 256, SyncProtocolVersion: "0.0.1"}}
+		require.Error(t, n.ValidateForks())
+
+		n.Forks = []Fork{{Name: "a", Epoch: 10, TopicPrefix: "ssv.a", SubnetsCount: 128, SyncProtocolVersion: "0.0.1", Encoding: "json"}}
+		require.Error(t, n.ValidateForks())
+	})
+}
@@ -2,12 +2,17 @@ package nodeprobe
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"go.uber.org/zap"
+
+	"github.com/bloxapp/ssv/monitoring/notifier"
 )
 
 const (
@@ -18,6 +23,40 @@ type Node interface {
 	Healthy(ctx context.Context) error
 }
 
+// NodeFunc adapts a function to a Node.
+type NodeFunc func(ctx context.Context) error
+
+func (f NodeFunc) Healthy(ctx context.Context) error {
+	return f(ctx)
+}
+
+// StatusReporter is implemented by components that can report a detailed status.
+// Nodes may implement it as well to add details to their health status.
+type StatusReporter interface {
+	Status(ctx context.Context) (any, error)
+}
+
+// ComponentStatus is the status of a single component of the node.
+type ComponentStatus struct {
+	Healthy bool   `json:"healthy"`
+	Error   string `json:"error,omitempty"`
+	// LastProbe is the time of the last health check, it's only set for probed nodes.
+	LastProbe *time.Time `json:"last_probe,omitempty"`
+	Details   any        `json:"details,omitempty"`
+}
+
+// probeResult is the result of the last health check of a node.
+type probeResult struct {
+	err  error
+	time time.Time
+}
+
+// alert is a check that is notified about when it fails, without affecting the health of the node.
+type alert struct {
+	eventType notifier.EventType
+	check     Node
+}
+
 type Prober struct {
 	logger           *zap.Logger
 	interval         time.Duration
@@ -26,6 +65,16 @@ type Prober struct {
 	healthy          atomic.Bool
 	cond             *sync.Cond
 	unhealthyHandler func()
+
+	// reporters are components that report their status without affecting the health of the node.
+	reporters   map[string]StatusReporter
+	results     map[string]probeResult
+	reportersMu sync.RWMutex
+
+	notifier     notifier.Notifier
+	alerts       map[string]alert
+	alertResults map[string]error
+	alertsMu     sync.Mutex
 }
 
 func NewProber(logger *zap.Logger, unhealthyHandler func(), nodes map[string]Node) *Prober {
@@ -35,6 +84,11 @@ func NewProber(logger *zap.Logger, unhealthyHandler func(), nodes map[string]Nod
 		interval:         probeInterval,
 		nodes:            nodes,
 		cond:             sync.NewCond(&sync.Mutex{}),
+		reporters:        make(map[string]StatusReporter),
+		results:          make(map[string]probeResult),
+		notifier:         notifier.Nop{},
+		alerts:           make(map[string]alert),
+		alertResults:     make(map[string]error),
 	}
 }
 
@@ -66,9 +120,9 @@ func (p *Prober) Run(ctx context.Context) error {
 	}
 }
 
-func (p *Prober) probe(ctx context.Context) {
+func (p *Prober) probe(parent context.Context) {
 	// Query all nodes in parallel.
-	ctx, cancel := context.WithTimeout(ctx, p.interval)
+	ctx, cancel := context.WithTimeout(parent, p.interval)
 	defer cancel()
 
 	var healthy atomic.Bool
@@ -86,6 +140,18 @@ func (p *Prober) probe(ctx context.Context) {
 				if e := recover(); e != nil {
 					err = fmt.Errorf("panic: %v", e)
 				}
+
+				p.reportersMu.Lock()
+				previous, probed := p.results[name]
+				p.results[name] = probeResult{err: err, time: time.Now()}
+				p.reportersMu.Unlock()
+
+				var previousErr error
+				if probed {
+					previousErr = previous.err
+				}
+				p.notifyTransition(name, notifier.ComponentUnhealthy, notifier.SeverityCritical, previousErr, err)
+
 				if err != nil {
 					// Update readiness and quit early.
 					healthy.Store(false)
@@ -102,6 +168,11 @@ func (p *Prober) probe(ctx context.Context) {
 	p.nodesMu.Unlock()
 	wg.Wait()
 
+	// Alerts are probed separately as ctx is cancelled once any node fails.
+	alertsCtx, cancelAlerts := context.WithTimeout(parent, p.interval)
+	p.probeAlerts(alertsCtx)
+	cancelAlerts()
+
 	// Update readiness.
 	p.cond.L.Lock()
 	defer p.cond.L.Unlock()
@@ -136,3 +207,190 @@ func (p *Prober) AddNode(name string, node Node) {
 
 	p.nodes[name] = node
 }
+
+// SetNotifier sets the notifier of failing nodes and alerts, it must be called before Start.
+func (p *Prober) SetNotifier(n notifier.Notifier) {
+	p.notifier = n
+}
+
+// AddAlert adds a check which is probed along with the nodes.
+// When the check starts failing, an event of the given type is notified, but the health of the node isn't affected.
+func (p *Prober) AddAlert(name string, eventType notifier.EventType, check Node) {
+	p.alertsMu.Lock()
+	defer p.alertsMu.Unlock()
+
+	p.alerts[name] = alert{eventType: eventType, check: check}
+}
+
+// probeAlerts runs the alert checks in parallel.
+func (p *Prober) probeAlerts(ctx context.Context) {
+	p.alertsMu.Lock()
+	defer p.alertsMu.Unlock()
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	for name, a := range p.alerts {
+		wg.Add(1)
+		go func(name string, a alert) {
+			defer wg.Done()
+
+			var err error
+			defer func() {
+				// Catch panics.
+				if e := recover(); e != nil {
+					err = fmt.Errorf("panic: %v", e)
+				}
+
+				mu.Lock()
+				previousErr := p.alertResults[name]
+				p.alertResults[name] = err
+				mu.Unlock()
+
+				p.notifyTransition(name, a.eventType, notifier.SeverityWarning, previousErr, err)
+			}()
+
+			err = a.check.Healthy(ctx)
+			if err != nil {
+				p.logger.Warn("alert check failed", zap.String("alert", name), zap.Error(err))
+			}
+		}(name, a)
+	}
+	wg.Wait()
+}
+
+// notifyTransition notifies when a node or an alert starts or stops failing.
+// Errors caused by cancelling the probe once another node failed aren't considered failures.
+func (p *Prober) notifyTransition(name string, eventType notifier.EventType, severity notifier.Severity, previousErr, err error) {
+	failing := func(err error) bool {
+		return err != nil && !errors.Is(err, context.Canceled)
+	}
+	switch {
+	case failing(err) && !failing(previousErr):
+		p.notifier.Notify(notifier.Event{
+			Type:     eventType,
+			Severity: severity,
+			Message:  fmt.Sprintf("%s: %s", name, err),
+			Fields:   map[string]any{"component": name, "error": err.Error()},
+		})
+	case err == nil && failing(previousErr):
+		p.notifier.Notify(notifier.Event{
+			Type:    notifier.ComponentRecovered,
+			Message: fmt.Sprintf("%s recovered", name),
+			Fields:  map[string]any{"component": name},
+		})
+	}
+}
+
+// AddStatusReporter adds a component whose status is reported by Status, but isn't probed for health.
+func (p *Prober) AddStatusReporter(name string, reporter StatusReporter) {
+	p.reportersMu.Lock()
+	defer p.reportersMu.Unlock()
+
+	p.reporters[name] = reporter
+}
+
+// Ready returns an error if any of the nodes wasn't healthy in the last probe.
+func (p *Prober) Ready(context.Context) error {
+	if p.healthy.Load() {
+		return nil
+	}
+
+	p.nodesMu.Lock()
+	names := make([]string, 0, len(p.nodes))
+	for name := range p.nodes {
+		names = append(names, name)
+	}
+	p.nodesMu.Unlock()
+
+	p.reportersMu.RLock()
+	defer p.reportersMu.RUnlock()
+
+	var unhealthy []string
+	for _, name := range names {
+		result, ok := p.results[name]
+		if !ok {
+			unhealthy = append(unhealthy, fmt.Sprintf("%s: not probed yet", name))
+		} else if result.err != nil {
+			unhealthy = append(unhealthy, fmt.Sprintf("%s: %s", name, result.err))
+		}
+	}
+	if len(unhealthy) == 0 {
+		return fmt.Errorf("not all nodes are healthy")
+	}
+	sort.Strings(unhealthy)
+	return fmt.Errorf("not all nodes are healthy: %s", strings.Join(unhealthy, ", "))
+}
+
+// Status returns the status of all nodes and status reporters by name.
+// The health of nodes is taken from their last probe, while their details
+// and the status of reporters are queried in parallel.
+func (p *Prober) Status(ctx context.Context) map[string]ComponentStatus {
+	ctx, cancel := context.WithTimeout(ctx, p.interval)
+	defer cancel()
+
+	p.nodesMu.Lock()
+	nodes := make(map[string]Node, len(p.nodes))
+	for name, node := range p.nodes {
+		nodes[name] = node
+	}
+	p.nodesMu.Unlock()
+
+	p.reportersMu.RLock()
+	statuses := make(map[string]ComponentStatus, len(nodes)+len(p.reporters))
+	reporters := make(map[string]StatusReporter, len(nodes)+len(p.reporters))
+	for name, node := range nodes {
+		status := ComponentStatus{Error: "not probed yet"}
+		if result, ok := p.results[name]; ok {
+			probeTime := result.time
+			status = ComponentStatus{Healthy: result.err == nil, LastProbe: &probeTime}
+			if result.err != nil {
+				status.Error = result.err.Error()
+			}
+		}
+		statuses[name] = status
+		if reporter, ok := node.(StatusReporter); ok {
+			reporters[name] = reporter
+		}
+	}
+	for name, reporter := range p.reporters {
+		reporters[name] = reporter
+	}
+	p.reportersMu.RUnlock()
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for name, reporter := range reporters {
+		wg.Add(1)
+		go func(name string, reporter StatusReporter) {
+			defer wg.Done()
+
+			var details any
+			var err error
+			defer func() {
+				// Catch panics.
+				if e := recover(); e != nil {
+					err = fmt.Errorf("panic: %v", e)
+				}
+
+				mu.Lock()
+				defer mu.Unlock()
+
+				status, probed := statuses[name]
+				if !probed {
+					// Reporters are healthy as long as they can report their status.
+					status.Healthy = err == nil
+				}
+				status.Details = details
+				if err != nil && status.Error == "" {
+					status.Error = err.Error()
+				}
+				statuses[name] = status
+			}()
+
+			details, err = reporter.Status(ctx)
+		}(name, reporter)
+	}
+	wg.Wait()
+
+	return statuses
+}
@@ -3,12 +3,15 @@ package nodeprobe
 import (
 	"context"
 	"fmt"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+
+	"github.com/bloxapp/ssv/monitoring/notifier"
 )
 
 func TestProber(t *testing.T) {
@@ -70,6 +73,121 @@ func TestProber_UnhealthyHandler(t *testing.T) {
 	require.False(t, healthy)
 }
 
+func TestProber_Status(t *testing.T) {
+	ctx := context.Background()
+
+	healthyNode := &statusNode{status: "synced"}
+	unhealthyNode := &node{}
+	notHealthy := fmt.Errorf("not healthy")
+	unhealthyNode.healthy.Store(&notHealthy)
+
+	prober := NewProber(zap.L(), nil, map[string]Node{
+		"healthy node":   healthyNode,
+		"unhealthy node": unhealthyNode,
+	})
+	prober.interval = 10 * time.Millisecond
+	prober.AddStatusReporter("reporter", &statusNode{status: "reporting"})
+	prober.AddStatusReporter("failing reporter", &statusNode{err: fmt.Errorf("failed")})
+
+	statuses := prober.Status(ctx)
+	require.False(t, statuses["healthy node"].Healthy)
+	require.Equal(t, "not probed yet", statuses["healthy node"].Error)
+	require.Error(t, prober.Ready(ctx))
+
+	prober.probe(ctx)
+	require.EqualError(t, prober.Ready(ctx), "not all nodes are healthy: unhealthy node: not healthy")
+
+	statuses = prober.Status(ctx)
+	require.Len(t, statuses, 4)
+
+	require.True(t, statuses["healthy node"].Healthy)
+	require.NotNil(t, statuses["healthy node"].LastProbe)
+	require.Equal(t, "synced", statuses["healthy node"].Details)
+
+	require.False(t, statuses["unhealthy node"].Healthy)
+	require.Equal(t, "not healthy", statuses["unhealthy node"].Error)
+	require.Nil(t, statuses["unhealthy node"].Details)
+
+	require.True(t, statuses["reporter"].Healthy)
+	require.Nil(t, statuses["reporter"].LastProbe)
+	require.Equal(t, "reporting", statuses["reporter"].Details)
+
+	require.False(t, statuses["failing reporter"].Healthy)
+	require.Equal(t, "failed", statuses["failing reporter"].Error)
+
+	unhealthyNode.healthy.Store(nil)
+	prober.probe(ctx)
+	require.NoError(t, prober.Ready(ctx))
+}
+
+func TestProber_Notifications(t *testing.T) {
+	ctx := context.Background()
+
+	unhealthyNode := &node{}
+	notHealthy := fmt.Errorf("not healthy")
+	unhealthyNode.healthy.Store(&notHealthy)
+
+	events := &recordingNotifier{}
+	prober := NewProber(zap.L(), nil, map[string]Node{"consensus client": unhealthyNode})
+	prober.SetNotifier(events)
+
+	var peers atomic.Int32
+	prober.AddAlert("p2p peers", notifier.LowPeerCount, NodeFunc(func(context.Context) error {
+		if peers.Load() < 5 {
+			return fmt.Errorf("%d peers", peers.Load())
+		}
+		return nil
+	}))
+
+	// Failures are notified once until they recover.
+	prober.probe(ctx)
+	prober.probe(ctx)
+	require.Len(t, events.events, 2)
+	types := []notifier.EventType{events.events[0].Type, events.events[1].Type}
+	require.ElementsMatch(t, []notifier.EventType{notifier.ComponentUnhealthy, notifier.LowPeerCount}, types)
+
+	// Alerts don't affect the health of the node.
+	unhealthyNode.healthy.Store(nil)
+	prober.probe(ctx)
+	require.NoError(t, prober.Ready(ctx))
+	require.Len(t, events.events, 3)
+	require.Equal(t, notifier.ComponentRecovered, events.events[2].Type)
+	require.Equal(t, "consensus client", events.events[2].Fields["component"])
+
+	peers.Store(10)
+	prober.probe(ctx)
+	require.Len(t, events.events, 4)
+	require.Equal(t, notifier.ComponentRecovered, events.events[3].Type)
+	require.Equal(t, "p2p peers", events.events[3].Fields["component"])
+}
+
+type recordingNotifier struct {
+	mu     sync.Mutex
+	events []notifier.Event
+}
+
+func (n *recordingNotifier) Notify(event notifier.Event) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.events = append(n.events, event)
+}
+
+type statusNode struct {
+	status string
+	err    error
+}
+
+func (sn *statusNode) Healthy(context.Context) error {
+	return nil
+}
+
+func (sn *statusNode) Status(context.Context) (any, error) {
+	if sn.err != nil {
+		return nil, sn.err
+	}
+	return sn.status, nil
+}
+
 type node struct {
 	healthy atomic.Pointer[error]
 }
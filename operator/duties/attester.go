@@ -193,10 +193,17 @@ func (h *AttesterHandler) fetchAndProcessDuties(ctx context.Context, epoch phase
 	}
 
 	specDuties := make([]*spectypes.Duty, 0, len(duties))
+	toPrepare := make([]*spectypes.Duty, 0, len(duties))
+	currentSlot := h.network.Beacon.EstimatedCurrentSlot()
 	for _, d := range duties {
 		h.duties.Add(epoch, d.Slot, d)
 		specDuties = append(specDuties, h.toSpecDuty(d, spectypes.BNRoleAttester))
+		// Aggregator selection proofs only depend on the slot, so they can be shared before it starts.
+		if d.Slot > currentSlot {
+			toPrepare = append(toPrepare, h.toSpecDuty(d, spectypes.BNRoleAggregator))
+		}
 	}
+	h.prepareDuties(h.logger, toPrepare)
 
 	h.logger.Debug("🗂 got duties",
 		fields.Count(len(duties)),
@@ -16,7 +16,7 @@ import (
 type ExecuteDutiesFunc func(logger *zap.Logger, duties []*spectypes.Duty)
 
 type dutyHandler interface {
-	Setup(string, *zap.Logger, BeaconNode, networkconfig.NetworkConfig, ValidatorController, ExecuteDutiesFunc, chan phase0.Slot, chan ReorgEvent, chan struct{})
+	Setup(string, *zap.Logger, BeaconNode, networkconfig.NetworkConfig, ValidatorController, ExecuteDutiesFunc, ExecuteDutiesFunc, chan phase0.Slot, chan ReorgEvent, chan struct{})
 	HandleDuties(context.Context)
 	Name() string
 }
@@ -27,6 +27,7 @@ type baseHandler struct {
 	network             networkconfig.NetworkConfig
 	validatorController ValidatorController
 	executeDuties       ExecuteDutiesFunc
+	prepareDuties       ExecuteDutiesFunc
 	ticker              chan phase0.Slot
 
 	reorg         chan ReorgEvent
@@ -43,6 +44,7 @@ func (h *baseHandler) Setup(
 	network networkconfig.NetworkConfig,
 	validatorController ValidatorController,
 	executeDuties ExecuteDutiesFunc,
+	prepareDuties ExecuteDutiesFunc,
 	ticker chan phase0.Slot,
 	reorgEvents chan ReorgEvent,
 	indicesChange chan struct{},
@@ -52,6 +54,7 @@ func (h *baseHandler) Setup(
 	h.network = network
 	h.validatorController = validatorController
 	h.executeDuties = executeDuties
+	h.prepareDuties = prepareDuties
 	h.ticker = ticker
 	h.reorg = reorgEvents
 	h.indicesChange = indicesChange
@@ -13,11 +13,13 @@ import (
 	spectypes "github.com/bloxapp/ssv-spec/types"
 	"github.com/prysmaticlabs/prysm/v4/async/event"
 	"github.com/sourcegraph/conc/pool"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"github.com/bloxapp/ssv/beacon/goclient"
 	"github.com/bloxapp/ssv/logging"
 	"github.com/bloxapp/ssv/logging/fields"
+	"github.com/bloxapp/ssv/monitoring/tracing"
 	"github.com/bloxapp/ssv/networkconfig"
 	"github.com/bloxapp/ssv/protocol/v2/types"
 )
@@ -49,7 +51,11 @@ type ValidatorController interface {
 	GetOperatorShares() []*types.SSVShare
 }
 
-type ExecuteDutyFunc func(logger *zap.Logger, duty *spectypes.Duty)
+// ExecuteDutyFunc executes the duty, the span of the duty in ctx is ended once the duty finishes.
+type ExecuteDutyFunc func(ctx context.Context, logger *zap.Logger, duty *spectypes.Duty)
+
+// PrepareDutyFunc prepares an upcoming duty ahead of its slot.
+type PrepareDutyFunc func(logger *zap.Logger, duty *spectypes.Duty)
 
 type SchedulerOptions struct {
 	Ctx                 context.Context
@@ -57,6 +63,7 @@ type SchedulerOptions struct {
 	Network             networkconfig.NetworkConfig
 	ValidatorController ValidatorController
 	ExecuteDuty         ExecuteDutyFunc
+	PrepareDuty         PrepareDutyFunc // optional, upcoming duties aren't prepared if nil
 	IndicesChg          chan struct{}
 	Ticker              SlotTicker
 	BuilderProposals    bool
@@ -68,6 +75,7 @@ type Scheduler struct {
 	validatorController ValidatorController
 	slotTicker          SlotTicker
 	executeDuty         ExecuteDutyFunc
+	prepareDuty         PrepareDutyFunc
 	builderProposals    bool
 
 	handlers            []dutyHandler
@@ -91,6 +99,7 @@ func NewScheduler(opts *SchedulerOptions) *Scheduler {
 		network:             opts.Network,
 		slotTicker:          opts.Ticker,
 		executeDuty:         opts.ExecuteDuty,
+		prepareDuty:         opts.PrepareDuty,
 		validatorController: opts.ValidatorController,
 		builderProposals:    opts.BuilderProposals,
 		indicesChg:          opts.IndicesChg,
@@ -136,7 +145,7 @@ func (s *Scheduler) Start(ctx context.Context, logger *zap.Logger) error {
 	for _, handler := range s.handlers {
 		handler := handler
 		slotTicker := make(chan phase0.Slot)
-		s.slotTicker.Subscribe(slotTicker)
+		slotSub := s.slotTicker.Subscribe(slotTicker)
 
 		indicesChangeCh := make(chan struct{})
 		indicesChangeFeed.Subscribe(indicesChangeCh)
@@ -150,20 +159,27 @@ func (s *Scheduler) Start(ctx context.Context, logger *zap.Logger) error {
 			s.network,
 			s.validatorController,
 			s.ExecuteDuties,
+			s.PrepareDuties,
 			slotTicker,
 			reorgCh,
 			indicesChangeCh,
 		)
 
 		s.pool.Go(func(ctx context.Context) error {
+			// Unsubscribe once the handler is stopped, so that it doesn't block the slot ticker.
+			defer slotSub.Unsubscribe()
+
 			// Wait for the head event subscription to complete before starting the handler.
 			handler.HandleDuties(ctx)
 			return nil
 		})
 	}
 
-	s.slotTicker.Subscribe(s.ticker)
-	go s.SlotTicker(ctx)
+	slotSub := s.slotTicker.Subscribe(s.ticker)
+	go func() {
+		defer slotSub.Unsubscribe()
+		s.SlotTicker(ctx)
+	}()
 
 	go indicesChangeFeed.FanOut(ctx, s.indicesChg)
 	go reorgFeed.FanOut(ctx, s.reorg)
@@ -323,14 +339,29 @@ func (s *Scheduler) ExecuteDuties(logger *zap.Logger, duties []*spectypes.Duty)
 		duty := duty
 		logger := s.loggerWithDutyContext(logger, duty)
 		go func() {
+			ctx, _ := tracing.Tracer().Start(context.Background(), "duty", trace.WithAttributes(tracing.DutyAttributes(duty)...))
 			if duty.Type == spectypes.BNRoleAttester || duty.Type == spectypes.BNRoleSyncCommittee {
+				_, wait := tracing.Tracer().Start(ctx, "wait_one_third")
 				s.waitOneThirdOrValidBlock(duty.Slot)
+				wait.End()
 			}
-			s.executeDuty(logger, duty)
+			s.executeDuty(ctx, logger, duty)
 		}()
 	}
 }
 
+// PrepareDuties prepares the given upcoming duties in the background, in order.
+func (s *Scheduler) PrepareDuties(logger *zap.Logger, duties []*spectypes.Duty) {
+	if s.prepareDuty == nil || len(duties) == 0 {
+		return
+	}
+	go func() {
+		for _, duty := range duties {
+			s.prepareDuty(s.loggerWithDutyContext(logger, duty), duty)
+		}
+	}()
+}
+
 // loggerWithDutyContext returns an instance of logger with the given duty's information
 func (s *Scheduler) loggerWithDutyContext(logger *zap.Logger, duty *spectypes.Duty) *zap.Logger {
 	return logger.
@@ -29,6 +29,10 @@ func (m *mockSlotTicker) Subscribe(subscriber chan phase0.Slot) event.Subscripti
 	return m.Feed.Subscribe(subscriber)
 }
 
+func nopSubscription() event.Subscription {
+	return event.NewSubscription(func(<-chan struct{}) error { return nil })
+}
+
 func setupSchedulerAndMocks(t *testing.T, handler dutyHandler, currentSlot *SlotValue) (
 	*Scheduler,
 	*zap.Logger,
@@ -108,7 +112,7 @@ func setupSchedulerAndMocks(t *testing.T, handler dutyHandler, currentSlot *Slot
 
 func setExecuteDutyFunc(s *Scheduler, executeDutiesCall chan []*spectypes.Duty, executeDutiesCallSize int) {
 	executeDutiesBuffer := make(chan *spectypes.Duty, executeDutiesCallSize)
-	s.executeDuty = func(logger *zap.Logger, duty *spectypes.Duty) {
+	s.executeDuty = func(ctx context.Context, logger *zap.Logger, duty *spectypes.Duty) {
 		logger.Debug("🏃 Executing duty", zap.Any("duty", duty))
 		executeDutiesBuffer <- duty
 
@@ -218,11 +222,11 @@ func TestScheduler_Run(t *testing.T) {
 	s.handlers = []dutyHandler{mockDutyHandler1, mockDutyHandler2}
 
 	mockBeaconNode.EXPECT().Events(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
-	mockTicker.EXPECT().Subscribe(gomock.Any()).Return(nil).Times(len(s.handlers) + 1)
+	mockTicker.EXPECT().Subscribe(gomock.Any()).Return(nopSubscription()).Times(len(s.handlers) + 1)
 
 	// setup mock duty handler expectations
 	for _, mockDutyHandler := range s.handlers {
-		mockDutyHandler.(*MockdutyHandler).EXPECT().Setup(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+		mockDutyHandler.(*MockdutyHandler).EXPECT().Setup(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
 		mockDutyHandler.(*MockdutyHandler).EXPECT().HandleDuties(gomock.Any()).
 			DoAndReturn(func(ctx context.Context) {
 				<-ctx.Done()
@@ -267,7 +271,7 @@ func TestScheduler_Regression_IndiciesChangeStuck(t *testing.T) {
 	// add multiple mock duty handlers
 	s.handlers = []dutyHandler{NewValidatorRegistrationHandler()}
 	mockBeaconNode.EXPECT().Events(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
-	mockTicker.EXPECT().Subscribe(gomock.Any()).Return(nil).Times(len(s.handlers) + 1)
+	mockTicker.EXPECT().Subscribe(gomock.Any()).Return(nopSubscription()).Times(len(s.handlers) + 1)
 	err := s.Start(ctx, logger)
 	require.NoError(t, err)
 
@@ -24,6 +24,8 @@ type SyncCommitteeHandler struct {
 	duties             *SyncCommitteeDuties
 	fetchCurrentPeriod bool
 	fetchNextPeriod    bool
+	// preparedUntil is the last slot whose contribution duties were prepared.
+	preparedUntil phase0.Slot
 }
 
 func NewSyncCommitteeHandler() *SyncCommitteeHandler {
@@ -108,9 +110,11 @@ func (h *SyncCommitteeHandler) HandleDuties(ctx context.Context) {
 				if h.indicesChanged {
 					h.duties.Reset(period)
 					h.indicesChanged = false
+					h.preparedUntil = 0
 				}
 				h.processFetching(ctx, period, slot)
 			}
+			h.processPreparation(slot)
 
 			// If we have reached the mid-point of the epoch, fetch the duties for the next period in the next slot.
 			// This allows us to set them up at a time when the beacon node should be less busy.
@@ -193,6 +197,31 @@ func (h *SyncCommitteeHandler) processExecution(period uint64, slot phase0.Slot)
 	}
 }
 
+// processPreparation prepares the contribution duties of the slots up to an epoch ahead,
+// as their selection proofs only depend on the slot and can be shared before it starts.
+func (h *SyncCommitteeHandler) processPreparation(slot phase0.Slot) {
+	from := slot + 1
+	if h.preparedUntil >= from {
+		from = h.preparedUntil + 1
+	}
+	until := slot + phase0.Slot(h.network.Beacon.SlotsPerEpoch())
+
+	var toPrepare []*spectypes.Duty
+	for s := from; s <= until; s++ {
+		epoch := h.network.Beacon.EstimatedEpochAtSlot(s)
+		duties, ok := h.duties.m[h.network.Beacon.EstimatedSyncCommitteePeriodAtEpoch(epoch)]
+		if !ok {
+			// The duties of the period weren't fetched yet.
+			break
+		}
+		for _, d := range duties {
+			toPrepare = append(toPrepare, h.toSpecDuty(d, s, spectypes.BNRoleSyncCommitteeContribution))
+		}
+		h.preparedUntil = s
+	}
+	h.prepareDuties(h.logger, toPrepare)
+}
+
 func (h *SyncCommitteeHandler) fetchAndProcessDuties(ctx context.Context, period uint64) error {
 	start := time.Now()
 	firstEpoch := h.network.Beacon.FirstEpochOfSyncPeriod(period)
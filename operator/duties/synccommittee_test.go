@@ -11,8 +11,11 @@ import (
 	"github.com/cornelk/hashmap"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 
+	"github.com/bloxapp/ssv/networkconfig"
 	"github.com/bloxapp/ssv/operator/duties/mocks"
+	"github.com/bloxapp/ssv/protocol/v2/blockchain/beacon"
 	mocknetwork "github.com/bloxapp/ssv/protocol/v2/blockchain/beacon/mocks"
 )
 
@@ -535,3 +538,30 @@ func TestScheduler_SyncCommittee_Early_Block(t *testing.T) {
 	cancel()
 	require.NoError(t, schedulerPool.Wait())
 }
+
+func TestScheduler_SyncCommittee_PrepareContributions(t *testing.T) {
+	var prepared []*spectypes.Duty
+	handler := NewSyncCommitteeHandler()
+	handler.network = networkconfig.NetworkConfig{Beacon: beacon.NewNetwork(spectypes.PraterNetwork)}
+	handler.prepareDuties = func(logger *zap.Logger, duties []*spectypes.Duty) {
+		prepared = append(prepared, duties...)
+	}
+
+	// nothing is prepared before the duties of the period are fetched
+	handler.processPreparation(100)
+	require.Empty(t, prepared)
+
+	handler.duties.Add(0, &v1.SyncCommitteeDuty{ValidatorIndex: 1})
+	handler.processPreparation(100)
+	require.Len(t, prepared, 32)
+	for i, duty := range prepared {
+		require.Equal(t, spectypes.BNRoleSyncCommitteeContribution, duty.Type)
+		require.Equal(t, phase0.Slot(101+i), duty.Slot)
+	}
+
+	// only the slots which entered the window are prepared
+	prepared = nil
+	handler.processPreparation(101)
+	require.Len(t, prepared, 1)
+	require.Equal(t, phase0.Slot(133), prepared[0].Slot)
+}
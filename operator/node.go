@@ -3,7 +3,9 @@ package operator
 import (
 	"context"
 	"fmt"
+	"sync"
 
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	spectypes "github.com/bloxapp/ssv-spec/types"
 	"go.uber.org/zap"
 
@@ -26,6 +28,10 @@ import (
 // Node represents the behavior of SSV node
 type Node interface {
 	Start(logger *zap.Logger) error
+	Shutdown(ctx context.Context, logger *zap.Logger)
+	// Wait waits for the background tasks of the node which write to the database,
+	// which stop once the context of the node is done.
+	Wait()
 }
 
 // Options contains options to create the node
@@ -40,6 +46,7 @@ type Options struct {
 	DB                  basedb.Database
 	ValidatorController validator.Controller
 	ValidatorOptions    validator.ControllerOptions `yaml:"ValidatorOptions"`
+	DecidedRetention    qbftstorage.RetentionConfig `yaml:"DecidedRetention"`
 
 	WS        api.WebSocketServer
 	WsAPIPort int
@@ -59,6 +66,13 @@ type operatorNode struct {
 	storage          storage.Storage
 	qbftStorage      *qbftstorage.QBFTStores
 	dutyScheduler    *duties.Scheduler
+	dutiesCtx        context.Context
+	stopDuties       context.CancelFunc
+	dutiesStopped    chan struct{}
+	dutiesMu         sync.Mutex // protects dutiesStarted against a concurrent shutdown
+	dutiesStarted    bool
+	background       sync.WaitGroup
+	pruner           *qbftstorage.Pruner
 	feeRecipientCtrl fee_recipient.RecipientController
 
 	ws        api.WebSocketServer
@@ -83,6 +97,9 @@ func New(logger *zap.Logger, opts Options, slotTicker slot_ticker.Ticker) Node {
 		storageMap.Add(role, qbftstorage.New(opts.DB, role.String()))
 	}
 
+	// The duty scheduler is stopped ahead of the rest of the node on shutdown.
+	dutiesCtx, stopDuties := context.WithCancel(opts.Context)
+
 	node := &operatorNode{
 		context:         opts.Context,
 		ticker:          slotTicker,
@@ -93,6 +110,10 @@ func New(logger *zap.Logger, opts Options, slotTicker slot_ticker.Ticker) Node {
 		net:             opts.P2PNetwork,
 		storage:         opts.ValidatorOptions.RegistryStorage,
 		qbftStorage:     storageMap,
+		pruner:          qbftstorage.NewPruner(opts.DecidedRetention, opts.Network.Beacon, storageMap),
+		dutiesCtx:       dutiesCtx,
+		stopDuties:      stopDuties,
+		dutiesStopped:   make(chan struct{}),
 		dutyScheduler: duties.NewScheduler(&duties.SchedulerOptions{
 			Ctx:                 opts.Context,
 			BeaconNode:          opts.BeaconNode,
@@ -100,6 +121,7 @@ func New(logger *zap.Logger, opts Options, slotTicker slot_ticker.Ticker) Node {
 			ValidatorController: opts.ValidatorController,
 			IndicesChg:          opts.ValidatorController.IndicesChangeChan(),
 			ExecuteDuty:         opts.ValidatorController.ExecuteDuty,
+			PrepareDuty:         opts.ValidatorController.PrepareDuty,
 			Ticker:              slotTicker,
 			BuilderProposals:    opts.ValidatorOptions.BuilderProposals,
 		}),
@@ -143,15 +165,28 @@ func (n *operatorNode) Start(logger *zap.Logger) error {
 	go n.ticker.Start(logger)
 	n.validatorsCtrl.StartNetworkHandlers()
 	n.validatorsCtrl.StartValidators()
-	go n.net.UpdateSubnets(logger)
 	go n.reportOperators(logger)
+	go n.purgeSelectionProofs()
 
 	go n.feeRecipientCtrl.Start(logger)
-	go n.validatorsCtrl.UpdateValidatorMetaDataLoop()
+	if n.pruner.Enabled() {
+		n.goBackground(func() { n.pruner.Start(n.context, logger) })
+	}
+	n.goBackground(n.validatorsCtrl.UpdateValidatorMetaDataLoop)
+
+	n.dutiesMu.Lock()
+	if n.dutiesCtx.Err() != nil {
+		// The node was shut down before the duty scheduler started.
+		n.dutiesMu.Unlock()
+		return nil
+	}
+	n.dutiesStarted = true
+	n.dutiesMu.Unlock()
+	defer close(n.dutiesStopped)
 
 	// Start the duty scheduler, and a background goroutine to crash the node
 	// in case there were any errors.
-	if err := n.dutyScheduler.Start(n.context, logger); err != nil {
+	if err := n.dutyScheduler.Start(n.dutiesCtx, logger); err != nil {
 		return fmt.Errorf("failed to run duty scheduler: %w", err)
 	}
 
@@ -162,6 +197,41 @@ func (n *operatorNode) Start(logger *zap.Logger) error {
 	return nil
 }
 
+// goBackground runs a background task which writes to the database, and is waited for by Wait.
+func (n *operatorNode) goBackground(task func()) {
+	n.background.Add(1)
+	go func() {
+		defer n.background.Done()
+		task()
+	}()
+}
+
+func (n *operatorNode) Wait() {
+	n.background.Wait()
+}
+
+// Shutdown stops the duty scheduler, so that no new duties are started,
+// and then lets the running duties finish until the context is done before stopping the validators.
+func (n *operatorNode) Shutdown(ctx context.Context, logger *zap.Logger) {
+	logger = logger.Named(logging.NameOperator)
+
+	n.dutiesMu.Lock()
+	n.stopDuties()
+	started := n.dutiesStarted
+	n.dutiesMu.Unlock()
+
+	if started {
+		select {
+		case <-n.dutiesStopped:
+			logger.Info("stopped duty scheduler")
+		case <-ctx.Done():
+			logger.Warn("duty scheduler didn't stop in time")
+		}
+	}
+
+	n.validatorsCtrl.Shutdown(ctx)
+}
+
 // HealthCheck returns a list of issues regards the state of the operator node
 func (n *operatorNode) HealthCheck() error {
 	// TODO: previously this checked availability of consensus & execution clients.
@@ -179,7 +249,7 @@ func (n *operatorNode) handleQueryRequests(logger *zap.Logger, nm *api.NetworkMe
 		zap.String("type", string(nm.Msg.Type)))
 	switch nm.Msg.Type {
 	case api.TypeDecided:
-		api.HandleDecidedQuery(logger, n.qbftStorage, nm)
+		api.HandleDecidedQuery(logger, n.qbftStorage, n.network.Domains(), nm)
 	case api.TypeError:
 		api.HandleErrorQuery(logger, nm)
 	default:
@@ -215,3 +285,19 @@ func (n *operatorNode) reportOperators(logger *zap.Logger) {
 			fields.PubKey(operators[i].PublicKey))
 	}
 }
+
+// purgeSelectionProofs drops the selection proofs of the slots which passed, on every slot tick.
+func (n *operatorNode) purgeSelectionProofs() {
+	slots := make(chan phase0.Slot, 32)
+	sub := n.ticker.Subscribe(slots)
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case <-n.context.Done():
+			return
+		case slot := <-slots:
+			n.validatorsCtrl.PurgeSelectionProofs(slot)
+		}
+	}
+}
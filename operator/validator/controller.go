@@ -17,11 +17,15 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/jellydator/ttlcache/v3"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"github.com/bloxapp/ssv/ibft/storage"
 	"github.com/bloxapp/ssv/logging"
 	"github.com/bloxapp/ssv/logging/fields"
+	"github.com/bloxapp/ssv/monitoring/effectiveness"
+	"github.com/bloxapp/ssv/monitoring/notifier"
+	"github.com/bloxapp/ssv/monitoring/tracing"
 	"github.com/bloxapp/ssv/network"
 	nodestorage "github.com/bloxapp/ssv/operator/storage"
 	beaconprotocol "github.com/bloxapp/ssv/protocol/v2/blockchain/beacon"
@@ -45,6 +49,8 @@ import (
 
 const (
 	networkRouterConcurrency = 2048
+	// drainPollInterval is how often Shutdown checks whether the running duties are finished.
+	drainPollInterval = 100 * time.Millisecond
 )
 
 // ShareEncryptionKeyProvider is a function that returns the operator private key
@@ -60,10 +66,12 @@ type ControllerOptions struct {
 	Context                    context.Context
 	DB                         basedb.Database
 	SignatureCollectionTimeout time.Duration `yaml:"SignatureCollectionTimeout" env:"SIGNATURE_COLLECTION_TIMEOUT" env-default:"5s" env-description:"Timeout for signature collection after consensus"`
-	MetadataUpdateInterval     time.Duration `yaml:"MetadataUpdateInterval" env:"METADATA_UPDATE_INTERVAL" env-default:"12m" env-description:"Interval for updating metadata"`
+	MetadataUpdateInterval     time.Duration `yaml:"MetadataUpdateInterval" env:"METADATA_UPDATE_INTERVAL" env-default:"12m" env-description:"Interval for sweeping the metadata of validators which weren't updated by beacon events"`
 	HistorySyncBatchSize       int           `yaml:"HistorySyncBatchSize" env:"HISTORY_SYNC_BATCH_SIZE" env-default:"25" env-description:"Maximum number of messages to sync in a single batch"`
 	MinPeers                   int           `yaml:"MinimumPeers" env:"MINIMUM_PEERS" env-default:"2" env-description:"The required minimum peers for sync"`
 	BeaconNetwork              beaconprotocol.Network
+	DomainAtSlot               ssvtypes.DomainAtSlotF
+	SignatureVerifier          *ssvtypes.SignatureVerifier
 	Network                    network.P2PNetwork
 	Beacon                     beaconprotocol.BeaconNode
 	ShareEncryptionKeyProvider ShareEncryptionKeyProvider
@@ -77,6 +85,9 @@ type ControllerOptions struct {
 	DutyRoles                  []spectypes.BeaconRole
 	StorageMap                 *storage.QBFTStores
 	Metrics                    validatorMetrics
+	Notifier                   notifier.Notifier
+	DutyTracker                validator.DutyTracker
+	EffectivenessTracker       *effectiveness.Tracker
 
 	// worker flags
 	WorkersCount    int `yaml:"MsgWorkersCount" env:"MSG_WORKERS_COUNT" env-default:"256" env-description:"Number of goroutines to use for message workers"`
@@ -88,9 +99,13 @@ type ControllerOptions struct {
 // it takes care of bootstrapping, updating and managing existing validators and their shares
 type Controller interface {
 	StartValidators()
+	Shutdown(ctx context.Context)
 	ActiveValidatorIndices(epoch phase0.Epoch) []phase0.ValidatorIndex
 	GetValidator(pubKey string) (*validator.Validator, bool)
-	ExecuteDuty(logger *zap.Logger, duty *spectypes.Duty)
+	ExecuteDuty(ctx context.Context, logger *zap.Logger, duty *spectypes.Duty)
+	PrepareDuty(logger *zap.Logger, duty *spectypes.Duty)
+	// PurgeSelectionProofs drops the selection proofs which were buffered or reconstructed for the slots before the given slot
+	PurgeSelectionProofs(slot phase0.Slot)
 	UpdateValidatorMetaDataLoop()
 	StartNetworkHandlers()
 	GetOperatorShares() []*ssvtypes.SSVShare
@@ -99,10 +114,19 @@ type Controller interface {
 	//  - the amount of active validators (i.e. not slashed or existed)
 	//  - the amount of validators assigned to this operator
 	GetValidatorStats() (uint64, uint64, uint64, error)
+	// Status returns the ValidatorsStatus of this operator's validators
+	Status(ctx context.Context) (any, error)
 	GetOperatorData() *registrystorage.OperatorData
 	SetOperatorData(data *registrystorage.OperatorData)
 	IndicesChangeChan() chan struct{}
 
+	// RefreshMetadata triggers an update of the metadata of all validators, regardless of when they were last updated
+	RefreshMetadata()
+	// RestartValidator stops the validator and starts it with new runners
+	RestartValidator(pubKey []byte) error
+	// ResyncValidator syncs the highest decided of all roles of the validator from its peers
+	ResyncValidator(pubKey []byte) error
+
 	StartValidator(share *ssvtypes.SSVShare) error
 	StopValidator(publicKey []byte) error
 	LiquidateCluster(owner common.Address, operatorIDs []uint64, toLiquidate []*ssvtypes.SSVShare) error
@@ -119,8 +143,10 @@ type nonCommitteeValidator struct {
 type controller struct {
 	context context.Context
 
-	logger  *zap.Logger
-	metrics validatorMetrics
+	logger        *zap.Logger
+	metrics       validatorMetrics
+	notifier      notifier.Notifier
+	effectiveness *effectiveness.Tracker
 
 	sharesStorage     registrystorage.Shares
 	operatorsStorage  registrystorage.Operators
@@ -136,6 +162,8 @@ type controller struct {
 
 	validatorsMap    *validatorsMap
 	validatorOptions *validator.Options
+	// restartLocks maps the hex public keys of validators to the mutexes which serialize their restarts
+	restartLocks sync.Map
 
 	metadataUpdateInterval time.Duration
 
@@ -150,8 +178,13 @@ type controller struct {
 	nonCommitteeMutex      sync.Mutex
 
 	recentlyStartedValidators uint64
-	metadataLastUpdated       map[string]time.Time
+	recentlyChangedValidators uint64
+	lastEventEpoch            uint64
 	indicesChange             chan struct{}
+	metadataQueue             *metadataQueue
+
+	// erroredValidators maps the hex public keys of validators that failed to start to their errors
+	erroredValidators sync.Map
 }
 
 // NewController creates a new validator controller instance
@@ -168,10 +201,12 @@ func NewController(logger *zap.Logger, options ControllerOptions) Controller {
 	}
 
 	validatorOptions := &validator.Options{ //TODO add vars
-		Network:       options.Network,
-		Beacon:        options.Beacon,
-		BeaconNetwork: options.BeaconNetwork.BeaconNetwork,
-		Storage:       options.StorageMap,
+		Network:           options.Network,
+		Beacon:            options.Beacon,
+		BeaconNetwork:     options.BeaconNetwork.BeaconNetwork,
+		DomainAtSlot:      options.DomainAtSlot,
+		SignatureVerifier: options.SignatureVerifier,
+		Storage:           options.StorageMap,
 		//Share:   nil,  // set per validator
 		Signer: options.KeyManager,
 		//Mode: validator.ModeRW // set per validator
@@ -181,6 +216,8 @@ func NewController(logger *zap.Logger, options ControllerOptions) Controller {
 		Exporter:          options.Exporter,
 		BuilderProposals:  options.BuilderProposals,
 		GasLimit:          options.GasLimit,
+		DutyTracker:       options.DutyTracker,
+		SelectionProofs:   runner.NewSelectionProofs(),
 	}
 
 	// If full node, increase queue size to make enough room
@@ -195,10 +232,15 @@ func NewController(logger *zap.Logger, options ControllerOptions) Controller {
 	if options.Metrics == nil {
 		options.Metrics = nopMetrics{}
 	}
+	if options.Notifier == nil {
+		options.Notifier = notifier.Nop{}
+	}
 
 	ctrl := controller{
 		logger:                     logger.Named(logging.NameController),
 		metrics:                    options.Metrics,
+		notifier:                   options.Notifier,
+		effectiveness:              options.EffectivenessTracker,
 		sharesStorage:              options.RegistryStorage.Shares(),
 		operatorsStorage:           options.RegistryStorage,
 		recipientsStorage:          options.RegistryStorage,
@@ -224,8 +266,8 @@ func NewController(logger *zap.Logger, options ControllerOptions) Controller {
 		nonCommitteeValidators: ttlcache.New(
 			ttlcache.WithTTL[spectypes.MessageID, *nonCommitteeValidator](time.Minute * 13),
 		),
-		metadataLastUpdated: make(map[string]time.Time),
-		indicesChange:       make(chan struct{}),
+		indicesChange: make(chan struct{}),
+		metadataQueue: newMetadataQueue(),
 	}
 
 	// Start automatic expired item deletion in nonCommitteeValidators.
@@ -234,12 +276,20 @@ func NewController(logger *zap.Logger, options ControllerOptions) Controller {
 	return &ctrl
 }
 
+// validateSyncIdentifier checks that the identifier of a sync request is of a known validator
+func (c *controller) validateSyncIdentifier(mid spectypes.MessageID) error {
+	if share := c.sharesStorage.Get(nil, mid.GetPubKey()); share == nil {
+		return errors.New("validator not found")
+	}
+	return nil
+}
+
 // setupNetworkHandlers registers all the required handlers for sync protocols
 func (c *controller) setupNetworkHandlers() error {
 	syncHandlers := []*p2pprotocol.SyncHandler{
 		p2pprotocol.WithHandler(
 			p2pprotocol.LastDecidedProtocol,
-			handlers.LastDecidedHandler(c.logger, c.ibftStorageMap, c.network),
+			handlers.LastDecidedHandler(c.logger, c.ibftStorageMap, c.network, c.validateSyncIdentifier),
 		),
 	}
 	if c.validatorOptions.FullNode {
@@ -248,7 +298,7 @@ func (c *controller) setupNetworkHandlers() error {
 			p2pprotocol.WithHandler(
 				p2pprotocol.DecidedHistoryProtocol,
 				// TODO: extract maxBatch to config
-				handlers.HistoryHandler(c.logger, c.ibftStorageMap, c.network, c.historySyncBatchSize),
+				handlers.HistoryHandler(c.logger, c.ibftStorageMap, c.network, c.historySyncBatchSize, c.validateSyncIdentifier),
 			),
 		)
 	}
@@ -296,6 +346,36 @@ func (c *controller) GetValidatorStats() (uint64, uint64, uint64, error) {
 	return uint64(len(allShares)), active, operatorShares, nil
 }
 
+// ValidatorsStatus is the status of the validators as reported by the status endpoint.
+type ValidatorsStatus struct {
+	// Running is the number of validators that are started.
+	Running int `json:"running"`
+	// Inactive is the number of validators that aren't started, e.g. because they aren't active on the beacon chain yet.
+	Inactive int `json:"inactive"`
+	// Errored is the number of validators that failed to start.
+	Errored int `json:"errored"`
+}
+
+func (c *controller) Status(context.Context) (any, error) {
+	status := ValidatorsStatus{}
+	_ = c.validatorsMap.ForEach(func(v *validator.Validator) error {
+		if v.State() == validator.Started {
+			status.Running++
+		}
+		return nil
+	})
+	c.erroredValidators.Range(func(_, _ any) bool {
+		status.Errored++
+		return true
+	})
+
+	shares := c.sharesStorage.List(nil, registrystorage.ByOperatorID(c.GetOperatorData().ID), registrystorage.ByNotLiquidated())
+	if inactive := len(shares) - status.Running - status.Errored; inactive > 0 {
+		status.Inactive = inactive
+	}
+	return status, nil
+}
+
 func (c *controller) handleRouterMessages() {
 	ctx, cancel := context.WithCancel(c.context)
 	defer cancel()
@@ -469,8 +549,9 @@ func (c *controller) setupNonCommitteeValidators() {
 			spectypes.BNRoleSyncCommittee,
 			spectypes.BNRoleSyncCommitteeContribution,
 		}
+		domain := opts.Domain(c.beacon.GetBeaconNetwork().EstimatedCurrentSlot())
 		for _, role := range allRoles {
-			messageID := spectypes.NewMsgID(ssvtypes.GetDefaultDomain(), validatorShare.ValidatorPubKey, role)
+			messageID := spectypes.NewMsgID(domain, validatorShare.ValidatorPubKey, role)
 			err := c.network.SyncHighestDecided(messageID)
 			if err != nil {
 				c.logger.Error("failed to sync highest decided", zap.Error(err))
@@ -501,24 +582,29 @@ func (c *controller) StartNetworkHandlers() {
 
 // UpdateValidatorMetadata updates a given validator with metadata (implements ValidatorMetadataStorage)
 func (c *controller) UpdateValidatorMetadata(pk string, metadata *beaconprotocol.ValidatorMetadata) error {
-	c.metadataLastUpdated[pk] = time.Now()
-
 	if metadata == nil {
 		return errors.New("could not update empty metadata")
 	}
 
-	// Save metadata to share storage.
-	err := c.sharesStorage.UpdateValidatorMetadata(pk, metadata)
+	pkBytes, err := hex.DecodeString(pk)
 	if err != nil {
-		return errors.Wrap(err, "could not update validator metadata")
+		return errors.Wrap(err, "could not decode public key")
 	}
 
-	// If this validator is not ours, don't start it.
-	pkBytes, err := hex.DecodeString(pk)
+	// Keep the previous metadata to notify about changes of the status.
+	var previous *beaconprotocol.ValidatorMetadata
+	if share := c.sharesStorage.Get(nil, pkBytes); share != nil && share.HasBeaconMetadata() {
+		metadataCopy := *share.BeaconMetadata
+		previous = &metadataCopy
+	}
+
+	// Save metadata to share storage.
+	err = c.sharesStorage.UpdateValidatorMetadata(pk, metadata)
 	if err != nil {
-		return errors.Wrap(err, "could not decode public key")
+		return errors.Wrap(err, "could not update validator metadata")
 	}
 
+	// If this validator is not ours, don't start it.
 	share := c.sharesStorage.Get(nil, pkBytes)
 	if share == nil {
 		return errors.New("share was not found")
@@ -527,6 +613,16 @@ func (c *controller) UpdateValidatorMetadata(pk string, metadata *beaconprotocol
 		return nil
 	}
 
+	c.notifyStatusChange(pk, previous, metadata)
+	if statusChanged(previous, metadata) {
+		c.recentlyChangedValidators++
+	}
+
+	// Stop validator if it exited or was slashed.
+	if inactive, err := c.stopInactiveValidator(pk, metadata); inactive {
+		return err
+	}
+
 	// Start validator (if not already started).
 	if v, found := c.validatorsMap.GetValidator(pk); found {
 		v.Share.BeaconMetadata = metadata
@@ -548,37 +644,148 @@ func (c *controller) UpdateValidatorMetadata(pk string, metadata *beaconprotocol
 	return nil
 }
 
+// notifyStatusChange notifies when a validator of this operator becomes slashed or exits.
+func (c *controller) notifyStatusChange(pk string, previous, current *beaconprotocol.ValidatorMetadata) {
+	eventFields := map[string]any{
+		"validator": pk,
+		"index":     uint64(current.Index),
+		"status":    current.Status.String(),
+	}
+	switch {
+	case current.Slashed() && (previous == nil || !previous.Slashed()):
+		c.notifier.Notify(notifier.Event{
+			Type:     notifier.ValidatorSlashed,
+			Severity: notifier.SeverityCritical,
+			Message:  "validator " + pk + " was slashed",
+			Fields:   eventFields,
+		})
+	case current.Exiting() && (previous == nil || !previous.Exiting()):
+		c.notifier.Notify(notifier.Event{
+			Type:     notifier.ValidatorExited,
+			Severity: notifier.SeverityWarning,
+			Message:  "validator " + pk + " is exiting",
+			Fields:   eventFields,
+		})
+	}
+}
+
 // GetValidator returns a validator instance from validatorsMap
 func (c *controller) GetValidator(pubKey string) (*validator.Validator, bool) {
 	return c.validatorsMap.GetValidator(pubKey)
 }
 
-func (c *controller) ExecuteDuty(logger *zap.Logger, duty *spectypes.Duty) {
+// ExecuteDuty pushes the duty to the queue of its validator.
+// The span of the duty in ctx is ended once the duty finishes, or here if it can't be queued.
+func (c *controller) ExecuteDuty(ctx context.Context, logger *zap.Logger, duty *spectypes.Duty) {
+	span := trace.SpanFromContext(ctx)
+
 	// because we're using the same duty for more than 1 duty (e.g. attest + aggregator) there is an error in bls.Deserialize func for cgo pointer to pointer.
 	// so we need to copy the pubkey val to avoid pointer
 	var pk phase0.BLSPubKey
 	copy(pk[:], duty.PubKey[:])
 
 	if v, ok := c.GetValidator(hex.EncodeToString(pk[:])); ok {
-		ssvMsg, err := CreateDutyExecuteMsg(duty, pk, types.GetDefaultDomain())
+		v, err := c.forkValidator(logger, v, duty.Slot)
+		if err != nil {
+			logger.Error("could not restart validator for the active fork", zap.Error(err))
+			tracing.End(span, err)
+			return
+		}
+		ssvMsg, err := CreateDutyExecuteMsg(duty, pk, v.Share.DomainType)
 		if err != nil {
 			logger.Error("could not create duty execute msg", zap.Error(err))
+			tracing.End(span, err)
 			return
 		}
 		dec, err := queue.DecodeSSVMessage(logger, ssvMsg)
 		if err != nil {
 			logger.Error("could not decode duty execute msg", zap.Error(err))
+			tracing.End(span, err)
 			return
 		}
+		dec.TraceContext = tracing.StartQueued(ctx, "queue")
 		if pushed := v.Queues[duty.Type].Q.TryPush(dec); !pushed {
 			logger.Warn("dropping ExecuteDuty message because the queue is full")
+			tracing.EndQueued(dec.TraceContext)
+			tracing.End(span, errors.New("queue is full"))
+		} else if c.effectiveness != nil {
+			c.effectiveness.Track(duty)
 		}
 		// logger.Debug("📬 queue: pushed message", fields.MessageID(dec.MsgID), fields.MessageType(dec.MsgType))
 	} else {
 		logger.Warn("could not find validator")
+		tracing.End(span, errors.New("could not find validator"))
+	}
+}
+
+// Shutdown waits until the running duties of the current and previous slots are finished
+// or the context is done, and then stops all validators.
+// Running duties of older slots are assumed to have failed already,
+// and duties which timed out or ended without being submitted aren't waited for.
+func (c *controller) Shutdown(ctx context.Context) {
+	start := time.Now()
+	since := c.beacon.GetBeaconNetwork().EstimatedCurrentSlot()
+	if since > 0 {
+		since--
+	}
+
+	running := c.runningDuties(since)
+	remaining := running
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+drain:
+	for remaining > 0 {
+		select {
+		case <-ctx.Done():
+			break drain
+		case <-ticker.C:
+			remaining = c.runningDuties(since)
+		}
+	}
+
+	stopped := 0
+	_ = c.validatorsMap.ForEach(func(v *validator.Validator) error {
+		v.Stop()
+		stopped++
+		return nil
+	})
+	c.logger.Info("stopped validators",
+		zap.Int("validators", stopped),
+		zap.Int("running_duties", running),
+		zap.Int("abandoned_duties", remaining),
+		fields.Took(time.Since(start)))
+}
+
+func (c *controller) runningDuties(since phase0.Slot) int {
+	count := 0
+	_ = c.validatorsMap.ForEach(func(v *validator.Validator) error {
+		count += v.RunningDuties(since)
+		return nil
+	})
+	return count
+}
+
+// PrepareDuty broadcasts the selection proofs of an upcoming duty of its validator.
+func (c *controller) PrepareDuty(logger *zap.Logger, duty *spectypes.Duty) {
+	v, ok := c.GetValidator(hex.EncodeToString(duty.PubKey[:]))
+	if !ok {
+		logger.Debug("could not find validator to prepare duty")
+		return
+	}
+	if err := v.PrepareDuty(logger, duty); err != nil {
+		logger.Warn("could not prepare duty", zap.Error(err))
 	}
 }
 
+// PurgeSelectionProofs drops the selection proofs of all validators for the slots before the given slot.
+func (c *controller) PurgeSelectionProofs(slot phase0.Slot) {
+	c.validatorOptions.SelectionProofs.Purge(slot)
+	_ = c.validatorsMap.ForEach(func(v *validator.Validator) error {
+		v.PurgeSelectionProofs(slot)
+		return nil
+	})
+}
+
 // CreateDutyExecuteMsg returns ssvMsg with event type of duty execute
 func CreateDutyExecuteMsg(duty *spectypes.Duty, pubKey phase0.BLSPubKey, domain spectypes.DomainType) (*spectypes.SSVMessage, error) {
 	executeDutyData := types.ExecuteDutyData{Duty: duty}
@@ -650,10 +857,14 @@ func (c *controller) onMetadataUpdated(pk string, meta *beaconprotocol.Validator
 func (c *controller) onShareRemove(pk string, removeSecret bool) error {
 	// remove from validatorsMap
 	v := c.validatorsMap.RemoveValidator(pk)
+	c.erroredValidators.Delete(pk)
 
-	// stop instance
+	// stop instance and release its subnet
 	if v != nil {
 		v.Stop()
+		if err := c.network.Unsubscribe(c.logger, v.Share.ValidatorPubKey); err != nil {
+			c.logger.Warn("could not unsubscribe from validator subnet", fields.PubKey(v.Share.ValidatorPubKey), zap.Error(err))
+		}
 	}
 	// remove the share secret from key-manager
 	if removeSecret {
@@ -670,6 +881,11 @@ func (c *controller) onShareStart(share *ssvtypes.SSVShare) (bool, error) {
 		c.logger.Warn("skipping validator until it becomes active", fields.PubKey(share.ValidatorPubKey))
 		return false, nil
 	}
+	if isInactive(share.BeaconMetadata) {
+		c.logger.Debug("skipping validator which is no longer active", fields.PubKey(share.ValidatorPubKey),
+			zap.String("status", share.BeaconMetadata.Status.String()))
+		return false, nil
+	}
 
 	if err := c.setShareFeeRecipient(share, c.recipientsStorage.GetRecipientData); err != nil {
 		return false, errors.Wrap(err, "could not set share fee recipient")
@@ -703,82 +919,119 @@ func (c *controller) setShareFeeRecipient(share *ssvtypes.SSVShare, getRecipient
 	return nil
 }
 
+// RefreshMetadata triggers an update of the metadata of all validators without blocking,
+// the update is done by UpdateValidatorMetaDataLoop.
+func (c *controller) RefreshMetadata() {
+	c.metadataQueue.Push(c.sharePubKeys()...)
+}
+
+// RestartValidator stops the validator and starts it with new runners.
+func (c *controller) RestartValidator(pubKey []byte) error {
+	unlock := c.lockRestart(hex.EncodeToString(pubKey))
+	defer unlock()
+
+	return c.restartValidator(pubKey)
+}
+
+// lockRestart locks the restarts of the validator, and returns the function which unlocks them.
+func (c *controller) lockRestart(pubKey string) func() {
+	lock, _ := c.restartLocks.LoadOrStore(pubKey, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	return lock.(*sync.Mutex).Unlock
+}
+
+// restartValidator stops the validator and starts it with new runners, the caller must lock its restarts.
+func (c *controller) restartValidator(pubKey []byte) error {
+	share := c.sharesStorage.Get(nil, pubKey)
+	if share == nil {
+		return errors.New("share not found")
+	}
+	if !share.BelongsToOperator(c.GetOperatorData().ID) {
+		return errors.New("share does not belong to this operator")
+	}
+	if share.Liquidated {
+		return errors.New("validator is liquidated")
+	}
+
+	if err := c.onShareRemove(hex.EncodeToString(pubKey), false); err != nil {
+		return errors.Wrap(err, "could not stop validator")
+	}
+	started, err := c.onShareStart(share)
+	if err != nil {
+		return errors.Wrap(err, "could not start validator")
+	}
+	if !started {
+		return errors.New("validator was stopped but could not be started, it will be started once it's active")
+	}
+	return nil
+}
+
+// ResyncValidator syncs the highest decided of all roles of the validator from its peers.
+func (c *controller) ResyncValidator(pubKey []byte) error {
+	v, ok := c.validatorsMap.GetValidator(hex.EncodeToString(pubKey))
+	if !ok {
+		return errors.New("validator not found")
+	}
+	v, err := c.forkValidator(c.logger, v, c.beacon.GetBeaconNetwork().EstimatedCurrentSlot())
+	if err != nil {
+		return errors.Wrap(err, "could not restart validator for the active fork")
+	}
+	return v.Resync(c.logger)
+}
+
+// forkValidator returns the given validator if its message IDs are derived from the domain of the fork
+// which is active at the given slot, otherwise a fork changed the domain and the validator is restarted.
+// Duties of the same validator are executed concurrently, so the domain is checked again once the restarts
+// of the validator are locked, and only the first of them restarts it.
+func (c *controller) forkValidator(logger *zap.Logger, v *validator.Validator, slot phase0.Slot) (*validator.Validator, error) {
+	domainAtSlot := c.validatorOptions.DomainAtSlot
+	if domainAtSlot == nil || domainAtSlot(slot) == v.Share.DomainType {
+		return v, nil
+	}
+
+	pk := hex.EncodeToString(v.Share.ValidatorPubKey)
+	unlock := c.lockRestart(pk)
+	defer unlock()
+
+	v, ok := c.validatorsMap.GetValidator(pk)
+	if !ok {
+		return nil, errors.New("validator not found")
+	}
+	if domainAtSlot(slot) == v.Share.DomainType {
+		return v, nil
+	}
+
+	logger.Info("restarting validator for the domain of the active fork", fields.PubKey(v.Share.ValidatorPubKey))
+	if err := c.restartValidator(v.Share.ValidatorPubKey); err != nil {
+		return nil, err
+	}
+	v, ok = c.validatorsMap.GetValidator(pk)
+	if !ok {
+		return nil, errors.New("validator not found after restart")
+	}
+	return v, nil
+}
+
 // startValidator will start the given validator if applicable
 func (c *controller) startValidator(v *validator.Validator) (bool, error) {
 	c.reportValidatorStatus(v.Share.ValidatorPubKey, v.Share.BeaconMetadata)
 	if v.Share.BeaconMetadata.Index == 0 {
 		return false, errors.New("could not start validator: index not found")
 	}
+	pk := hex.EncodeToString(v.Share.ValidatorPubKey)
 	started, err := v.Start(c.logger)
 	if err != nil {
 		c.metrics.ValidatorError(v.Share.ValidatorPubKey)
+		c.erroredValidators.Store(pk, err)
 		return false, errors.Wrap(err, "could not start validator")
 	}
+	c.erroredValidators.Delete(pk)
 	if started {
 		c.recentlyStartedValidators++
 	}
 	return true, nil
 }
 
-// UpdateValidatorMetaDataLoop updates metadata of validators in an interval
-func (c *controller) UpdateValidatorMetaDataLoop() {
-	var interval = c.beacon.GetBeaconNetwork().SlotDurationSec() * 2
-
-	// Prepare share filters.
-	filters := []registrystorage.SharesFilter{}
-
-	// Filter for validators who belong to our operator.
-	if !c.validatorOptions.Exporter {
-		filters = append(filters, registrystorage.ByOperatorID(c.GetOperatorData().ID))
-	}
-
-	// Filter for validators who are not liquidated.
-	filters = append(filters, registrystorage.ByNotLiquidated())
-
-	// Filter for validators which haven't been updated recently.
-	filters = append(filters, func(s *ssvtypes.SSVShare) bool {
-		last, ok := c.metadataLastUpdated[string(s.ValidatorPubKey)]
-		return !ok || time.Since(last) > c.metadataUpdateInterval
-	})
-
-	for {
-		time.Sleep(interval)
-		start := time.Now()
-
-		// Get the shares to fetch metadata for.
-		shares := c.sharesStorage.List(nil, filters...)
-		var pks [][]byte
-		for _, share := range shares {
-			pks = append(pks, share.ValidatorPubKey)
-			c.metadataLastUpdated[string(share.ValidatorPubKey)] = time.Now()
-		}
-
-		// TODO: continue if there is nothing to update.
-
-		c.recentlyStartedValidators = 0
-		if len(pks) > 0 {
-			err := beaconprotocol.UpdateValidatorsMetadata(c.logger, pks, c, c.beacon, c.onMetadataUpdated)
-			if err != nil {
-				c.logger.Warn("failed to update validators metadata", zap.Error(err))
-				continue
-			}
-		}
-		c.logger.Debug("updated validators metadata",
-			zap.Int("validators", len(shares)),
-			zap.Uint64("started_validators", c.recentlyStartedValidators),
-			fields.Took(time.Since(start)))
-
-		// Notify DutyScheduler of new validators.
-		if c.recentlyStartedValidators > 0 {
-			select {
-			case c.indicesChange <- struct{}{}:
-			case <-time.After(interval):
-				c.logger.Warn("timed out while notifying DutyScheduler of new validators")
-			}
-		}
-	}
-}
-
 // SetupRunners initializes duty runners for the given validator
 func SetupRunners(ctx context.Context, logger *zap.Logger, options validator.Options) runner.DutyRunners {
 	if options.SSVShare == nil || options.SSVShare.BeaconMetadata == nil {
@@ -795,13 +1048,15 @@ func SetupRunners(ctx context.Context, logger *zap.Logger, options validator.Opt
 		spectypes.BNRoleValidatorRegistration,
 	}
 
-	domainType := ssvtypes.GetDefaultDomain()
+	domainType := options.SSVShare.DomainType
 	buildController := func(role spectypes.BeaconRole, valueCheckF specqbft.ProposedValueCheckF) *qbftcontroller.Controller {
 		config := &qbft.Config{
-			Signer:      options.Signer,
-			SigningPK:   options.SSVShare.ValidatorPubKey, // TODO right val?
-			Domain:      domainType,
-			ValueCheckF: nil, // sets per role type
+			Signer:            options.Signer,
+			SigningPK:         options.SSVShare.ValidatorPubKey, // TODO right val?
+			Domain:            domainType,
+			DomainAtSlot:      options.DomainAtSlot,
+			SignatureVerifier: options.SignatureVerifier,
+			ValueCheckF:       nil, // sets per role type
 			ProposerF: func(state *specqbft.State, round specqbft.Round) spectypes.OperatorID {
 				leader := specqbft.RoundRobinProposer(state, round)
 				//logger.Debug("leader", zap.Int("operator_id", int(leader)))
@@ -813,7 +1068,7 @@ func SetupRunners(ctx context.Context, logger *zap.Logger, options validator.Opt
 		}
 		config.ValueCheckF = valueCheckF
 
-		identifier := spectypes.NewMsgID(ssvtypes.GetDefaultDomain(), options.SSVShare.Share.ValidatorPubKey, role)
+		identifier := spectypes.NewMsgID(domainType, options.SSVShare.Share.ValidatorPubKey, role)
 		qbftCtrl := qbftcontroller.NewController(identifier[:], &options.SSVShare.Share, domainType, config, options.FullNode)
 		qbftCtrl.NewDecidedHandler = options.NewDecidedHandler
 		return qbftCtrl
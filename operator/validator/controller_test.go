@@ -2,22 +2,32 @@ package validator
 
 import (
 	"context"
+	"encoding/hex"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
 
 	"github.com/bloxapp/ssv/logging"
 
 	specqbft "github.com/bloxapp/ssv-spec/qbft"
+	specssv "github.com/bloxapp/ssv-spec/ssv"
 	spectypes "github.com/bloxapp/ssv-spec/types"
+	spectestingutils "github.com/bloxapp/ssv-spec/types/testingutils"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
 	"github.com/bloxapp/ssv/protocol/v2/blockchain/beacon"
 	"github.com/bloxapp/ssv/protocol/v2/message"
+	qbfttesting "github.com/bloxapp/ssv/protocol/v2/qbft/testing"
 	"github.com/bloxapp/ssv/protocol/v2/queue/worker"
+	"github.com/bloxapp/ssv/protocol/v2/ssv/queue"
+	"github.com/bloxapp/ssv/protocol/v2/ssv/runner"
 	"github.com/bloxapp/ssv/protocol/v2/ssv/validator"
 	"github.com/bloxapp/ssv/protocol/v2/types"
 )
@@ -151,6 +161,108 @@ func TestGetIndices(t *testing.T) {
 	require.Equal(t, 3, len(activeIndicesForNextEpoch)) // should return including ValidatorStatePendingQueued
 }
 
+// notAggregatorBeaconNode fails like the beacon node does if the validator isn't selected as an aggregator.
+type notAggregatorBeaconNode struct {
+	*spectestingutils.TestingBeaconNode
+}
+
+func (bn *notAggregatorBeaconNode) SubmitAggregateSelectionProof(phase0.Slot, phase0.CommitteeIndex, uint64, phase0.ValidatorIndex, []byte) (ssz.Marshaler, spec.DataVersion, error) {
+	return nil, spec.DataVersionPhase0, errors.New("validator is not an aggregator")
+}
+
+// selectionProofMsg returns the partial selection proof of the given operator for the given slot.
+func selectionProofMsg(t *testing.T, ks *spectestingutils.TestKeySet, id spectypes.OperatorID, slot phase0.Slot) *spectypes.SignedPartialSignatureMessage {
+	km := spectestingutils.NewTestingKeyManager()
+	domain, err := spectestingutils.NewTestingBeaconNode().DomainData(1, spectypes.DomainSelectionProof)
+	require.NoError(t, err)
+	pk := ks.Shares[id].GetPublicKey().Serialize()
+	sig, root, err := km.SignBeaconObject(spectypes.SSZUint64(slot), domain, pk, spectypes.DomainSelectionProof)
+	require.NoError(t, err)
+
+	msgs := spectypes.PartialSignatureMessages{
+		Type: spectypes.SelectionProofPartialSig,
+		Slot: slot,
+		Messages: []*spectypes.PartialSignatureMessage{{
+			PartialSignature: sig,
+			SigningRoot:      root,
+			Signer:           id,
+		}},
+	}
+	msgSig, err := km.SignRoot(msgs, spectypes.PartialSignatureType, pk)
+	require.NoError(t, err)
+	return &spectypes.SignedPartialSignatureMessage{Message: msgs, Signature: msgSig, Signer: id}
+}
+
+func TestShutdown_NotSelectedAggregator(t *testing.T) {
+	logger := logging.TestLogger(t)
+	ks := spectestingutils.Testing4SharesSet()
+	share := spectestingutils.TestingShare(ks)
+	beaconNetwork := spectypes.BeaconTestNetwork
+	duty := spectestingutils.TestingAggregatorDuty
+	duty.Slot = beaconNetwork.EstimatedCurrentSlot()
+
+	identifier := spectypes.NewMsgID(spectestingutils.TestingSSVDomainType, share.ValidatorPubKey, spectypes.BNRoleAggregator)
+	net := spectestingutils.NewTestingNetwork()
+	km := spectestingutils.NewTestingKeyManager()
+	config := qbfttesting.TestingConfig(logger, ks, spectypes.BNRoleAggregator)
+	config.Network = net
+	config.ValueCheckF = specssv.AggregatorValueCheckF(km, beaconNetwork, share.ValidatorPubKey, spectestingutils.TestingValidatorIndex)
+	beaconNode := &notAggregatorBeaconNode{spectestingutils.NewTestingBeaconNode()}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	v := validator.NewValidator(ctx, cancel, validator.Options{
+		Network:           net,
+		Beacon:            beaconNode,
+		BeaconNetwork:     beaconNetwork,
+		Storage:           qbfttesting.TestingStores(logger),
+		SSVShare:          &types.SSVShare{Share: *share},
+		Signer:            km,
+		SignatureVerifier: config.SignatureVerifier,
+		DutyRunners: runner.DutyRunners{
+			spectypes.BNRoleAggregator: runner.NewAggregatorRunner(
+				beaconNetwork,
+				share,
+				qbfttesting.NewTestingQBFTController(identifier[:], share, config, false),
+				beaconNode,
+				net,
+				km,
+				config.ValueCheckF,
+				0,
+			),
+		},
+	})
+	require.NoError(t, v.StartDuty(logger, &duty))
+	require.Equal(t, 1, v.RunningDuties(duty.Slot))
+
+	// The duty ends once the quorum of selection proofs shows that the validator isn't an aggregator.
+	for _, id := range []spectypes.OperatorID{1, 2, 3} {
+		msg := selectionProofMsg(t, ks, id, duty.Slot)
+		dec, err := queue.DecodeSSVMessage(logger, spectestingutils.SSVMsgAggregator(nil, msg))
+		require.NoError(t, err)
+		err = v.ProcessMessage(logger, dec)
+		if id == 3 {
+			require.ErrorContains(t, err, "validator is not an aggregator")
+		} else {
+			require.NoError(t, err)
+		}
+	}
+	require.Zero(t, v.RunningDuties(duty.Slot))
+
+	ctrl := gomock.NewController(t)
+	bn := beacon.NewMockBeaconNode(ctrl)
+	bn.EXPECT().GetBeaconNetwork().Return(beaconNetwork).AnyTimes()
+	ctr := setupController(logger, map[string]*validator.Validator{hex.EncodeToString(share.ValidatorPubKey): v})
+	ctr.beacon = bn
+	ctr.logger = logger
+
+	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancelShutdown()
+	start := time.Now()
+	ctr.Shutdown(shutdownCtx)
+	require.Less(t, time.Since(start), time.Second)
+	require.NoError(t, shutdownCtx.Err())
+}
+
 func setupController(logger *zap.Logger, validators map[string]*validator.Validator) controller {
 	return controller{
 		context:                    context.Background(),
@@ -0,0 +1,204 @@
+package validator
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	eth2apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"go.uber.org/zap"
+
+	"github.com/bloxapp/ssv/logging/fields"
+	beaconprotocol "github.com/bloxapp/ssv/protocol/v2/blockchain/beacon"
+	ssvtypes "github.com/bloxapp/ssv/protocol/v2/types"
+	registrystorage "github.com/bloxapp/ssv/registry/storage"
+)
+
+// metadataEventTopics are the topics of the beacon node events which trigger metadata updates.
+var metadataEventTopics = []string{"head", "finalized_checkpoint", "voluntary_exit"}
+
+// metadataQueue collects the validators whose metadata should be updated, until the update loop takes them.
+type metadataQueue struct {
+	mu     sync.Mutex
+	pks    map[string][]byte
+	signal chan struct{}
+}
+
+func newMetadataQueue() *metadataQueue {
+	return &metadataQueue{
+		pks:    make(map[string][]byte),
+		signal: make(chan struct{}, 1),
+	}
+}
+
+// Push adds the given validators to the queue and signals the update loop without blocking.
+func (q *metadataQueue) Push(pks ...[]byte) {
+	if len(pks) == 0 {
+		return
+	}
+	q.mu.Lock()
+	for _, pk := range pks {
+		q.pks[string(pk)] = pk
+	}
+	q.mu.Unlock()
+
+	select {
+	case q.signal <- struct{}{}:
+	default:
+		// an update is already pending
+	}
+}
+
+// Pop removes and returns all the queued validators.
+func (q *metadataQueue) Pop() [][]byte {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	pks := make([][]byte, 0, len(q.pks))
+	for key, pk := range q.pks {
+		pks = append(pks, pk)
+		delete(q.pks, key)
+	}
+	return pks
+}
+
+// UpdateValidatorMetaDataLoop updates the metadata of validators once their status might have changed on the beacon chain,
+// according to the events of the beacon node, and sweeps the validators which weren't updated recently as a fallback.
+func (c *controller) UpdateValidatorMetaDataLoop() {
+	beaconNetwork := c.beacon.GetBeaconNetwork()
+	sweepInterval := beaconNetwork.SlotDurationSec() * time.Duration(beaconNetwork.SlotsPerEpoch())
+	// The duty scheduler is notified within 2 slots, so that it doesn't miss the duties of the changed validators.
+	notifyTimeout := 2 * beaconNetwork.SlotDurationSec()
+
+	if err := c.beacon.Events(c.context, metadataEventTopics, c.handleBeaconEvent); err != nil {
+		c.logger.Warn("could not subscribe to beacon events, metadata is only updated by sweeps", zap.Error(err))
+	}
+
+	// Sweep once on start to update validators whose metadata was last updated before the node stopped.
+	c.metadataQueue.Push(c.sharePubKeys(c.notUpdatedRecently)...)
+
+	sweep := time.NewTicker(sweepInterval)
+	defer sweep.Stop()
+	for {
+		select {
+		case <-c.context.Done():
+			return
+		case <-sweep.C:
+			c.metadataQueue.Push(c.sharePubKeys(c.notUpdatedRecently)...)
+			continue
+		case <-c.metadataQueue.signal:
+		}
+		c.updateMetadata(c.metadataQueue.Pop(), notifyTimeout)
+	}
+}
+
+// updateMetadata fetches the metadata of the given validators, which starts or stops them according to their status,
+// and notifies the duty scheduler if validators were started or their status changed.
+func (c *controller) updateMetadata(pks [][]byte, notifyTimeout time.Duration) {
+	if len(pks) == 0 {
+		return
+	}
+	start := time.Now()
+
+	c.recentlyStartedValidators = 0
+	c.recentlyChangedValidators = 0
+	if err := beaconprotocol.UpdateValidatorsMetadata(c.logger, pks, c, c.beacon, c.onMetadataUpdated); err != nil {
+		c.logger.Warn("failed to update validators metadata", zap.Error(err))
+	}
+	c.logger.Debug("updated validators metadata",
+		zap.Int("validators", len(pks)),
+		zap.Uint64("started_validators", c.recentlyStartedValidators),
+		zap.Uint64("changed_validators", c.recentlyChangedValidators),
+		fields.Took(time.Since(start)))
+
+	// Notify DutyScheduler of new or changed validators.
+	if c.recentlyStartedValidators > 0 || c.recentlyChangedValidators > 0 {
+		select {
+		case c.indicesChange <- struct{}{}:
+		case <-c.context.Done():
+		case <-time.After(notifyTimeout):
+			c.logger.Warn("timed out while notifying DutyScheduler of validators changes")
+		}
+	}
+}
+
+// handleBeaconEvent queues the validators whose status might have changed according to the event:
+//   - on a new epoch, pending validators whose activation epoch was reached, so they start right away.
+//   - on finalization, validators which are pending, exiting or weren't found yet, as the beacon chain
+//     processes deposits and exits according to finalized epochs.
+//   - on a voluntary exit, the exiting validator.
+func (c *controller) handleBeaconEvent(event *eth2apiv1.Event) {
+	switch data := event.Data.(type) {
+	case *eth2apiv1.HeadEvent:
+		epoch := c.beacon.GetBeaconNetwork().EstimatedEpochAtSlot(data.Slot)
+		if uint64(epoch) <= atomic.SwapUint64(&c.lastEventEpoch, uint64(epoch)) {
+			return
+		}
+		c.metadataQueue.Push(c.sharePubKeys(func(share *ssvtypes.SSVShare) bool {
+			return share.HasBeaconMetadata() && share.BeaconMetadata.Pending() && share.BeaconMetadata.ActivationEpoch <= epoch
+		})...)
+	case *eth2apiv1.FinalizedCheckpointEvent:
+		c.metadataQueue.Push(c.sharePubKeys(func(share *ssvtypes.SSVShare) bool {
+			return !share.HasBeaconMetadata() || share.BeaconMetadata.Pending() ||
+				share.BeaconMetadata.Status == eth2apiv1.ValidatorStateActiveExiting ||
+				share.BeaconMetadata.Status == eth2apiv1.ValidatorStateActiveSlashed
+		})...)
+	case *phase0.SignedVoluntaryExit:
+		if data.Message == nil {
+			return
+		}
+		index := data.Message.ValidatorIndex
+		c.metadataQueue.Push(c.sharePubKeys(func(share *ssvtypes.SSVShare) bool {
+			return share.HasBeaconMetadata() && share.BeaconMetadata.Index == index
+		})...)
+	}
+}
+
+// notUpdatedRecently filters shares whose metadata wasn't updated within the metadata update interval.
+func (c *controller) notUpdatedRecently(share *ssvtypes.SSVShare) bool {
+	return !share.HasBeaconMetadata() || time.Since(share.BeaconMetadata.LastUpdated) > c.metadataUpdateInterval
+}
+
+// sharePubKeys returns the public keys of the non-liquidated shares which are managed by this node
+// (all of them in exporter mode) and match the given filters.
+func (c *controller) sharePubKeys(filters ...registrystorage.SharesFilter) [][]byte {
+	if !c.validatorOptions.Exporter {
+		filters = append(filters, registrystorage.ByOperatorID(c.GetOperatorData().ID))
+	}
+	filters = append(filters, registrystorage.ByNotLiquidated())
+
+	shares := c.sharesStorage.List(nil, filters...)
+	pks := make([][]byte, 0, len(shares))
+	for _, share := range shares {
+		pks = append(pks, share.ValidatorPubKey)
+	}
+	return pks
+}
+
+// stopInactiveValidator stops the validator if it exited or was slashed, releasing its subnet.
+func (c *controller) stopInactiveValidator(pk string, metadata *beaconprotocol.ValidatorMetadata) (bool, error) {
+	if !isInactive(metadata) {
+		return false, nil
+	}
+	if _, found := c.validatorsMap.GetValidator(pk); !found {
+		return true, nil
+	}
+	if err := c.onShareRemove(pk, false); err != nil {
+		return true, err
+	}
+	c.logger.Info("stopped validator which is no longer active",
+		zap.String("pubKey", pk), zap.String("status", metadata.Status.String()))
+	return true, nil
+}
+
+// statusChanged returns true if the status or the activation epoch of the validator changed,
+// which changes the duties it should be scheduled for.
+func statusChanged(previous, current *beaconprotocol.ValidatorMetadata) bool {
+	return previous == nil || previous.Status != current.Status || previous.ActivationEpoch != current.ActivationEpoch
+}
+
+// isInactive returns true if the validator exited or was slashed, so it shouldn't run.
+func isInactive(metadata *beaconprotocol.ValidatorMetadata) bool {
+	return metadata != nil && (metadata.Exiting() || metadata.Slashed())
+}
@@ -0,0 +1,101 @@
+package validator
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	eth2apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	spectypes "github.com/bloxapp/ssv-spec/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bloxapp/ssv/logging"
+	"github.com/bloxapp/ssv/protocol/v2/blockchain/beacon"
+	"github.com/bloxapp/ssv/protocol/v2/ssv/validator"
+	"github.com/bloxapp/ssv/protocol/v2/types"
+	registrystorage "github.com/bloxapp/ssv/registry/storage"
+	"github.com/bloxapp/ssv/storage/basedb"
+	"github.com/bloxapp/ssv/storage/kv"
+)
+
+func TestMetadataQueue(t *testing.T) {
+	q := newMetadataQueue()
+	require.Empty(t, q.Pop())
+
+	q.Push()
+	require.Len(t, q.signal, 0, "nothing to signal")
+
+	q.Push([]byte{1}, []byte{2})
+	q.Push([]byte{2}, []byte{3})
+	require.Len(t, q.signal, 1, "signals are coalesced")
+	require.ElementsMatch(t, [][]byte{{1}, {2}, {3}}, q.Pop())
+	require.Empty(t, q.Pop())
+}
+
+func TestHandleBeaconEvent(t *testing.T) {
+	logger := logging.TestLogger(t)
+	db, err := kv.NewInMemory(logger, basedb.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+	sharesStorage, err := registrystorage.NewSharesStorage(logger, db, []byte("test"))
+	require.NoError(t, err)
+
+	farFuture := phase0.Epoch(math.MaxUint64)
+	newShare := func(pk byte, operatorID spectypes.OperatorID, metadata *beacon.ValidatorMetadata) *types.SSVShare {
+		share := &types.SSVShare{}
+		share.ValidatorPubKey = []byte{pk}
+		share.OperatorID = operatorID
+		share.BeaconMetadata = metadata
+		return share
+	}
+	require.NoError(t, sharesStorage.Save(nil,
+		newShare(1, 1, &beacon.ValidatorMetadata{Status: eth2apiv1.ValidatorStatePendingQueued, Index: 1, ActivationEpoch: 10}),
+		newShare(2, 1, &beacon.ValidatorMetadata{Status: eth2apiv1.ValidatorStatePendingInitialized, Index: 2, ActivationEpoch: farFuture}),
+		newShare(3, 1, &beacon.ValidatorMetadata{Status: eth2apiv1.ValidatorStateActiveOngoing, Index: 3}),
+		newShare(4, 1, nil),
+		newShare(5, 1, &beacon.ValidatorMetadata{Status: eth2apiv1.ValidatorStateActiveExiting, Index: 5}),
+		newShare(6, 2, &beacon.ValidatorMetadata{Status: eth2apiv1.ValidatorStatePendingQueued, Index: 6, ActivationEpoch: 10}),
+	))
+
+	ctrl := gomock.NewController(t)
+	bc := beacon.NewMockBeaconNode(ctrl)
+	bc.EXPECT().GetBeaconNetwork().Return(spectypes.PraterNetwork).AnyTimes()
+
+	c := &controller{
+		context:          context.Background(),
+		logger:           logger,
+		sharesStorage:    sharesStorage,
+		beacon:           bc,
+		operatorData:     &registrystorage.OperatorData{ID: 1},
+		validatorOptions: &validator.Options{},
+		metadataQueue:    newMetadataQueue(),
+	}
+
+	// pending validators are updated once their activation epoch starts
+	c.handleBeaconEvent(&eth2apiv1.Event{Data: &eth2apiv1.HeadEvent{Slot: 9 * 32}})
+	require.Empty(t, c.metadataQueue.Pop())
+	c.handleBeaconEvent(&eth2apiv1.Event{Data: &eth2apiv1.HeadEvent{Slot: 10 * 32}})
+	require.Equal(t, [][]byte{{1}}, c.metadataQueue.Pop())
+	c.handleBeaconEvent(&eth2apiv1.Event{Data: &eth2apiv1.HeadEvent{Slot: 10*32 + 1}})
+	require.Empty(t, c.metadataQueue.Pop(), "epoch didn't change")
+
+	// validators in transition are updated on finalization
+	c.handleBeaconEvent(&eth2apiv1.Event{Data: &eth2apiv1.FinalizedCheckpointEvent{Epoch: 9}})
+	require.ElementsMatch(t, [][]byte{{1}, {2}, {4}, {5}}, c.metadataQueue.Pop())
+
+	// exiting validators are updated by their index
+	c.handleBeaconEvent(&eth2apiv1.Event{Data: &phase0.SignedVoluntaryExit{Message: &phase0.VoluntaryExit{ValidatorIndex: 3}}})
+	require.Equal(t, [][]byte{{3}}, c.metadataQueue.Pop())
+	c.handleBeaconEvent(&eth2apiv1.Event{Data: &phase0.SignedVoluntaryExit{Message: &phase0.VoluntaryExit{ValidatorIndex: 6}}})
+	require.Empty(t, c.metadataQueue.Pop(), "validator of another operator")
+}
+
+func TestIsInactive(t *testing.T) {
+	require.False(t, isInactive(nil))
+	require.False(t, isInactive(&beacon.ValidatorMetadata{Status: eth2apiv1.ValidatorStateActiveExiting}))
+	require.True(t, isInactive(&beacon.ValidatorMetadata{Status: eth2apiv1.ValidatorStateActiveSlashed}))
+	require.True(t, isInactive(&beacon.ValidatorMetadata{Status: eth2apiv1.ValidatorStateExitedUnslashed}))
+	require.True(t, isInactive(&beacon.ValidatorMetadata{Status: eth2apiv1.ValidatorStateWithdrawalDone}))
+}
@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	phase0 "github.com/attestantio/go-eth2-client/spec/phase0"
@@ -55,15 +56,15 @@ func (mr *MockControllerMockRecorder) ActiveValidatorIndices(epoch interface{})
 }
 
 // ExecuteDuty mocks base method.
-func (m *MockController) ExecuteDuty(logger *zap.Logger, duty *types.Duty) {
+func (m *MockController) ExecuteDuty(ctx context.Context, logger *zap.Logger, duty *types.Duty) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "ExecuteDuty", logger, duty)
+	m.ctrl.Call(m, "ExecuteDuty", ctx, logger, duty)
 }
 
 // ExecuteDuty indicates an expected call of ExecuteDuty.
-func (mr *MockControllerMockRecorder) ExecuteDuty(logger, duty interface{}) *gomock.Call {
+func (mr *MockControllerMockRecorder) ExecuteDuty(ctx, logger, duty interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteDuty", reflect.TypeOf((*MockController)(nil).ExecuteDuty), logger, duty)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteDuty", reflect.TypeOf((*MockController)(nil).ExecuteDuty), ctx, logger, duty)
 }
 
 // GetOperatorData mocks base method.
@@ -154,6 +155,30 @@ func (mr *MockControllerMockRecorder) LiquidateCluster(owner, operatorIDs, toLiq
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LiquidateCluster", reflect.TypeOf((*MockController)(nil).LiquidateCluster), owner, operatorIDs, toLiquidate)
 }
 
+// PrepareDuty mocks base method.
+func (m *MockController) PrepareDuty(logger *zap.Logger, duty *types.Duty) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PrepareDuty", logger, duty)
+}
+
+// PrepareDuty indicates an expected call of PrepareDuty.
+func (mr *MockControllerMockRecorder) PrepareDuty(logger, duty interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareDuty", reflect.TypeOf((*MockController)(nil).PrepareDuty), logger, duty)
+}
+
+// PurgeSelectionProofs mocks base method.
+func (m *MockController) PurgeSelectionProofs(slot phase0.Slot) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PurgeSelectionProofs", slot)
+}
+
+// PurgeSelectionProofs indicates an expected call of PurgeSelectionProofs.
+func (mr *MockControllerMockRecorder) PurgeSelectionProofs(slot interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeSelectionProofs", reflect.TypeOf((*MockController)(nil).PurgeSelectionProofs), slot)
+}
+
 // ReactivateCluster mocks base method.
 func (m *MockController) ReactivateCluster(owner common.Address, operatorIDs []uint64, toReactivate []*types0.SSVShare) error {
 	m.ctrl.T.Helper()
@@ -168,6 +193,46 @@ func (mr *MockControllerMockRecorder) ReactivateCluster(owner, operatorIDs, toRe
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReactivateCluster", reflect.TypeOf((*MockController)(nil).ReactivateCluster), owner, operatorIDs, toReactivate)
 }
 
+// RefreshMetadata mocks base method.
+func (m *MockController) RefreshMetadata() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RefreshMetadata")
+}
+
+// RefreshMetadata indicates an expected call of RefreshMetadata.
+func (mr *MockControllerMockRecorder) RefreshMetadata() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshMetadata", reflect.TypeOf((*MockController)(nil).RefreshMetadata))
+}
+
+// RestartValidator mocks base method.
+func (m *MockController) RestartValidator(pubKey []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestartValidator", pubKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestartValidator indicates an expected call of RestartValidator.
+func (mr *MockControllerMockRecorder) RestartValidator(pubKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestartValidator", reflect.TypeOf((*MockController)(nil).RestartValidator), pubKey)
+}
+
+// ResyncValidator mocks base method.
+func (m *MockController) ResyncValidator(pubKey []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResyncValidator", pubKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResyncValidator indicates an expected call of ResyncValidator.
+func (mr *MockControllerMockRecorder) ResyncValidator(pubKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResyncValidator", reflect.TypeOf((*MockController)(nil).ResyncValidator), pubKey)
+}
+
 // SetOperatorData mocks base method.
 func (m *MockController) SetOperatorData(data *storage.OperatorData) {
 	m.ctrl.T.Helper()
@@ -180,6 +245,18 @@ func (mr *MockControllerMockRecorder) SetOperatorData(data interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOperatorData", reflect.TypeOf((*MockController)(nil).SetOperatorData), data)
 }
 
+// Shutdown mocks base method.
+func (m *MockController) Shutdown(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Shutdown", ctx)
+}
+
+// Shutdown indicates an expected call of Shutdown.
+func (mr *MockControllerMockRecorder) Shutdown(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Shutdown", reflect.TypeOf((*MockController)(nil).Shutdown), ctx)
+}
+
 // StartNetworkHandlers mocks base method.
 func (m *MockController) StartNetworkHandlers() {
 	m.ctrl.T.Helper()
@@ -218,6 +295,21 @@ func (mr *MockControllerMockRecorder) StartValidators() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartValidators", reflect.TypeOf((*MockController)(nil).StartValidators))
 }
 
+// Status mocks base method.
+func (m *MockController) Status(ctx context.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Status", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Status indicates an expected call of Status.
+func (mr *MockControllerMockRecorder) Status(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockController)(nil).Status), ctx)
+}
+
 // StopValidator mocks base method.
 func (m *MockController) StopValidator(publicKey []byte) error {
 	m.ctrl.T.Helper()
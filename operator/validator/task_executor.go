@@ -21,11 +21,9 @@ func (c *controller) taskLogger(taskName string, fields ...zap.Field) *zap.Logge
 }
 
 func (c *controller) StartValidator(share *ssvtypes.SSVShare) error {
-	// logger := c.taskLogger("StartValidator", fields.PubKey(share.ValidatorPubKey))
-
-	// Since we don't yet have the Beacon metadata for this validator,
-	// we can't yet start it. Starting happens in `UpdateValidatorMetaDataLoop`,
-	// so this task is currently a no-op.
+	// Since we don't yet have the Beacon metadata for this validator, we can't yet start it.
+	// Its metadata is fetched by `UpdateValidatorMetaDataLoop`, which starts it once it's active.
+	c.metadataQueue.Push(share.ValidatorPubKey)
 
 	return nil
 }
@@ -75,7 +75,12 @@ func (vm *validatorsMap) GetOrCreateValidator(logger *zap.Logger, share *types.S
 			return nil, fmt.Errorf("beacon metadata is missing")
 		}
 		opts := *vm.optsTemplate
-		opts.SSVShare = share
+		// The message IDs of the validator are derived from the domain of the fork which is active when it's created,
+		// the controller restarts it once a fork changes the domain.
+		// The share is copied, since the share of the storage is read concurrently.
+		shareCopy := *share
+		opts.SSVShare = &shareCopy
+		shareCopy.DomainType = opts.Domain(opts.BeaconNetwork.EstimatedCurrentSlot())
 
 		// Share context with both the validator and the runners,
 		// so that when the validator is stopped, the runners are stopped as well.
@@ -2,6 +2,7 @@ package beacon
 
 import (
 	"encoding/hex"
+	"time"
 
 	eth2apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
@@ -22,6 +23,8 @@ type ValidatorMetadata struct {
 	Status          eth2apiv1.ValidatorState `json:"status"`
 	Index           phase0.ValidatorIndex    `json:"index"` // pointer in order to support nil
 	ActivationEpoch phase0.Epoch             `json:"activation_epoch"`
+	// LastUpdated is the time the metadata was fetched from the beacon node
+	LastUpdated time.Time `json:"last_updated"`
 }
 
 // Equals returns true if the given metadata is equal to current
@@ -114,6 +117,7 @@ func FetchValidatorsMetadata(bc BeaconNode, pubKeys [][]byte) (map[string]*Valid
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get validators data from beacon")
 	}
+	now := time.Now()
 	ret := make(map[string]*ValidatorMetadata)
 	for _, v := range validatorsIndexMap {
 		pk := hex.EncodeToString(v.Validator.PublicKey[:])
@@ -122,6 +126,7 @@ func FetchValidatorsMetadata(bc BeaconNode, pubKeys [][]byte) (map[string]*Valid
 			Status:          v.Status,
 			Index:           v.Index,
 			ActivationEpoch: v.Validator.ActivationEpoch,
+			LastUpdated:     now,
 		}
 		ret[pk] = meta
 	}
@@ -1,5 +1,11 @@
 package message
 
+import (
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+)
+
 // Encoder encodes or decodes the message
 type Encoder interface {
 	// Encode encodes the message
@@ -7,3 +13,65 @@ type Encoder interface {
 	// Decode decodes the message
 	Decode(data []byte) error
 }
+
+const (
+	// versionedPrefix marks versioned payloads. it can't be the first byte of legacy payloads,
+	// as JSON payloads start with '{' and SSZ encoded SSVMessage payloads start with a small message type
+	versionedPrefix byte = 0xff
+	// VersionSSZ is the version of SSZ encoded payloads
+	VersionSSZ byte = 0x01
+	// VersionSSZSnappy is the version of SSZ encoded payloads, compressed with snappy
+	VersionSSZSnappy byte = 0x02
+
+	// MaxDecodedSize is the maximum size of a decompressed payload, which is the maximum size of SSVMessage.Data (2^23)
+	// plus some room for the rest of the message
+	MaxDecodedSize = 1<<23 + 1<<10
+)
+
+// IsVersioned returns whether the given payload was encoded with EncodeVersioned
+func IsVersioned(data []byte) bool {
+	return len(data) >= 2 && data[0] == versionedPrefix
+}
+
+// EncodeVersioned encodes the given object with SSZ, optionally compressed with snappy,
+// prefixed by a header that allows receivers to tell it apart from legacy payloads
+func EncodeVersioned(obj ssz.Marshaler, compress bool) ([]byte, error) {
+	if !compress {
+		buf := make([]byte, 2, 2+obj.SizeSSZ())
+		buf[0], buf[1] = versionedPrefix, VersionSSZ
+		return obj.MarshalSSZTo(buf)
+	}
+	raw, err := obj.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, 2, 2+snappy.MaxEncodedLen(len(raw)))
+	buf[0], buf[1] = versionedPrefix, VersionSSZSnappy
+	return append(buf, snappy.Encode(nil, raw)...), nil
+}
+
+// DecodeVersioned decodes a payload that was encoded with EncodeVersioned
+func DecodeVersioned(data []byte, obj ssz.Unmarshaler) error {
+	if !IsVersioned(data) {
+		return errors.New("payload is not versioned")
+	}
+	payload := data[2:]
+	switch data[1] {
+	case VersionSSZ:
+	case VersionSSZSnappy:
+		n, err := snappy.DecodedLen(payload)
+		if err != nil {
+			return errors.Wrap(err, "could not read decoded length")
+		}
+		if n > MaxDecodedSize {
+			return errors.Errorf("decoded payload is too large: %d", n)
+		}
+		payload, err = snappy.Decode(nil, payload)
+		if err != nil {
+			return errors.Wrap(err, "could not decompress payload")
+		}
+	default:
+		return errors.Errorf("unknown payload version %d", data[1])
+	}
+	return obj.UnmarshalSSZ(payload)
+}
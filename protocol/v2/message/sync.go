@@ -87,8 +87,11 @@ func (sm *SyncMessage) Encode() ([]byte, error) {
 	return json.Marshal(sm)
 }
 
-// Decode decodes the message
+// Decode decodes the message, either JSON or SSZ (see EncodeSSZ) encoded
 func (sm *SyncMessage) Decode(data []byte) error {
+	if IsVersioned(data) {
+		return sm.decodeSSZ(data)
+	}
 	return json.Unmarshal(data, sm)
 }
 
@@ -0,0 +1,69 @@
+package message
+
+import (
+	specqbft "github.com/bloxapp/ssv-spec/qbft"
+	spectypes "github.com/bloxapp/ssv-spec/types"
+	"github.com/pkg/errors"
+)
+
+// MaxSyncResults is the maximum amount of decided messages in a single SSZ encoded sync message
+const MaxSyncResults = 1024
+
+// syncMessageSSZ is the SSZ representation of SyncMessage
+type syncMessageSSZ struct {
+	Protocol   uint32
+	HasParams  bool
+	Height     []uint64                  `ssz-max:"2"`
+	Identifier [56]byte                  `ssz-size:"56"`
+	Data       []*specqbft.SignedMessage `ssz-max:"1024"`
+	Status     uint32
+}
+
+// EncodeSSZ encodes the message with SSZ, optionally compressed with snappy
+func (sm *SyncMessage) EncodeSSZ(compress bool) ([]byte, error) {
+	if len(sm.Data) > MaxSyncResults {
+		return nil, errors.Errorf("too many results: %d", len(sm.Data))
+	}
+	obj := &syncMessageSSZ{
+		Protocol: uint32(sm.Protocol),
+		Data:     sm.Data,
+		Status:   uint32(sm.Status),
+	}
+	if obj.Data == nil {
+		obj.Data = []*specqbft.SignedMessage{}
+	}
+	if sm.Params != nil {
+		if len(sm.Params.Height) > 2 {
+			return nil, errors.Errorf("too many heights: %d", len(sm.Params.Height))
+		}
+		obj.HasParams = true
+		obj.Identifier = sm.Params.Identifier
+		obj.Height = make([]uint64, 0, len(sm.Params.Height))
+		for _, h := range sm.Params.Height {
+			obj.Height = append(obj.Height, uint64(h))
+		}
+	}
+	return EncodeVersioned(obj, compress)
+}
+
+// decodeSSZ decodes a message that was encoded with EncodeSSZ
+func (sm *SyncMessage) decodeSSZ(data []byte) error {
+	obj := &syncMessageSSZ{}
+	if err := DecodeVersioned(data, obj); err != nil {
+		return errors.Wrap(err, "could not decode sync message")
+	}
+	sm.Protocol = SyncMsgType(obj.Protocol)
+	sm.Status = StatusCode(obj.Status)
+	sm.Data = nil
+	if len(obj.Data) > 0 {
+		sm.Data = obj.Data
+	}
+	sm.Params = nil
+	if obj.HasParams {
+		sm.Params = &SyncParams{Identifier: spectypes.MessageID(obj.Identifier)}
+		for _, h := range obj.Height {
+			sm.Params.Height = append(sm.Params.Height, specqbft.Height(h))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: 3854c7a070e9557593fa625b137097c1272a220097d8e40f1800795e370b09f0
+// Version: 0.1.3
+package message
+
+import (
+	specqbft "github.com/bloxapp/ssv-spec/qbft"
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MarshalSSZ ssz marshals the syncMessageSSZ object
+func (s *syncMessageSSZ) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the syncMessageSSZ object to a target array
+func (s *syncMessageSSZ) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(73)
+
+	// Field (0) 'Protocol'
+	dst = ssz.MarshalUint32(dst, s.Protocol)
+
+	// Field (1) 'HasParams'
+	dst = ssz.MarshalBool(dst, s.HasParams)
+
+	// Offset (2) 'Height'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(s.Height) * 8
+
+	// Field (3) 'Identifier'
+	dst = append(dst, s.Identifier[:]...)
+
+	// Offset (4) 'Data'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(s.Data); ii++ {
+		offset += 4
+		offset += s.Data[ii].SizeSSZ()
+	}
+
+	// Field (5) 'Status'
+	dst = ssz.MarshalUint32(dst, s.Status)
+
+	// Field (2) 'Height'
+	if size := len(s.Height); size > 2 {
+		err = ssz.ErrListTooBigFn("syncMessageSSZ.Height", size, 2)
+		return
+	}
+	for ii := 0; ii < len(s.Height); ii++ {
+		dst = ssz.MarshalUint64(dst, s.Height[ii])
+	}
+
+	// Field (4) 'Data'
+	if size := len(s.Data); size > 1024 {
+		err = ssz.ErrListTooBigFn("syncMessageSSZ.Data", size, 1024)
+		return
+	}
+	{
+		offset = 4 * len(s.Data)
+		for ii := 0; ii < len(s.Data); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += s.Data[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(s.Data); ii++ {
+		if dst, err = s.Data[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the syncMessageSSZ object
+func (s *syncMessageSSZ) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 73 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o2, o4 uint64
+
+	// Field (0) 'Protocol'
+	s.Protocol = ssz.UnmarshallUint32(buf[0:4])
+
+	// Field (1) 'HasParams'
+	s.HasParams = ssz.UnmarshalBool(buf[4:5])
+
+	// Offset (2) 'Height'
+	if o2 = ssz.ReadOffset(buf[5:9]); o2 > size {
+		return ssz.ErrOffset
+	}
+
+	if o2 < 73 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (3) 'Identifier'
+	copy(s.Identifier[:], buf[9:65])
+
+	// Offset (4) 'Data'
+	if o4 = ssz.ReadOffset(buf[65:69]); o4 > size || o2 > o4 {
+		return ssz.ErrOffset
+	}
+
+	// Field (5) 'Status'
+	s.Status = ssz.UnmarshallUint32(buf[69:73])
+
+	// Field (2) 'Height'
+	{
+		buf = tail[o2:o4]
+		num, err := ssz.DivideInt2(len(buf), 8, 2)
+		if err != nil {
+			return err
+		}
+		s.Height = ssz.ExtendUint64(s.Height, num)
+		for ii := 0; ii < num; ii++ {
+			s.Height[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	// Field (4) 'Data'
+	{
+		buf = tail[o4:]
+		num, err := ssz.DecodeDynamicLength(buf, 1024)
+		if err != nil {
+			return err
+		}
+		s.Data = make([]*specqbft.SignedMessage, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if s.Data[indx] == nil {
+				s.Data[indx] = new(specqbft.SignedMessage)
+			}
+			if err = s.Data[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the syncMessageSSZ object
+func (s *syncMessageSSZ) SizeSSZ() (size int) {
+	size = 73
+
+	// Field (2) 'Height'
+	size += len(s.Height) * 8
+
+	// Field (4) 'Data'
+	for ii := 0; ii < len(s.Data); ii++ {
+		size += 4
+		size += s.Data[ii].SizeSSZ()
+	}
+
+	return
+}
+
+// HashTreeRoot ssz hashes the syncMessageSSZ object
+func (s *syncMessageSSZ) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the syncMessageSSZ object with a hasher
+func (s *syncMessageSSZ) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Protocol'
+	hh.PutUint32(s.Protocol)
+
+	// Field (1) 'HasParams'
+	hh.PutBool(s.HasParams)
+
+	// Field (2) 'Height'
+	{
+		if size := len(s.Height); size > 2 {
+			err = ssz.ErrListTooBigFn("syncMessageSSZ.Height", size, 2)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range s.Height {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+		numItems := uint64(len(s.Height))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(2, numItems, 8))
+	}
+
+	// Field (3) 'Identifier'
+	hh.PutBytes(s.Identifier[:])
+
+	// Field (4) 'Data'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(s.Data))
+		if num > 1024 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range s.Data {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 1024)
+	}
+
+	// Field (5) 'Status'
+	hh.PutUint32(s.Status)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the syncMessageSSZ object
+func (s *syncMessageSSZ) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
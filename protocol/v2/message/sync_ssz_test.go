@@ -0,0 +1,83 @@
+package message_test
+
+import (
+	"testing"
+
+	specqbft "github.com/bloxapp/ssv-spec/qbft"
+	spectypes "github.com/bloxapp/ssv-spec/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bloxapp/ssv/protocol/v2/message"
+	protocoltesting "github.com/bloxapp/ssv/protocol/v2/testing"
+)
+
+func TestSyncMessage_SSZ(t *testing.T) {
+	uids := []spectypes.OperatorID{spectypes.OperatorID(1), spectypes.OperatorID(2), spectypes.OperatorID(3)}
+	secretKeys, _ := protocoltesting.GenerateBLSKeys(uids...)
+	mid := spectypes.NewMsgID(spectypes.DomainType{0x0, 0x0, 0x5, 0x1}, make([]byte, 48), spectypes.BNRoleAttester)
+
+	var decided []*specqbft.SignedMessage
+	for h := specqbft.Height(1); h <= 3; h++ {
+		decided = append(decided, protocoltesting.SignMsg(t, secretKeys, uids, &specqbft.Message{
+			MsgType:    specqbft.CommitMsgType,
+			Height:     h,
+			Round:      1,
+			Identifier: mid[:],
+			Root:       [32]byte{0x1},
+		}))
+	}
+	sm := &message.SyncMessage{
+		Protocol: message.DecidedHistoryType,
+		Params: &message.SyncParams{
+			Height:     []specqbft.Height{1, 3},
+			Identifier: mid,
+		},
+	}
+	sm.UpdateResults(nil, decided...)
+
+	for _, compress := range []bool{false, true} {
+		data, err := sm.EncodeSSZ(compress)
+		require.NoError(t, err)
+		require.True(t, message.IsVersioned(data))
+
+		decoded := &message.SyncMessage{}
+		require.NoError(t, decoded.Decode(data))
+		require.Equal(t, sm.Protocol, decoded.Protocol)
+		require.Equal(t, sm.Status, decoded.Status)
+		require.Equal(t, sm.Params, decoded.Params)
+		require.Len(t, decoded.Data, len(decided))
+		for i := range decided {
+			expected, err := decided[i].Encode()
+			require.NoError(t, err)
+			actual, err := decoded.Data[i].Encode()
+			require.NoError(t, err)
+			require.Equal(t, expected, actual)
+		}
+	}
+
+	t.Run("legacy JSON", func(t *testing.T) {
+		data, err := sm.Encode()
+		require.NoError(t, err)
+		require.False(t, message.IsVersioned(data))
+
+		decoded := &message.SyncMessage{}
+		require.NoError(t, decoded.Decode(data))
+		require.Equal(t, sm.Params, decoded.Params)
+		require.Len(t, decoded.Data, len(decided))
+	})
+
+	t.Run("no params", func(t *testing.T) {
+		data, err := (&message.SyncMessage{Status: message.StatusNotFound}).EncodeSSZ(true)
+		require.NoError(t, err)
+		decoded := &message.SyncMessage{}
+		require.NoError(t, decoded.Decode(data))
+		require.Nil(t, decoded.Params)
+		require.Nil(t, decoded.Data)
+		require.Equal(t, message.StatusNotFound, decoded.Status)
+	})
+
+	t.Run("too many results", func(t *testing.T) {
+		_, err := (&message.SyncMessage{Data: make([]*specqbft.SignedMessage, message.MaxSyncResults+1)}).EncodeSSZ(true)
+		require.Error(t, err)
+	})
+}
@@ -1,9 +1,12 @@
 package qbft
 
 import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	specqbft "github.com/bloxapp/ssv-spec/qbft"
 	spectypes "github.com/bloxapp/ssv-spec/types"
+
 	qbftstorage "github.com/bloxapp/ssv/protocol/v2/qbft/storage"
+	"github.com/bloxapp/ssv/protocol/v2/types"
 )
 
 type signing interface {
@@ -11,6 +14,10 @@ type signing interface {
 	GetSigner() spectypes.SSVSigner
 	// GetSignatureDomainType returns the Domain type used for signatures
 	GetSignatureDomainType() spectypes.DomainType
+	// GetSignatureDomainTypeAtHeight returns the Domain type used for signatures of messages of the given height
+	GetSignatureDomainTypeAtHeight(height specqbft.Height) spectypes.DomainType
+	// GetSignatureVerifier returns the SignatureVerifier used to verify the signatures of messages
+	GetSignatureVerifier() *types.SignatureVerifier
 }
 
 type IConfig interface {
@@ -28,14 +35,17 @@ type IConfig interface {
 }
 
 type Config struct {
-	Signer      spectypes.SSVSigner
-	SigningPK   []byte
-	Domain      spectypes.DomainType
-	ValueCheckF specqbft.ProposedValueCheckF
-	ProposerF   specqbft.ProposerF
-	Storage     qbftstorage.QBFTStore
-	Network     specqbft.Network
-	Timer       specqbft.Timer
+	Signer    spectypes.SSVSigner
+	SigningPK []byte
+	Domain    spectypes.DomainType
+	// DomainAtSlot is optional, if set it resolves the domain of messages according to the fork schedule
+	DomainAtSlot      types.DomainAtSlotF
+	SignatureVerifier *types.SignatureVerifier
+	ValueCheckF       specqbft.ProposedValueCheckF
+	ProposerF         specqbft.ProposerF
+	Storage           qbftstorage.QBFTStore
+	Network           specqbft.Network
+	Timer             specqbft.Timer
 }
 
 // GetSigner returns a Signer instance
@@ -53,6 +63,20 @@ func (c *Config) GetSignatureDomainType() spectypes.DomainType {
 	return c.Domain
 }
 
+// GetSignatureDomainTypeAtHeight returns the Domain type used for signatures of messages of the given height,
+// the height of duty instances is the slot of the duty
+func (c *Config) GetSignatureDomainTypeAtHeight(height specqbft.Height) spectypes.DomainType {
+	if c.DomainAtSlot == nil {
+		return c.Domain
+	}
+	return c.DomainAtSlot(phase0.Slot(height))
+}
+
+// GetSignatureVerifier returns the SignatureVerifier used to verify the signatures of messages
+func (c *Config) GetSignatureVerifier() *types.SignatureVerifier {
+	return c.SignatureVerifier
+}
+
 // GetValueCheckF returns value check instance
 func (c *Config) GetValueCheckF() specqbft.ProposedValueCheckF {
 	return c.ValueCheckF
@@ -7,7 +7,6 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/bloxapp/ssv/protocol/v2/qbft"
-	"github.com/bloxapp/ssv/protocol/v2/types"
 )
 
 func (c *Controller) UponFutureMsg(logger *zap.Logger, msg *specqbft.SignedMessage) (*specqbft.SignedMessage, error) {
@@ -40,7 +39,7 @@ func ValidateFutureMsg(
 	}
 
 	// verify signature
-	if err := types.VerifyByOperators(msg.Signature, msg, config.GetSignatureDomainType(), spectypes.QBFTSignatureType, operators); err != nil {
+	if err := config.GetSignatureVerifier().VerifyByOperators(msg.Signature, msg, config.GetSignatureDomainTypeAtHeight(msg.Message.Height), spectypes.QBFTSignatureType, operators, msg.Message.Height); err != nil {
 		return errors.Wrap(err, "msg signature invalid")
 	}
 
@@ -11,7 +11,6 @@ import (
 
 	"github.com/bloxapp/ssv/logging/fields"
 	"github.com/bloxapp/ssv/protocol/v2/qbft"
-	"github.com/bloxapp/ssv/protocol/v2/types"
 )
 
 // UponCommit returns true if a quorum of commit messages was received.
@@ -159,7 +158,7 @@ func BaseCommitValidation(
 	}
 
 	// verify signature
-	if err := types.VerifyByOperators(signedCommit.Signature, signedCommit, config.GetSignatureDomainType(), spectypes.QBFTSignatureType, operators); err != nil {
+	if err := config.GetSignatureVerifier().VerifyByOperators(signedCommit.Signature, signedCommit, config.GetSignatureDomainTypeAtHeight(signedCommit.Message.Height), spectypes.QBFTSignatureType, operators, signedCommit.Message.Height); err != nil {
 		return errors.Wrap(err, "msg signature invalid")
 	}
 
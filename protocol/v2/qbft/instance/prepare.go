@@ -10,7 +10,6 @@ import (
 
 	"github.com/bloxapp/ssv/logging/fields"
 	"github.com/bloxapp/ssv/protocol/v2/qbft"
-	"github.com/bloxapp/ssv/protocol/v2/types"
 )
 
 // uponPrepare process prepare message
@@ -159,7 +158,7 @@ func validSignedPrepareForHeightRoundAndRoot(
 		return errors.New("msg allows 1 signer")
 	}
 
-	if err := types.VerifyByOperators(signedPrepare.Signature, signedPrepare, config.GetSignatureDomainType(), spectypes.QBFTSignatureType, operators); err != nil {
+	if err := config.GetSignatureVerifier().VerifyByOperators(signedPrepare.Signature, signedPrepare, config.GetSignatureDomainTypeAtHeight(signedPrepare.Message.Height), spectypes.QBFTSignatureType, operators, signedPrepare.Message.Height); err != nil {
 		return errors.Wrap(err, "msg signature invalid")
 	}
 
@@ -10,7 +10,6 @@ import (
 
 	"github.com/bloxapp/ssv/logging/fields"
 	"github.com/bloxapp/ssv/protocol/v2/qbft"
-	"github.com/bloxapp/ssv/protocol/v2/types"
 )
 
 // uponRoundChange process round change messages.
@@ -247,7 +246,7 @@ func validRoundChangeForData(
 		return errors.New("msg allows 1 signer")
 	}
 
-	if err := types.VerifyByOperators(signedMsg.Signature, signedMsg, config.GetSignatureDomainType(), spectypes.QBFTSignatureType, state.Share.Committee); err != nil {
+	if err := config.GetSignatureVerifier().VerifyByOperators(signedMsg.Signature, signedMsg, config.GetSignatureDomainTypeAtHeight(signedMsg.Message.Height), spectypes.QBFTSignatureType, state.Share.Committee, signedMsg.Message.Height); err != nil {
 		return errors.Wrap(err, "msg signature invalid")
 	}
 
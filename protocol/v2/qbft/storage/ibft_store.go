@@ -1,6 +1,7 @@
 package qbftstorage
 
 import (
+	"context"
 	"encoding/json"
 
 	"go.uber.org/zap"
@@ -46,6 +47,11 @@ type InstanceStore interface {
 
 	// CleanAllInstances removes all historical and highest instances for the given identifier.
 	CleanAllInstances(logger *zap.Logger, msgID []byte) error
+
+	// PruneInstances removes the historical instances that are below the height returned by minHeight,
+	// which is called for each identifier with the height of its latest historical instance.
+	// The highest instance is kept, and the amount of removed instances is returned.
+	PruneInstances(ctx context.Context, minHeight func(identifier []byte, latest specqbft.Height) specqbft.Height, batchSize int) (int, error)
 }
 
 // QBFTStore is the store used by QBFT components
@@ -2,21 +2,38 @@ package testing
 
 import (
 	"bytes"
+	"sync"
 
 	specqbft "github.com/bloxapp/ssv-spec/qbft"
 	"github.com/bloxapp/ssv-spec/types"
 	"github.com/bloxapp/ssv-spec/types/testingutils"
 	"github.com/bloxapp/ssv/protocol/v2/qbft"
 	"github.com/bloxapp/ssv/protocol/v2/qbft/controller"
+	ssvtypes "github.com/bloxapp/ssv/protocol/v2/types"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 )
 
+var (
+	testingSignatureVerifier     *ssvtypes.SignatureVerifier
+	testingSignatureVerifierOnce sync.Once
+)
+
+// TestingSignatureVerifier returns the SignatureVerifier shared by the testing configs,
+// which is created upon the first call.
+var TestingSignatureVerifier = func() *ssvtypes.SignatureVerifier {
+	testingSignatureVerifierOnce.Do(func() {
+		testingSignatureVerifier = ssvtypes.NewDefaultSignatureVerifier()
+	})
+	return testingSignatureVerifier
+}
+
 var TestingConfig = func(logger *zap.Logger, keySet *testingutils.TestKeySet, role types.BeaconRole) *qbft.Config {
 	return &qbft.Config{
-		Signer:    testingutils.NewTestingKeyManager(),
-		SigningPK: keySet.Shares[1].GetPublicKey().Serialize(),
-		Domain:    testingutils.TestingSSVDomainType,
+		Signer:            testingutils.NewTestingKeyManager(),
+		SigningPK:         keySet.Shares[1].GetPublicKey().Serialize(),
+		Domain:            testingutils.TestingSSVDomainType,
+		SignatureVerifier: TestingSignatureVerifier(),
 		ValueCheckF: func(data []byte) error {
 			if bytes.Equal(data, TestingInvalidValueCheck) {
 				return errors.New("invalid value")
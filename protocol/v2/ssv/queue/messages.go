@@ -1,6 +1,8 @@
 package queue
 
 import (
+	"context"
+
 	specqbft "github.com/bloxapp/ssv-spec/qbft"
 	spectypes "github.com/bloxapp/ssv-spec/types"
 	"github.com/pkg/errors"
@@ -16,6 +18,9 @@ type DecodedSSVMessage struct {
 
 	// Body is the decoded Data.
 	Body interface{} // *SignedMessage | *SignedPartialSignatureMessage
+
+	// TraceContext carries the span of the duty of ExecuteDuty messages, if any.
+	TraceContext context.Context
 }
 
 // DecodeSSVMessage decodes an SSVMessage and returns a DecodedSSVMessage.
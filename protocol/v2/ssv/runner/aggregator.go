@@ -73,6 +73,7 @@ func (r *AggregatorRunner) ProcessPreConsensus(logger *zap.Logger, signedMsg *sp
 	if !quorum {
 		return nil
 	}
+	defer r.BaseRunner.endDutyUnlessDeciding()
 
 	r.metrics.EndPreConsensus()
 
@@ -91,6 +92,12 @@ func (r *AggregatorRunner) ProcessPreConsensus(logger *zap.Logger, signedMsg *sp
 		fields.Slot(duty.Slot),
 	)
 
+	return r.decideAggregateAndProof(logger, duty, fullSig)
+}
+
+// decideAggregateAndProof fetches the aggregate and proof of the duty with its reconstructed selection proof,
+// and starts consensus on it.
+func (r *AggregatorRunner) decideAggregateAndProof(logger *zap.Logger, duty *spectypes.Duty, fullSig []byte) error {
 	r.metrics.PauseDutyFullFlow()
 
 	// get block data
@@ -180,6 +187,7 @@ func (r *AggregatorRunner) ProcessPostConsensus(logger *zap.Logger, signedMsg *s
 	if !quorum {
 		return nil
 	}
+	defer r.BaseRunner.dutyEnded()
 
 	r.metrics.EndPostConsensus()
 
@@ -201,26 +209,35 @@ func (r *AggregatorRunner) ProcessPostConsensus(logger *zap.Logger, signedMsg *s
 			Signature: specSig,
 		}
 
+		r.BaseRunner.trace.startPhase(phaseSubmission)
 		proofSubmissionEnd := r.metrics.StartBeaconSubmission()
 
 		if err := r.GetBeaconNode().SubmitSignedAggregateSelectionProof(msg); err != nil {
 			r.metrics.RoleSubmissionFailed()
+			r.BaseRunner.dutyFinished(err)
 			return errors.Wrap(err, "could not submit to Beacon chain reconstructed signed aggregate")
 		}
 
 		proofSubmissionEnd()
 		r.metrics.EndDutyFullFlow(r.GetState().RunningInstance.State.Round)
 		r.metrics.RoleSubmitted()
+		r.BaseRunner.dutyFinished(nil)
 
 		logger.Debug("✅ successful submitted aggregate")
 	}
 	r.GetState().Finished = true
+	r.BaseRunner.endTrace()
 
 	return nil
 }
 
 func (r *AggregatorRunner) expectedPreConsensusRootsAndDomain() ([]ssz.HashRoot, phase0.DomainType, error) {
-	return []ssz.HashRoot{spectypes.SSZUint64(r.GetState().StartingDuty.Slot)}, spectypes.DomainSelectionProof, nil
+	return r.selectionProofRootsAndDomain(r.GetState().StartingDuty)
+}
+
+// selectionProofRootsAndDomain returns the roots of the selection proof of the duty
+func (r *AggregatorRunner) selectionProofRootsAndDomain(duty *spectypes.Duty) ([]ssz.HashRoot, phase0.DomainType, error) {
+	return []ssz.HashRoot{spectypes.SSZUint64(duty.Slot)}, spectypes.DomainSelectionProof, nil
 }
 
 // expectedPostConsensusRootsAndDomain an INTERNAL function, returns the expected post-consensus roots to sign
@@ -239,10 +256,34 @@ func (r *AggregatorRunner) expectedPostConsensusRootsAndDomain() ([]ssz.HashRoot
 // 3) start consensus on duty + aggregation data
 // 4) Once consensus decides, sign partial aggregation data and broadcast
 // 5) collect 2f+1 partial sigs, reconstruct and broadcast valid SignedAggregateSubmitRequest sig to the BN
+// Steps 1 and 2 are skipped if the selection proof was reconstructed ahead of the duty.
 func (r *AggregatorRunner) executeDuty(logger *zap.Logger, duty *spectypes.Duty) error {
 	r.metrics.StartDutyFullFlow()
+
+	if proofs, ok := r.BaseRunner.cachedSelectionProofs(duty); ok {
+		defer r.BaseRunner.endDutyUnlessDeciding()
+		logger.Debug("🧩 using selection proof reconstructed ahead of the duty", fields.Slot(duty.Slot))
+		return r.decideAggregateAndProof(logger, duty, proofs[0])
+	}
+
 	r.metrics.StartPreConsensus()
 
+	return r.BroadcastSelectionProofs(duty)
+}
+
+// ReconstructSelectionProofs reconstructs the selection proof of the duty from the partial selection proofs
+// which were received ahead of it, and returns true once it's cached.
+func (r *AggregatorRunner) ReconstructSelectionProofs(duty *spectypes.Duty, msgs []*spectypes.SignedPartialSignatureMessage) (bool, error) {
+	roots, domain, err := r.selectionProofRootsAndDomain(duty)
+	if err != nil {
+		return false, err
+	}
+	return r.BaseRunner.reconstructSelectionProofs(r, duty, roots, domain, msgs)
+}
+
+// BroadcastSelectionProofs signs and broadcasts the partial selection proof of the duty,
+// either when the duty starts or ahead of its slot.
+func (r *AggregatorRunner) BroadcastSelectionProofs(duty *spectypes.Duty) error {
 	// sign selection proof
 	msg, err := r.BaseRunner.signBeaconObject(r, spectypes.SSZUint64(duty.Slot), duty.Slot, spectypes.DomainSelectionProof)
 	if err != nil {
@@ -139,6 +139,7 @@ func (r *AttesterRunner) ProcessPostConsensus(logger *zap.Logger, signedMsg *spe
 	if !quorum {
 		return nil
 	}
+	defer r.BaseRunner.dutyEnded()
 
 	r.metrics.EndPostConsensus()
 
@@ -166,11 +167,13 @@ func (r *AttesterRunner) ProcessPostConsensus(logger *zap.Logger, signedMsg *spe
 			AggregationBits: aggregationBitfield,
 		}
 
+		r.BaseRunner.trace.startPhase(phaseSubmission)
 		attestationSubmissionEnd := r.metrics.StartBeaconSubmission()
 
 		// Submit it to the BN.
 		if err := r.beacon.SubmitAttestation(signedAtt); err != nil {
 			r.metrics.RoleSubmissionFailed()
+			r.BaseRunner.dutyFinished(err)
 			logger.Error("❌ failed to submit attestation", zap.Error(err))
 			return errors.Wrap(err, "could not submit to Beacon chain reconstructed attestation")
 		}
@@ -178,6 +181,7 @@ func (r *AttesterRunner) ProcessPostConsensus(logger *zap.Logger, signedMsg *spe
 		attestationSubmissionEnd()
 		r.metrics.EndDutyFullFlow(r.GetState().RunningInstance.State.Round)
 		r.metrics.RoleSubmitted()
+		r.BaseRunner.dutyFinished(nil)
 
 		logger.Info("✅ successfully submitted attestation",
 			zap.String("block_root", hex.EncodeToString(signedAtt.Data.BeaconBlockRoot[:])),
@@ -186,6 +190,7 @@ func (r *AttesterRunner) ProcessPostConsensus(logger *zap.Logger, signedMsg *spe
 			fields.Round(r.GetState().RunningInstance.State.Round))
 	}
 	r.GetState().Finished = true
+	r.BaseRunner.endTrace()
 
 	return nil
 }
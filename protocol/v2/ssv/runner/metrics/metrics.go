@@ -58,6 +58,20 @@ var (
 		Name: "ssv_validator_roles_failed",
 		Help: "Submitted roles",
 	}, []string{"role"})
+	metricsBlockProposals = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ssv_validator_block_proposals",
+		Help: "Submitted block proposals by the source of the decided block (builder, local or local_fallback)",
+	}, []string{"source"})
+)
+
+// Sources of proposed blocks.
+const (
+	// BlockSourceBuilder is a blinded block from the builder.
+	BlockSourceBuilder = "builder"
+	// BlockSourceLocal is a block built locally by the beacon node, when builder proposals are disabled.
+	BlockSourceLocal = "local"
+	// BlockSourceLocalFallback is a block built locally by the beacon node, after the builder failed.
+	BlockSourceLocalFallback = "local_fallback"
 )
 
 func init() {
@@ -69,6 +83,7 @@ func init() {
 		metricsDutyFullFlowDuration,
 		metricsRolesSubmitted,
 		metricsRolesSubmissionFailures,
+		metricsBlockProposals,
 	}
 	logger := zap.L()
 	for _, metric := range metricsList {
@@ -217,3 +232,8 @@ func (cm *ConsensusMetrics) RoleSubmissionFailed() {
 		cm.rolesSubmissionFailures.Inc()
 	}
 }
+
+// BlockProposed counts a submitted block proposal by the source of the decided block.
+func BlockProposed(source string) {
+	metricsBlockProposals.WithLabelValues(source).Inc()
+}
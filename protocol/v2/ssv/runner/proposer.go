@@ -88,6 +88,7 @@ func (r *ProposerRunner) ProcessPreConsensus(logger *zap.Logger, signedMsg *spec
 	if !quorum {
 		return nil
 	}
+	defer r.BaseRunner.endDutyUnlessDeciding()
 
 	r.metrics.EndPreConsensus()
 
@@ -106,22 +107,10 @@ func (r *ProposerRunner) ProcessPreConsensus(logger *zap.Logger, signedMsg *spec
 	var obj ssz.Marshaler
 	var start = time.Now()
 	if r.ProducesBlindedBlocks {
-		// get block data
-		obj, ver, err = r.GetBeaconNode().GetBlindedBeaconBlock(duty.Slot, r.GetShare().Graffiti, fullSig)
+		// get block data, either blinded from the builder or a standard block if the builder failed
+		obj, ver, err = r.getBlindedOrStandardBlock(logger, duty.Slot, fullSig)
 		if err != nil {
-			// Prysm workaround: when Prysm can't retrieve an MEV block, it responds with an error
-			// saying the block isn't blinded, implying to request a standard block instead.
-			// https://github.com/prysmaticlabs/prysm/issues/12103
-			if nodeClientProvider, ok := r.GetBeaconNode().(goclient.NodeClientProvider); ok &&
-				nodeClientProvider.NodeClient() == goclient.NodePrysm {
-				logger.Debug("failed to get blinded beacon block, falling back to standard block")
-				obj, ver, err = r.GetBeaconNode().GetBeaconBlock(duty.Slot, r.GetShare().Graffiti, fullSig)
-				if err != nil {
-					return errors.Wrap(err, "failed falling back from blinded to standard beacon block")
-				}
-			} else {
-				return errors.Wrap(err, "failed to get blinded beacon block")
-			}
+			return err
 		}
 	} else {
 		// get block data
@@ -227,6 +216,7 @@ func (r *ProposerRunner) ProcessPostConsensus(logger *zap.Logger, signedMsg *spe
 	if !quorum {
 		return nil
 	}
+	defer r.BaseRunner.dutyEnded()
 
 	r.metrics.EndPostConsensus()
 
@@ -238,6 +228,7 @@ func (r *ProposerRunner) ProcessPostConsensus(logger *zap.Logger, signedMsg *spe
 		specSig := phase0.BLSSignature{}
 		copy(specSig[:], sig)
 
+		r.BaseRunner.trace.startPhase(phaseSubmission)
 		blockSubmissionEnd := r.metrics.StartBeaconSubmission()
 
 		start := time.Now()
@@ -251,6 +242,7 @@ func (r *ProposerRunner) ProcessPostConsensus(logger *zap.Logger, signedMsg *spe
 
 			if err := r.GetBeaconNode().SubmitBlindedBeaconBlock(vBlindedBlk, specSig); err != nil {
 				r.metrics.RoleSubmissionFailed()
+				r.BaseRunner.dutyFinished(err)
 
 				return errors.Wrap(err, "could not submit to Beacon chain reconstructed signed blinded Beacon block")
 			}
@@ -263,6 +255,7 @@ func (r *ProposerRunner) ProcessPostConsensus(logger *zap.Logger, signedMsg *spe
 
 			if err := r.GetBeaconNode().SubmitBeaconBlock(vBlk, specSig); err != nil {
 				r.metrics.RoleSubmissionFailed()
+				r.BaseRunner.dutyFinished(err)
 
 				return errors.Wrap(err, "could not submit to Beacon chain reconstructed signed Beacon block")
 			}
@@ -271,6 +264,8 @@ func (r *ProposerRunner) ProcessPostConsensus(logger *zap.Logger, signedMsg *spe
 		blockSubmissionEnd()
 		r.metrics.EndDutyFullFlow(r.GetState().RunningInstance.State.Round)
 		r.metrics.RoleSubmitted()
+		metrics.BlockProposed(r.decidedBlockSource())
+		r.BaseRunner.dutyFinished(nil)
 
 		blockSummary, summarizeErr := summarizeBlock(blk)
 		logger.Info("✅ successfully submitted block proposal",
@@ -283,9 +278,47 @@ func (r *ProposerRunner) ProcessPostConsensus(logger *zap.Logger, signedMsg *spe
 			zap.NamedError("summarize_err", summarizeErr))
 	}
 	r.GetState().Finished = true
+	r.BaseRunner.endTrace()
 	return nil
 }
 
+// getBlindedOrStandardBlock requests a blinded block from the builder and a standard block at the same time,
+// and prefers the blinded block unless the builder fails or misses its deadline (which the beacon node client enforces),
+// in which case the standard block is returned without having to request it only then.
+// The committee reaches consensus on either type, as the value check accepts both blinded and standard blocks.
+func (r *ProposerRunner) getBlindedOrStandardBlock(logger *zap.Logger, slot phase0.Slot, randao []byte) (ssz.Marshaler, spec.DataVersion, error) {
+	type blockResult struct {
+		obj ssz.Marshaler
+		ver spec.DataVersion
+		err error
+	}
+	blindedResult := make(chan blockResult, 1)
+	standardResult := make(chan blockResult, 1)
+	go func() {
+		obj, ver, err := r.GetBeaconNode().GetBlindedBeaconBlock(slot, r.GetShare().Graffiti, randao)
+		blindedResult <- blockResult{obj, ver, err}
+	}()
+	go func() {
+		obj, ver, err := r.GetBeaconNode().GetBeaconBlock(slot, r.GetShare().Graffiti, randao)
+		standardResult <- blockResult{obj, ver, err}
+	}()
+
+	blinded := <-blindedResult
+	if blinded.err == nil {
+		return blinded.obj, blinded.ver, nil
+	}
+	// Prysm also responds with an error when it can't retrieve an MEV block,
+	// saying the block isn't blinded, implying to request a standard block instead.
+	// https://github.com/prysmaticlabs/prysm/issues/12103
+	logger.Warn("failed to get blinded beacon block, falling back to standard block", zap.Error(blinded.err))
+
+	standard := <-standardResult
+	if standard.err != nil {
+		return nil, goclient.DataVersionNil, errors.Wrapf(standard.err, "failed to get standard beacon block after blinded beacon block failed (%v)", blinded.err)
+	}
+	return standard.obj, standard.ver, nil
+}
+
 // decidedBlindedBlock returns true if decided value has a blinded block, false if regular block
 // WARNING!! should be called after decided only
 func (r *ProposerRunner) decidedBlindedBlock() bool {
@@ -293,6 +326,19 @@ func (r *ProposerRunner) decidedBlindedBlock() bool {
 	return err == nil
 }
 
+// decidedBlockSource returns the source of the decided block, a regular block is a fallback
+// if builder proposals are enabled. WARNING!! should be called after decided only
+func (r *ProposerRunner) decidedBlockSource() string {
+	switch {
+	case r.decidedBlindedBlock():
+		return metrics.BlockSourceBuilder
+	case r.ProducesBlindedBlocks:
+		return metrics.BlockSourceLocalFallback
+	default:
+		return metrics.BlockSourceLocal
+	}
+}
+
 func (r *ProposerRunner) expectedPreConsensusRootsAndDomain() ([]ssz.HashRoot, phase0.DomainType, error) {
 	epoch := r.BaseRunner.BeaconNetwork.EstimatedEpochAtSlot(r.GetState().StartingDuty.Slot)
 	return []ssz.HashRoot{spectypes.SSZUint64(epoch)}, spectypes.DomainRandao, nil
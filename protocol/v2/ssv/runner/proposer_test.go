@@ -0,0 +1,71 @@
+package runner
+
+import (
+	"testing"
+
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	spectypes "github.com/bloxapp/ssv-spec/types"
+	"github.com/bloxapp/ssv-spec/types/testingutils"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// builderBeaconNode is a testing beacon node whose builder and local block production can fail.
+type builderBeaconNode struct {
+	*testingutils.TestingBeaconNode
+	builderErr error
+	localErr   error
+}
+
+func (bn *builderBeaconNode) GetBlindedBeaconBlock(slot phase0.Slot, graffiti, randao []byte) (ssz.Marshaler, spec.DataVersion, error) {
+	if bn.builderErr != nil {
+		return nil, 0, bn.builderErr
+	}
+	return bn.TestingBeaconNode.GetBlindedBeaconBlock(slot, graffiti, randao)
+}
+
+func (bn *builderBeaconNode) GetBeaconBlock(slot phase0.Slot, graffiti, randao []byte) (ssz.Marshaler, spec.DataVersion, error) {
+	if bn.localErr != nil {
+		return nil, 0, bn.localErr
+	}
+	return bn.TestingBeaconNode.GetBeaconBlock(slot, graffiti, randao)
+}
+
+func TestProposerRunner_GetBlindedOrStandardBlock(t *testing.T) {
+	slot := phase0.Slot(testingutils.TestingDutySlotCapella)
+	newRunner := func(bn *builderBeaconNode) *ProposerRunner {
+		bn.TestingBeaconNode = testingutils.NewTestingBeaconNode()
+		return &ProposerRunner{
+			BaseRunner: &BaseRunner{Share: &spectypes.Share{}},
+			beacon:     bn,
+		}
+	}
+
+	t.Run("builder", func(t *testing.T) {
+		r := newRunner(&builderBeaconNode{localErr: errors.New("local failed")})
+		obj, ver, err := r.getBlindedOrStandardBlock(zap.NewNop(), slot, nil)
+		require.NoError(t, err)
+		require.Equal(t, spec.DataVersionCapella, ver)
+		require.IsType(t, &apiv1capella.BlindedBeaconBlock{}, obj)
+	})
+
+	t.Run("fallback", func(t *testing.T) {
+		r := newRunner(&builderBeaconNode{builderErr: errors.New("relay is down")})
+		obj, ver, err := r.getBlindedOrStandardBlock(zap.NewNop(), slot, nil)
+		require.NoError(t, err)
+		require.Equal(t, spec.DataVersionCapella, ver)
+		require.IsType(t, &capella.BeaconBlock{}, obj)
+	})
+
+	t.Run("both fail", func(t *testing.T) {
+		r := newRunner(&builderBeaconNode{builderErr: errors.New("relay is down"), localErr: errors.New("local failed")})
+		_, _, err := r.getBlindedOrStandardBlock(zap.NewNop(), slot, nil)
+		require.ErrorContains(t, err, "local failed")
+		require.ErrorContains(t, err, "relay is down")
+	})
+}
@@ -1,6 +1,7 @@
 package runner
 
 import (
+	"context"
 	"sync"
 
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
@@ -12,6 +13,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/bloxapp/ssv/protocol/v2/qbft/controller"
+	"github.com/bloxapp/ssv/protocol/v2/types"
 )
 
 type Getters interface {
@@ -45,6 +47,17 @@ type Runner interface {
 	executeDuty(logger *zap.Logger, duty *spectypes.Duty) error
 }
 
+// SelectionProofsRunner is a runner whose pre-consensus partial signatures are the selection proofs of its duty,
+// which don't depend on the beacon chain and can therefore be broadcast and reconstructed ahead of the duty's slot.
+type SelectionProofsRunner interface {
+	Runner
+	// BroadcastSelectionProofs signs and broadcasts the partial selection proofs of the duty.
+	BroadcastSelectionProofs(duty *spectypes.Duty) error
+	// ReconstructSelectionProofs reconstructs the selection proofs of the duty from the partial selection proofs
+	// which were received ahead of it, and returns true once they are cached.
+	ReconstructSelectionProofs(duty *spectypes.Duty, msgs []*spectypes.SignedPartialSignatureMessage) (bool, error)
+}
+
 type BaseRunner struct {
 	mtx            sync.RWMutex
 	State          *State
@@ -55,9 +68,54 @@ type BaseRunner struct {
 
 	// implementation vars
 	TimeoutF TimeoutF `json:"-"`
+	// DutyFinishedF is called with the result of the submission of each duty to the beacon node, if set.
+	DutyFinishedF DutyFinishedF `json:"-"`
+	// DutyEndedF is called once the running duty ends, whether it was submitted or not, if set.
+	DutyEndedF DutyEndedF `json:"-"`
+	// SelectionProofs caches the selection proofs which were reconstructed ahead of their duty, if set.
+	SelectionProofs *SelectionProofs `json:"-"`
+	// SignatureVerifier verifies the signatures of partial signature messages.
+	SignatureVerifier *types.SignatureVerifier `json:"-"`
 
 	// highestDecidedSlot holds the highest decided duty slot and gets updated after each decided is reached
 	highestDecidedSlot spec.Slot
+
+	// preConsensusSkipped is true if the running duty started consensus with cached selection proofs
+	preConsensusSkipped bool
+
+	// trace holds the spans of the running duty, nextDutyCtx is the context of the next duty.
+	trace       *dutyTrace
+	nextDutyCtx context.Context
+}
+
+// DutyFinishedF is called with the result of a duty, err is nil if the duty was submitted successfully.
+type DutyFinishedF func(role spectypes.BeaconRole, err error)
+
+func (b *BaseRunner) dutyFinished(err error) {
+	b.trace.recordError(err)
+	if b.DutyFinishedF != nil {
+		b.DutyFinishedF(b.BeaconRoleType, err)
+	}
+}
+
+// DutyEndedF is called once the running duty of the role can't make any more progress.
+type DutyEndedF func(role spectypes.BeaconRole)
+
+// dutyEnded is called once the post-consensus of the running duty has a quorum,
+// since the duty is either submitted or failed by then.
+func (b *BaseRunner) dutyEnded() {
+	if b.DutyEndedF != nil {
+		b.DutyEndedF(b.BeaconRoleType)
+	}
+}
+
+// endDutyUnlessDeciding is called once the pre-consensus of the running duty has a quorum,
+// and ends the duty unless it started consensus, e.g. if the validator wasn't selected as an aggregator
+// or the beacon node failed.
+func (b *BaseRunner) endDutyUnlessDeciding() {
+	if b.State == nil || b.State.RunningInstance == nil {
+		b.dutyEnded()
+	}
 }
 
 // SetHighestDecidedSlot set highestDecidedSlot for base runner
@@ -74,6 +132,9 @@ func (b *BaseRunner) baseSetupForNewDuty(duty *spectypes.Duty) {
 	b.mtx.Lock() // writes to b.State
 	b.State = state
 	b.mtx.Unlock()
+	b.preConsensusSkipped = false
+
+	b.startTrace(duty)
 }
 
 func NewBaseRunner(
@@ -102,6 +163,11 @@ func (b *BaseRunner) baseStartNewDuty(logger *zap.Logger, runner Runner, duty *s
 
 // basePreConsensusMsgProcessing is a base func that all runner implementation can call for processing a pre-consensus msg
 func (b *BaseRunner) basePreConsensusMsgProcessing(runner Runner, signedMsg *spectypes.SignedPartialSignatureMessage) (bool, [][32]byte, error) {
+	if b.preConsensusSkipped {
+		// the running duty already started consensus with cached selection proofs
+		return false, nil, nil
+	}
+
 	if err := b.ValidatePreConsensusMsg(runner, signedMsg); err != nil {
 		return false, nil, errors.Wrap(err, "invalid pre-consensus message")
 	}
@@ -135,6 +201,7 @@ func (b *BaseRunner) baseConsensusMsgProcessing(logger *zap.Logger, runner Runne
 	if !b.hasRunningDuty() {
 		return false, nil, nil
 	}
+	b.TraceRound()
 
 	if decideCorrectly, err := b.didDecideCorrectly(prevDecided, decidedMsg); !decideCorrectly {
 		return false, nil, err
@@ -167,6 +234,7 @@ func (b *BaseRunner) baseConsensusMsgProcessing(logger *zap.Logger, runner Runne
 	}
 
 	runner.GetBaseRunner().State.DecidedValue = decidedValue
+	b.trace.startPhase(phasePostConsensus)
 
 	return true, decidedValue, nil
 }
@@ -249,6 +317,8 @@ func (b *BaseRunner) decide(logger *zap.Logger, runner Runner, input *spectypes.
 	}
 
 	runner.GetBaseRunner().State.RunningInstance = newInstance
+	b.trace.startPhase(phaseConsensus)
+	b.TraceRound()
 
 	b.registerTimeoutHandler(logger, newInstance, runner.GetBaseRunner().QBFTController.Height)
 
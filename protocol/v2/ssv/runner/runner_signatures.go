@@ -2,10 +2,10 @@ package runner
 
 import (
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	specqbft "github.com/bloxapp/ssv-spec/qbft"
 	spectypes "github.com/bloxapp/ssv-spec/types"
 	"github.com/bloxapp/ssv/protocol/v2/types"
 	ssz "github.com/ferranbt/fastssz"
-	"github.com/herumi/bls-eth-go-binary/bls"
 	"github.com/pkg/errors"
 )
 
@@ -57,12 +57,36 @@ func (b *BaseRunner) validatePartialSigMsgForSlot(
 		return errors.New("invalid partial sig slot")
 	}
 
-	if err := types.VerifyByOperators(signedMsg.GetSignature(), signedMsg, b.Share.DomainType, spectypes.PartialSignatureType, b.Share.Committee); err != nil {
+	// The signature of the message and its partial signatures are verified together,
+	// but their errors are returned in the order they would be checked one by one.
+	operatorSignedRoot, err := b.operatorSignedRoot(signedMsg, slot)
+	if err != nil {
 		return errors.Wrap(err, "failed to verify PartialSignature")
 	}
+	signedRoots := []types.SignedRoot{operatorSignedRoot}
+	lookupErrs := make([]error, len(signedMsg.Message.Messages))
+	for i, msg := range signedMsg.Message.Messages {
+		pk, err := b.signerPublicKey(msg.Signer)
+		if err != nil {
+			lookupErrs[i] = err
+			continue
+		}
+		signedRoots = append(signedRoots, types.SignedRoot{Signature: msg.PartialSignature, Root: msg.SigningRoot, PublicKeys: [][]byte{pk}})
+	}
 
-	for _, msg := range signedMsg.Message.Messages {
-		if err := b.verifyBeaconPartialSignature(msg); err != nil {
+	errs := b.SignatureVerifier.Verify(slot, signedRoots...)
+	if errs[0] != nil {
+		return errors.Wrap(errs[0], "failed to verify PartialSignature")
+	}
+	errs = errs[1:]
+	for _, err := range lookupErrs {
+		if err == nil {
+			err, errs = errs[0], errs[1:]
+			if errors.Is(err, types.ErrInvalidSignature) {
+				err = errors.New("wrong signature")
+			}
+		}
+		if err != nil {
 			return errors.Wrap(err, "could not verify Beacon partial Signature")
 		}
 	}
@@ -70,28 +94,34 @@ func (b *BaseRunner) validatePartialSigMsgForSlot(
 	return nil
 }
 
-func (b *BaseRunner) verifyBeaconPartialSignature(msg *spectypes.PartialSignatureMessage) error {
-	signer := msg.Signer
-	signature := msg.PartialSignature
-	root := msg.SigningRoot
+// operatorSignedRoot returns the signature of the message by its signer.
+func (b *BaseRunner) operatorSignedRoot(signedMsg *spectypes.SignedPartialSignatureMessage, slot spec.Slot) (types.SignedRoot, error) {
+	pk, err := b.signerPublicKey(signedMsg.Signer)
+	if err != nil {
+		return types.SignedRoot{}, err
+	}
+	domain := spectypes.ComputeSignatureDomain(b.domainAtSlot(slot), spectypes.PartialSignatureType)
+	root, err := spectypes.ComputeSigningRoot(signedMsg, domain)
+	if err != nil {
+		return types.SignedRoot{}, errors.Wrap(err, "could not compute signing root")
+	}
+	return types.SignedRoot{Signature: signedMsg.GetSignature(), Root: root, PublicKeys: [][]byte{pk}}, nil
+}
 
+// signerPublicKey returns the public key of the committee member.
+func (b *BaseRunner) signerPublicKey(signer spectypes.OperatorID) ([]byte, error) {
 	for _, n := range b.Share.Committee {
 		if n.GetID() == signer {
-			pk, err := types.DeserializeBLSPublicKey(n.GetPublicKey())
-			if err != nil {
-				return errors.Wrap(err, "could not deserialized pk")
-			}
-			sig := &bls.Sign{}
-			if err := sig.Deserialize(signature); err != nil {
-				return errors.Wrap(err, "could not deserialized Signature")
-			}
-
-			// verify
-			if !sig.VerifyByte(&pk, root[:]) {
-				return errors.New("wrong signature")
-			}
-			return nil
+			return n.GetPublicKey(), nil
 		}
 	}
-	return errors.New("unknown signer")
+	return nil, errors.New("unknown signer")
+}
+
+// domainAtSlot returns the domain type that is active at the given slot
+func (b *BaseRunner) domainAtSlot(slot spec.Slot) spectypes.DomainType {
+	if b.QBFTController == nil {
+		return b.Share.DomainType
+	}
+	return b.QBFTController.GetConfig().GetSignatureDomainTypeAtHeight(specqbft.Height(slot))
 }
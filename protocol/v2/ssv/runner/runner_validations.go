@@ -24,7 +24,7 @@ func (b *BaseRunner) ValidatePreConsensusMsg(runner Runner, signedMsg *spectypes
 		return err
 	}
 
-	return b.verifyExpectedRoot(runner, signedMsg, roots, domain)
+	return b.verifyExpectedRoot(runner, signedMsg, roots, domain, b.State.StartingDuty.Slot)
 }
 
 func (b *BaseRunner) ValidatePostConsensusMsg(runner Runner, signedMsg *spectypes.SignedPartialSignatureMessage) error {
@@ -59,7 +59,7 @@ func (b *BaseRunner) ValidatePostConsensusMsg(runner Runner, signedMsg *spectype
 		return err
 	}
 
-	return b.verifyExpectedRoot(runner, signedMsg, roots, domain)
+	return b.verifyExpectedRoot(runner, signedMsg, roots, domain, b.State.StartingDuty.Slot)
 }
 
 func (b *BaseRunner) validateDecidedConsensusData(runner Runner, val *spectypes.ConsensusData) error {
@@ -74,36 +74,19 @@ func (b *BaseRunner) validateDecidedConsensusData(runner Runner, val *spectypes.
 	return nil
 }
 
-func (b *BaseRunner) verifyExpectedRoot(runner Runner, signedMsg *spectypes.SignedPartialSignatureMessage, expectedRootObjs []ssz.HashRoot, domain spec.DomainType) error {
+func (b *BaseRunner) verifyExpectedRoot(runner Runner, signedMsg *spectypes.SignedPartialSignatureMessage, expectedRootObjs []ssz.HashRoot, domain spec.DomainType, slot spec.Slot) error {
 	if len(expectedRootObjs) != len(signedMsg.Message.Messages) {
 		return errors.New("wrong expected roots count")
 	}
 
 	// convert expected roots to map and mark unique roots when verified
-	sortedExpectedRoots, err := func(expectedRootObjs []ssz.HashRoot) ([][32]byte, error) {
-		epoch := b.BeaconNetwork.EstimatedEpochAtSlot(b.State.StartingDuty.Slot)
-		d, err := runner.GetBeaconNode().DomainData(epoch, domain)
-		if err != nil {
-			return nil, errors.Wrap(err, "could not get pre consensus root domain")
-		}
-
-		ret := make([][32]byte, 0)
-		for _, rootI := range expectedRootObjs {
-			r, err := spectypes.ComputeETHSigningRoot(rootI, d)
-			if err != nil {
-				return nil, errors.Wrap(err, "could not compute ETH signing root")
-			}
-			ret = append(ret, r)
-		}
-
-		sort.Slice(ret, func(i, j int) bool {
-			return string(ret[i][:]) < string(ret[j][:])
-		})
-		return ret, nil
-	}(expectedRootObjs)
+	sortedExpectedRoots, err := b.expectedSigningRoots(runner, expectedRootObjs, domain, slot)
 	if err != nil {
 		return err
 	}
+	sort.Slice(sortedExpectedRoots, func(i, j int) bool {
+		return string(sortedExpectedRoots[i][:]) < string(sortedExpectedRoots[j][:])
+	})
 
 	sortedRoots := func(msgs spectypes.PartialSignatureMessages) [][32]byte {
 		ret := make([][32]byte, 0)
@@ -125,3 +108,22 @@ func (b *BaseRunner) verifyExpectedRoot(runner Runner, signedMsg *spectypes.Sign
 	}
 	return nil
 }
+
+// expectedSigningRoots returns the signing roots of the expected root objects, in their order
+func (b *BaseRunner) expectedSigningRoots(runner Runner, expectedRootObjs []ssz.HashRoot, domain spec.DomainType, slot spec.Slot) ([][32]byte, error) {
+	epoch := b.BeaconNetwork.EstimatedEpochAtSlot(slot)
+	d, err := runner.GetBeaconNode().DomainData(epoch, domain)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get pre consensus root domain")
+	}
+
+	ret := make([][32]byte, 0)
+	for _, rootI := range expectedRootObjs {
+		r, err := spectypes.ComputeETHSigningRoot(rootI, d)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute ETH signing root")
+		}
+		ret = append(ret, r)
+	}
+	return ret, nil
+}
@@ -0,0 +1,133 @@
+package runner
+
+import (
+	"sync"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	specssv "github.com/bloxapp/ssv-spec/ssv"
+	spectypes "github.com/bloxapp/ssv-spec/types"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+
+	"github.com/bloxapp/ssv/protocol/v2/types"
+)
+
+type selectionProofsKey struct {
+	validator string
+	slot      spec.Slot
+	role      spectypes.BeaconRole
+}
+
+// SelectionProofs caches the selection proofs which were reconstructed ahead of their duty,
+// so that its runner can skip pre-consensus and go straight to consensus.
+type SelectionProofs struct {
+	mu     sync.Mutex
+	proofs map[selectionProofsKey][][]byte
+}
+
+func NewSelectionProofs() *SelectionProofs {
+	return &SelectionProofs{
+		proofs: make(map[selectionProofsKey][][]byte),
+	}
+}
+
+// Set caches the selection proofs of the validator's duty, ordered as the roots of its pre-consensus.
+func (p *SelectionProofs) Set(validatorPK []byte, slot spec.Slot, role spectypes.BeaconRole, proofs [][]byte) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.proofs[selectionProofsKey{validator: string(validatorPK), slot: slot, role: role}] = proofs
+}
+
+// Has returns true if the selection proofs of the validator's duty are cached.
+func (p *SelectionProofs) Has(validatorPK []byte, slot spec.Slot, role spectypes.BeaconRole) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	_, ok := p.proofs[selectionProofsKey{validator: string(validatorPK), slot: slot, role: role}]
+	return ok
+}
+
+// Pop removes and returns the cached selection proofs of the validator's duty.
+func (p *SelectionProofs) Pop(validatorPK []byte, slot spec.Slot, role spectypes.BeaconRole) ([][]byte, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := selectionProofsKey{validator: string(validatorPK), slot: slot, role: role}
+	proofs, ok := p.proofs[key]
+	delete(p.proofs, key)
+	return proofs, ok
+}
+
+// Purge drops the selection proofs of the slots before the given slot.
+func (p *SelectionProofs) Purge(slot spec.Slot) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for key := range p.proofs {
+		if key.slot < slot {
+			delete(p.proofs, key)
+		}
+	}
+}
+
+// cachedSelectionProofs returns the selection proofs of the duty if they were reconstructed ahead of it,
+// in which case the pre-consensus of the duty is skipped.
+func (b *BaseRunner) cachedSelectionProofs(duty *spectypes.Duty) ([][]byte, bool) {
+	if b.SelectionProofs == nil {
+		return nil, false
+	}
+	proofs, ok := b.SelectionProofs.Pop(b.Share.ValidatorPubKey, duty.Slot, b.BeaconRoleType)
+	b.preConsensusSkipped = ok
+	return proofs, ok
+}
+
+// reconstructSelectionProofs verifies the partial selection proofs which were received ahead of the duty,
+// and caches the reconstructed selection proofs once all the expected roots have a quorum.
+func (b *BaseRunner) reconstructSelectionProofs(
+	runner Runner,
+	duty *spectypes.Duty,
+	expectedRootObjs []ssz.HashRoot,
+	domain spec.DomainType,
+	msgs []*spectypes.SignedPartialSignatureMessage,
+) (bool, error) {
+	if b.SelectionProofs == nil {
+		return false, errors.New("no selection proofs cache")
+	}
+	if b.SelectionProofs.Has(b.Share.ValidatorPubKey, duty.Slot, b.BeaconRoleType) {
+		return true, nil
+	}
+
+	// invalid messages are skipped, so that they can't keep the valid ones from reaching a quorum
+	container := specssv.NewPartialSigContainer(b.Share.Quorum)
+	for _, msg := range msgs {
+		if err := b.validatePartialSigMsgForSlot(msg, duty.Slot); err != nil {
+			continue
+		}
+		if err := b.verifyExpectedRoot(runner, msg, expectedRootObjs, domain, duty.Slot); err != nil {
+			continue
+		}
+		if _, _, err := b.basePartialSigMsgProcessing(msg, container); err != nil {
+			return false, err
+		}
+	}
+
+	roots, err := b.expectedSigningRoots(runner, expectedRootObjs, domain, duty.Slot)
+	if err != nil {
+		return false, err
+	}
+	proofs := make([][]byte, 0, len(roots))
+	for _, root := range roots {
+		if !container.HasQuorum(root) {
+			return false, nil
+		}
+		sig, err := types.ReconstructSignature(container, root, b.Share.ValidatorPubKey)
+		if err != nil {
+			return false, errors.Wrap(err, "could not reconstruct selection proof sig")
+		}
+		proofs = append(proofs, sig)
+	}
+
+	b.SelectionProofs.Set(b.Share.ValidatorPubKey, duty.Slot, b.BeaconRoleType, proofs)
+	return true, nil
+}
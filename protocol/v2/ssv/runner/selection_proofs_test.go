@@ -0,0 +1,101 @@
+package runner
+
+import (
+	"testing"
+
+	specssv "github.com/bloxapp/ssv-spec/ssv"
+	spectypes "github.com/bloxapp/ssv-spec/types"
+	"github.com/bloxapp/ssv-spec/types/testingutils"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bloxapp/ssv/logging"
+	qbfttesting "github.com/bloxapp/ssv/protocol/v2/qbft/testing"
+)
+
+func TestSelectionProofs(t *testing.T) {
+	p := NewSelectionProofs()
+	pk1, pk2 := []byte{1}, []byte{2}
+
+	p.Set(pk1, 10, spectypes.BNRoleAggregator, [][]byte{{1}})
+	p.Set(pk2, 10, spectypes.BNRoleAggregator, [][]byte{{2}})
+	p.Set(pk1, 12, spectypes.BNRoleSyncCommitteeContribution, [][]byte{{3}, {4}})
+
+	// proofs are keyed by validator, slot and role
+	require.False(t, p.Has(pk1, 10, spectypes.BNRoleSyncCommitteeContribution))
+	proofs, ok := p.Pop(pk2, 10, spectypes.BNRoleAggregator)
+	require.True(t, ok)
+	require.Equal(t, [][]byte{{2}}, proofs)
+	_, ok = p.Pop(pk2, 10, spectypes.BNRoleAggregator)
+	require.False(t, ok)
+
+	// proofs of past slots are purged
+	p.Purge(11)
+	require.False(t, p.Has(pk1, 10, spectypes.BNRoleAggregator))
+	require.True(t, p.Has(pk1, 12, spectypes.BNRoleSyncCommitteeContribution))
+}
+
+func TestAggregatorRunner_SelectionProofsAheadOfDuty(t *testing.T) {
+	logger := logging.TestLogger(t)
+	ks := testingutils.Testing4SharesSet()
+	duty := testingutils.TestingAggregatorDuty
+
+	identifier := spectypes.NewMsgID(testingutils.TestingSSVDomainType, testingutils.TestingValidatorPubKey[:], spectypes.BNRoleAggregator)
+	config := qbfttesting.TestingConfig(logger, ks, spectypes.BNRoleAggregator)
+	config.ValueCheckF = specssv.AggregatorValueCheckF(testingutils.NewTestingKeyManager(), spectypes.BeaconTestNetwork, testingutils.TestingValidatorPubKey[:], testingutils.TestingValidatorIndex)
+	net := testingutils.NewTestingNetwork()
+	config.Network = net
+	share := testingutils.TestingShare(ks)
+
+	r := NewAggregatorRunner(
+		spectypes.BeaconTestNetwork,
+		share,
+		qbfttesting.NewTestingQBFTController(identifier[:], share, config, false),
+		testingutils.NewTestingBeaconNode(),
+		net,
+		testingutils.NewTestingKeyManager(),
+		config.ValueCheckF,
+		0,
+	).(*AggregatorRunner)
+	r.GetBaseRunner().SelectionProofs = NewSelectionProofs()
+	r.GetBaseRunner().SignatureVerifier = config.SignatureVerifier
+
+	msg := func(id spectypes.OperatorID) *spectypes.SignedPartialSignatureMessage {
+		return testingutils.PreConsensusSelectionProofMsg(ks.Shares[id], ks.Shares[id], id, id)
+	}
+	partialSigMsgs := func() int {
+		count := 0
+		for _, msg := range net.BroadcastedMsgs {
+			if msg.MsgType == spectypes.SSVPartialSignatureMsgType {
+				count++
+			}
+		}
+		return count
+	}
+	wrongSig := testingutils.PreConsensusSelectionProofMsg(ks.Shares[1], ks.Shares[2], 3, 3)
+
+	// no quorum of valid partial selection proofs
+	reconstructed, err := r.ReconstructSelectionProofs(&duty, []*spectypes.SignedPartialSignatureMessage{msg(1), msg(2), wrongSig})
+	require.NoError(t, err)
+	require.False(t, reconstructed)
+
+	reconstructed, err = r.ReconstructSelectionProofs(&duty, []*spectypes.SignedPartialSignatureMessage{msg(1), msg(2), wrongSig, msg(4)})
+	require.NoError(t, err)
+	require.True(t, reconstructed)
+
+	// the duty goes straight to consensus, without broadcasting its partial selection proof
+	require.NoError(t, r.StartNewDuty(logger, &duty))
+	require.Zero(t, partialSigMsgs())
+	require.NotNil(t, r.GetState().RunningInstance)
+	require.False(t, r.GetBaseRunner().SelectionProofs.Has(share.ValidatorPubKey, duty.Slot, spectypes.BNRoleAggregator))
+
+	// late partial selection proofs are ignored
+	for _, id := range []spectypes.OperatorID{1, 2, 3} {
+		require.NoError(t, r.ProcessPreConsensus(logger, msg(id)))
+	}
+	require.Empty(t, r.GetState().PreConsensusContainer.Signatures)
+
+	// without cached selection proofs, the duty broadcasts its partial selection proof
+	require.NoError(t, r.StartNewDuty(logger, &duty))
+	require.Equal(t, 1, partialSigMsgs())
+	require.Nil(t, r.GetState().RunningInstance)
+}
@@ -130,6 +130,7 @@ func (r *SyncCommitteeRunner) ProcessPostConsensus(logger *zap.Logger, signedMsg
 	if !quorum {
 		return nil
 	}
+	defer r.BaseRunner.dutyEnded()
 
 	r.metrics.EndPostConsensus()
 
@@ -153,16 +154,19 @@ func (r *SyncCommitteeRunner) ProcessPostConsensus(logger *zap.Logger, signedMsg
 			Signature:       specSig,
 		}
 
+		r.BaseRunner.trace.startPhase(phaseSubmission)
 		messageSubmissionEnd := r.metrics.StartBeaconSubmission()
 
 		if err := r.GetBeaconNode().SubmitSyncMessage(msg); err != nil {
 			r.metrics.RoleSubmissionFailed()
+			r.BaseRunner.dutyFinished(err)
 			return errors.Wrap(err, "could not submit to Beacon chain reconstructed signed sync committee")
 		}
 
 		messageSubmissionEnd()
 		r.metrics.EndDutyFullFlow(r.GetState().RunningInstance.State.Round)
 		r.metrics.RoleSubmitted()
+		r.BaseRunner.dutyFinished(nil)
 
 		logger.Info("✅ successfully submitted sync committee",
 			fields.Slot(msg.Slot),
@@ -171,6 +175,7 @@ func (r *SyncCommitteeRunner) ProcessPostConsensus(logger *zap.Logger, signedMsg
 			fields.Round(r.GetState().RunningInstance.State.Round))
 	}
 	r.GetState().Finished = true
+	r.BaseRunner.endTrace()
 
 	return nil
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 
+	"github.com/bloxapp/ssv/logging/fields"
 	"github.com/bloxapp/ssv/protocol/v2/qbft/controller"
 	"github.com/bloxapp/ssv/protocol/v2/ssv/runner/metrics"
 )
@@ -75,20 +76,32 @@ func (r *SyncCommitteeAggregatorRunner) ProcessPreConsensus(logger *zap.Logger,
 	if !quorum {
 		return nil
 	}
+	defer r.BaseRunner.endDutyUnlessDeciding()
 
 	r.metrics.EndPreConsensus()
 
+	// reconstruct selection proof sigs
+	sigs := make([][]byte, 0, len(roots))
+	for _, root := range roots {
+		sig, err := r.GetState().ReconstructBeaconSig(r.GetState().PreConsensusContainer, root, r.GetShare().ValidatorPubKey)
+		if err != nil {
+			return errors.Wrap(err, "could not reconstruct sync committee index root")
+		}
+		sigs = append(sigs, sig)
+	}
+
+	return r.decideContributions(logger, r.GetState().StartingDuty, sigs)
+}
+
+// decideContributions fetches the contributions of the subcommittees the validator is an aggregator of,
+// by the reconstructed selection proofs of the duty, and starts consensus on them.
+func (r *SyncCommitteeAggregatorRunner) decideContributions(logger *zap.Logger, duty *spectypes.Duty, sigs [][]byte) error {
 	// collect selection proofs and subnets
 	var (
 		selectionProofs []phase0.BLSSignature
 		subnets         []uint64
 	)
-	for i, root := range roots {
-		// reconstruct selection proof sig
-		sig, err := r.GetState().ReconstructBeaconSig(r.GetState().PreConsensusContainer, root, r.GetShare().ValidatorPubKey)
-		if err != nil {
-			return errors.Wrap(err, "could not reconstruct sync committee index root")
-		}
+	for i, sig := range sigs {
 		blsSigSelectionProof := phase0.BLSSignature{}
 		copy(blsSigSelectionProof[:], sig)
 
@@ -101,7 +114,7 @@ func (r *SyncCommitteeAggregatorRunner) ProcessPreConsensus(logger *zap.Logger,
 		}
 
 		// fetch sync committee contribution
-		subnet, err := r.GetBeaconNode().SyncCommitteeSubnetID(phase0.CommitteeIndex(r.GetState().StartingDuty.ValidatorSyncCommitteeIndices[i]))
+		subnet, err := r.GetBeaconNode().SyncCommitteeSubnetID(phase0.CommitteeIndex(duty.ValidatorSyncCommitteeIndices[i]))
 		if err != nil {
 			return errors.Wrap(err, "could not get sync committee subnet ID")
 		}
@@ -111,11 +124,10 @@ func (r *SyncCommitteeAggregatorRunner) ProcessPreConsensus(logger *zap.Logger,
 	}
 	if len(selectionProofs) == 0 {
 		r.GetState().Finished = true
+		r.BaseRunner.endTrace()
 		return nil
 	}
 
-	duty := r.GetState().StartingDuty
-
 	// fetch contributions
 	r.metrics.PauseDutyFullFlow()
 	contributions, ver, err := r.GetBeaconNode().GetSyncCommitteeContribution(duty.Slot, selectionProofs, subnets)
@@ -214,6 +226,7 @@ func (r *SyncCommitteeAggregatorRunner) ProcessPostConsensus(logger *zap.Logger,
 	if !quorum {
 		return nil
 	}
+	defer r.BaseRunner.dutyEnded()
 
 	r.metrics.EndPostConsensus()
 
@@ -252,22 +265,26 @@ func (r *SyncCommitteeAggregatorRunner) ProcessPostConsensus(logger *zap.Logger,
 				Signature: blsSignedContribAndProof,
 			}
 
+			r.BaseRunner.trace.startPhase(phaseSubmission)
 			submissionEnd := r.metrics.StartBeaconSubmission()
 
 			if err := r.GetBeaconNode().SubmitSignedContributionAndProof(signedContribAndProof); err != nil {
 				r.metrics.RoleSubmissionFailed()
+				r.BaseRunner.dutyFinished(err)
 				return errors.Wrap(err, "could not submit to Beacon chain reconstructed contribution and proof")
 			}
 
 			submissionEnd()
 			r.metrics.EndDutyFullFlow(r.GetState().RunningInstance.State.Round)
 			r.metrics.RoleSubmitted()
+			r.BaseRunner.dutyFinished(nil)
 
 			logger.Debug("✅ submitted successfully sync committee aggregator!")
 			break
 		}
 	}
 	r.GetState().Finished = true
+	r.BaseRunner.endTrace()
 	return nil
 }
 
@@ -291,14 +308,19 @@ func (r *SyncCommitteeAggregatorRunner) generateContributionAndProof(contrib alt
 }
 
 func (r *SyncCommitteeAggregatorRunner) expectedPreConsensusRootsAndDomain() ([]ssz.HashRoot, phase0.DomainType, error) {
+	return r.selectionProofRootsAndDomain(r.GetState().StartingDuty)
+}
+
+// selectionProofRootsAndDomain returns the roots of the selection proofs of the duty, one per sync committee index
+func (r *SyncCommitteeAggregatorRunner) selectionProofRootsAndDomain(duty *spectypes.Duty) ([]ssz.HashRoot, phase0.DomainType, error) {
 	sszIndexes := make([]ssz.HashRoot, 0)
-	for _, index := range r.GetState().StartingDuty.ValidatorSyncCommitteeIndices {
+	for _, index := range duty.ValidatorSyncCommitteeIndices {
 		subnet, err := r.GetBeaconNode().SyncCommitteeSubnetID(phase0.CommitteeIndex(index))
 		if err != nil {
 			return nil, spectypes.DomainError, errors.Wrap(err, "could not get sync committee subnet ID")
 		}
 		data := &altair.SyncAggregatorSelectionData{
-			Slot:              r.GetState().StartingDuty.Slot,
+			Slot:              duty.Slot,
 			SubcommitteeIndex: subnet,
 		}
 		sszIndexes = append(sszIndexes, data)
@@ -330,17 +352,41 @@ func (r *SyncCommitteeAggregatorRunner) expectedPostConsensusRootsAndDomain() ([
 // 2) Reconstruct contribution proofs, check IsSyncCommitteeAggregator and start consensus on duty + contribution data
 // 3) Once consensus decides, sign partial contribution data (for each subcommittee) and broadcast
 // 4) collect 2f+1 partial sigs, reconstruct and broadcast valid SignedContributionAndProof (for each subcommittee) sig to the BN
+// Step 1 is skipped if the contribution proofs were reconstructed ahead of the duty.
 func (r *SyncCommitteeAggregatorRunner) executeDuty(logger *zap.Logger, duty *spectypes.Duty) error {
 	r.metrics.StartDutyFullFlow()
+
+	if proofs, ok := r.BaseRunner.cachedSelectionProofs(duty); ok {
+		defer r.BaseRunner.endDutyUnlessDeciding()
+		logger.Debug("🧩 using contribution proofs reconstructed ahead of the duty", fields.Slot(duty.Slot))
+		return r.decideContributions(logger, duty, proofs)
+	}
+
 	r.metrics.StartPreConsensus()
 
+	return r.BroadcastSelectionProofs(duty)
+}
+
+// ReconstructSelectionProofs reconstructs the contribution proofs of the duty from the partial contribution proofs
+// which were received ahead of it, and returns true once they're cached.
+func (r *SyncCommitteeAggregatorRunner) ReconstructSelectionProofs(duty *spectypes.Duty, msgs []*spectypes.SignedPartialSignatureMessage) (bool, error) {
+	roots, domain, err := r.selectionProofRootsAndDomain(duty)
+	if err != nil {
+		return false, err
+	}
+	return r.BaseRunner.reconstructSelectionProofs(r, duty, roots, domain, msgs)
+}
+
+// BroadcastSelectionProofs signs and broadcasts the partial contribution proofs of the duty,
+// either when the duty starts or ahead of its slot.
+func (r *SyncCommitteeAggregatorRunner) BroadcastSelectionProofs(duty *spectypes.Duty) error {
 	// sign selection proofs
 	msgs := spectypes.PartialSignatureMessages{
 		Type:     spectypes.ContributionProofs,
 		Slot:     duty.Slot,
 		Messages: []*spectypes.PartialSignatureMessage{},
 	}
-	for _, index := range r.GetState().StartingDuty.ValidatorSyncCommitteeIndices {
+	for _, index := range duty.ValidatorSyncCommitteeIndices {
 		subnet, err := r.GetBeaconNode().SyncCommitteeSubnetID(phase0.CommitteeIndex(index))
 		if err != nil {
 			return errors.Wrap(err, "could not get sync committee subnet ID")
@@ -0,0 +1,136 @@
+package runner
+
+import (
+	"context"
+
+	specqbft "github.com/bloxapp/ssv-spec/qbft"
+	spectypes "github.com/bloxapp/ssv-spec/types"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/bloxapp/ssv/monitoring/tracing"
+)
+
+// Phases of a duty, each of them is traced as a child span of the span of the duty.
+// The pre-consensus phase lasts until consensus starts, so it includes fetching the consensus data from the beacon node.
+const (
+	phasePreConsensus  = "pre_consensus"
+	phaseConsensus     = "consensus"
+	phasePostConsensus = "post_consensus"
+	phaseSubmission    = "submission"
+)
+
+// errDutyNotFinished ends the span of a duty that was replaced by the next duty before it finished.
+var errDutyNotFinished = errors.New("duty did not finish")
+
+// dutyTrace holds the spans of the running duty, its methods are no-ops on a nil dutyTrace.
+type dutyTrace struct {
+	ctx  context.Context
+	duty trace.Span
+
+	phaseName string
+	phaseCtx  context.Context
+	phase     trace.Span
+
+	round    trace.Span
+	roundNum specqbft.Round
+}
+
+// TraceNextDuty sets the context of the next duty that is started by the runner.
+// The span of ctx becomes the span of the duty, which the runner ends once the duty finishes.
+func (b *BaseRunner) TraceNextDuty(ctx context.Context) {
+	b.nextDutyCtx = ctx
+}
+
+// TraceRound traces the current round of the running QBFT instance,
+// it's called whenever the round of the instance may have changed.
+func (b *BaseRunner) TraceRound() {
+	if b.State == nil || b.State.RunningInstance == nil {
+		return
+	}
+	b.trace.observeRound(b.State.RunningInstance.GetHeight(), b.State.RunningInstance.State.Round)
+}
+
+// startTrace ends the trace of the previous duty, if it didn't finish, and starts tracing the given duty.
+func (b *BaseRunner) startTrace(duty *spectypes.Duty) {
+	b.trace.end(errDutyNotFinished)
+
+	ctx := b.nextDutyCtx
+	b.nextDutyCtx = nil
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	span := trace.SpanFromContext(ctx)
+	if !span.SpanContext().IsValid() {
+		ctx, span = tracing.Tracer().Start(ctx, "duty", trace.WithAttributes(tracing.DutyAttributes(duty)...))
+	}
+
+	b.trace = &dutyTrace{ctx: ctx, duty: span}
+	b.trace.startPhase(phasePreConsensus)
+}
+
+// endTrace ends the trace of the duty, with its recorded errors.
+func (b *BaseRunner) endTrace() {
+	b.trace.end(nil)
+	b.trace = nil
+}
+
+// startPhase ends the current phase and starts the given one.
+func (t *dutyTrace) startPhase(name string) {
+	if t == nil {
+		return
+	}
+	t.endPhase()
+	t.phaseName = name
+	t.phaseCtx, t.phase = tracing.Tracer().Start(t.ctx, name)
+}
+
+func (t *dutyTrace) endPhase() {
+	if t.round != nil {
+		t.round.End()
+		t.round = nil
+	}
+	if t.phase != nil {
+		t.phase.End()
+		t.phase = nil
+	}
+}
+
+// observeRound starts a span for each round of the consensus phase.
+func (t *dutyTrace) observeRound(height specqbft.Height, round specqbft.Round) {
+	if t == nil || t.phase == nil || t.phaseName != phaseConsensus || t.round != nil && t.roundNum == round {
+		return
+	}
+	if t.round != nil {
+		t.round.End()
+	}
+	_, t.round = tracing.Tracer().Start(t.phaseCtx, "round", trace.WithAttributes(
+		tracing.Height(height),
+		tracing.Round(round),
+	))
+	t.roundNum = round
+	t.phase.SetAttributes(tracing.Height(height))
+}
+
+// recordError marks the current phase and the duty as failed.
+func (t *dutyTrace) recordError(err error) {
+	if t == nil || err == nil {
+		return
+	}
+	if t.phase != nil {
+		t.phase.RecordError(err)
+		t.phase.SetStatus(codes.Error, err.Error())
+	}
+	t.duty.RecordError(err)
+	t.duty.SetStatus(codes.Error, err.Error())
+}
+
+// end ends all the spans of the duty, marking it as failed if err isn't nil.
+func (t *dutyTrace) end(err error) {
+	if t == nil {
+		return
+	}
+	t.endPhase()
+	tracing.End(t.duty, err)
+}
@@ -0,0 +1,65 @@
+package runner
+
+import (
+	"context"
+	"testing"
+
+	specqbft "github.com/bloxapp/ssv-spec/qbft"
+	spectypes "github.com/bloxapp/ssv-spec/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/bloxapp/ssv/monitoring/tracing"
+)
+
+func TestDutyTrace(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	duty := &spectypes.Duty{Type: spectypes.BNRoleAttester, Slot: 12}
+	b := &BaseRunner{}
+
+	// the span of the duty is taken from the context of the next duty
+	ctx, dutySpan := tracing.Tracer().Start(context.Background(), "duty")
+	b.TraceNextDuty(ctx)
+	b.startTrace(duty)
+	b.trace.startPhase(phaseConsensus)
+	b.trace.observeRound(12, 1)
+	b.trace.observeRound(12, 1)
+	b.trace.observeRound(12, 2)
+	b.trace.startPhase(phasePostConsensus)
+	b.trace.observeRound(12, 3) // rounds are only traced during consensus
+	b.trace.startPhase(phaseSubmission)
+	b.dutyFinished(errors.New("beacon node is down"))
+	b.endTrace()
+
+	ended := recorder.Ended()
+	var names []string
+	for _, span := range ended {
+		names = append(names, span.Name())
+		require.Equal(t, dutySpan.SpanContext().TraceID(), span.SpanContext().TraceID())
+	}
+	require.Equal(t, []string{phasePreConsensus, "round", "round", phaseConsensus, phasePostConsensus, phaseSubmission, "duty"}, names)
+	require.Contains(t, ended[1].Attributes(), tracing.Round(specqbft.Round(1)))
+	require.Contains(t, ended[2].Attributes(), tracing.Round(specqbft.Round(2)))
+	require.Equal(t, codes.Error, ended[5].Status().Code)
+	require.Equal(t, codes.Error, ended[6].Status().Code)
+
+	// a duty without a span in its context is traced in its own trace,
+	// and a duty which didn't finish is ended once the next one starts.
+	b.startTrace(duty)
+	b.startTrace(duty)
+	ended = recorder.Ended()[len(ended):]
+	require.Len(t, ended, 2)
+	require.Equal(t, "duty", ended[1].Name())
+	require.NotEqual(t, dutySpan.SpanContext().TraceID(), ended[1].SpanContext().TraceID())
+	require.Equal(t, errDutyNotFinished.Error(), ended[1].Status().Description)
+	require.Contains(t, ended[1].Attributes(), tracing.Slot(12))
+}
@@ -27,7 +27,8 @@ var BaseValidator = func(logger *zap.Logger, keySet *spectestingutils.TestKeySet
 			SSVShare: &types.SSVShare{
 				Share: *spectestingutils.TestingShare(keySet),
 			},
-			Signer: spectestingutils.NewTestingKeyManager(),
+			Signer:            spectestingutils.NewTestingKeyManager(),
+			SignatureVerifier: testing.TestingSignatureVerifier(),
 			DutyRunners: map[spectypes.BeaconRole]runner.Runner{
 				spectypes.BNRoleAttester:                  AttesterRunner(logger, keySet),
 				spectypes.BNRoleProposer:                  ProposerRunner(logger, keySet),
@@ -1,24 +1,42 @@
 package validator
 
 import (
+	"context"
+
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"github.com/bloxapp/ssv/logging/fields"
+	"github.com/bloxapp/ssv/monitoring/tracing"
 	"github.com/bloxapp/ssv/protocol/v2/types"
 )
 
-func (v *Validator) OnExecuteDuty(logger *zap.Logger, msg types.EventMsg) error {
+// OnExecuteDuty starts the duty of the message. The span of the duty in ctx is ended by its runner,
+// or here if the duty can't be handed to its runner.
+func (v *Validator) OnExecuteDuty(ctx context.Context, logger *zap.Logger, msg types.EventMsg) error {
+	tracing.EndQueued(ctx)
+	span := trace.SpanFromContext(ctx)
+
 	executeDutyData, err := msg.GetExecuteDutyData()
 	if err != nil {
-		return errors.Wrap(err, "failed to get execute duty data")
+		err = errors.Wrap(err, "failed to get execute duty data")
+		tracing.End(span, err)
+		return err
 	}
 
 	logger = logger.With(fields.Slot(executeDutyData.Duty.Slot), fields.Role(executeDutyData.Duty.Type))
 
 	// force the validator to be started (subscribed to validator's topic and synced)
 	if _, err := v.Start(logger); err != nil {
-		return errors.Wrap(err, "could not start validator")
+		err = errors.Wrap(err, "could not start validator")
+		tracing.End(span, err)
+		return err
+	}
+	if dutyRunner := v.DutyRunners[executeDutyData.Duty.Type]; dutyRunner != nil {
+		dutyRunner.GetBaseRunner().TraceNextDuty(ctx)
+	} else {
+		tracing.End(span, errors.New("no runner for duty"))
 	}
 	if err := v.StartDuty(logger, executeDutyData.Duty); err != nil {
 		return errors.Wrap(err, "could not start duty")
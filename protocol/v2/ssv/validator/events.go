@@ -1,6 +1,7 @@
 package validator
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/pkg/errors"
@@ -21,9 +22,16 @@ func (v *Validator) handleEventMessage(logger *zap.Logger, msg *queue.DecodedSSV
 		if err := dutyRunner.GetBaseRunner().QBFTController.OnTimeout(logger, *eventMsg); err != nil {
 			return fmt.Errorf("timeout event: %w", err)
 		}
+		dutyRunner.GetBaseRunner().TraceRound()
+		// A duty which timed out may still be decided in a later round, but it isn't waited for on shutdown.
+		v.dutyEnded(dutyRunner.GetBaseRunner().BeaconRoleType)
 		return nil
 	case types.ExecuteDuty:
-		if err := v.OnExecuteDuty(logger, *eventMsg); err != nil {
+		ctx := msg.TraceContext
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		if err := v.OnExecuteDuty(ctx, logger, *eventMsg); err != nil {
 			return fmt.Errorf("execute duty event: %w", err)
 		}
 		return nil
@@ -46,6 +46,9 @@ func (v *Validator) HandleMessage(logger *zap.Logger, msg *spectypes.SSVMessage)
 			)
 			return
 		}
+		if v.bufferEarlySelectionProofs(logger, decodedMsg) {
+			return
+		}
 		if pushed := q.Q.TryPush(decodedMsg); !pushed {
 			msgID := msg.MsgID.String()
 			logger.Warn("❗ dropping message because the queue is full",
@@ -19,13 +19,19 @@ type NonCommitteeValidator struct {
 }
 
 func NewNonCommitteeValidator(logger *zap.Logger, identifier spectypes.MessageID, opts Options) *NonCommitteeValidator {
+	// the domain of the identifier is the domain of the fork the messages belong to
+	var domain spectypes.DomainType
+	copy(domain[:], identifier.GetDomain())
+
 	// currently, only need domain & storage
 	config := &qbft.Config{
-		Domain:  types.GetDefaultDomain(),
-		Storage: opts.Storage.Get(identifier.GetRoleType()),
-		Network: opts.Network,
+		Domain:            domain,
+		DomainAtSlot:      opts.DomainAtSlot,
+		SignatureVerifier: opts.SignatureVerifier,
+		Storage:           opts.Storage.Get(identifier.GetRoleType()),
+		Network:           opts.Network,
 	}
-	ctrl := qbftcontroller.NewController(identifier[:], &opts.SSVShare.Share, types.GetDefaultDomain(), config, opts.FullNode)
+	ctrl := qbftcontroller.NewController(identifier[:], &opts.SSVShare.Share, domain, config, opts.FullNode)
 	ctrl.StoredInstances = make(qbftcontroller.InstanceContainer, 0, nonCommitteeInstanceContainerCapacity(opts.FullNode))
 	ctrl.NewDecidedHandler = opts.NewDecidedHandler
 	if _, err := ctrl.LoadHighestInstance(identifier[:]); err != nil {
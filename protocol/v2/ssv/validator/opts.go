@@ -1,6 +1,7 @@
 package validator
 
 import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	specqbft "github.com/bloxapp/ssv-spec/qbft"
 	specssv "github.com/bloxapp/ssv-spec/ssv"
 	spectypes "github.com/bloxapp/ssv-spec/types"
@@ -20,6 +21,8 @@ type Options struct {
 	Network           specqbft.Network
 	Beacon            specssv.BeaconNode
 	BeaconNetwork     spectypes.BeaconNetwork
+	DomainAtSlot      types.DomainAtSlotF
+	SignatureVerifier *types.SignatureVerifier
 	Storage           *storage.QBFTStores
 	SSVShare          *types.SSVShare
 	Signer            spectypes.KeyManager
@@ -30,6 +33,22 @@ type Options struct {
 	BuilderProposals  bool
 	QueueSize         int
 	GasLimit          uint64
+	DutyTracker       DutyTracker
+	SelectionProofs   *runner.SelectionProofs
+}
+
+// DutyTracker is told about the result of every duty that is submitted to the beacon node.
+type DutyTracker interface {
+	DutyFinished(pubKey []byte, role spectypes.BeaconRole, err error)
+}
+
+// Domain returns the domain type of the fork which is active at the given slot,
+// or the domain type of the share if there's no fork schedule.
+func (o *Options) Domain(slot phase0.Slot) spectypes.DomainType {
+	if o.DomainAtSlot == nil {
+		return o.SSVShare.DomainType
+	}
+	return o.DomainAtSlot(slot)
 }
 
 func (o *Options) defaults() {
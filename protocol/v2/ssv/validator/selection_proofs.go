@@ -0,0 +1,228 @@
+package validator
+
+import (
+	"sync"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	spectypes "github.com/bloxapp/ssv-spec/types"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/bloxapp/ssv/logging/fields"
+	"github.com/bloxapp/ssv/protocol/v2/ssv/queue"
+	"github.com/bloxapp/ssv/protocol/v2/ssv/runner"
+)
+
+// earlySelectionProofsEpochs is how many epochs ahead of their slot partial selection proofs are buffered.
+const earlySelectionProofsEpochs = 2
+
+type selectionProofsKey struct {
+	role spectypes.BeaconRole
+	slot phase0.Slot
+}
+
+// selectionProofs buffers the partial selection proofs which were received ahead of their duty's slot,
+// until the duty starts and they are handed to its runner, along with the prepared duties they belong to.
+type selectionProofs struct {
+	mu     sync.Mutex
+	msgs   map[selectionProofsKey]map[spectypes.OperatorID]*queue.DecodedSSVMessage
+	duties map[selectionProofsKey]*spectypes.Duty
+}
+
+func newSelectionProofs() *selectionProofs {
+	return &selectionProofs{
+		msgs:   make(map[selectionProofsKey]map[spectypes.OperatorID]*queue.DecodedSSVMessage),
+		duties: make(map[selectionProofsKey]*spectypes.Duty),
+	}
+}
+
+// Add buffers the message of the given signer, and drops the messages of slots which already passed.
+func (p *selectionProofs) Add(role spectypes.BeaconRole, slot phase0.Slot, signer spectypes.OperatorID, msg *queue.DecodedSSVMessage, currentSlot phase0.Slot) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.purge(currentSlot)
+
+	key := selectionProofsKey{role: role, slot: slot}
+	if p.msgs[key] == nil {
+		p.msgs[key] = make(map[spectypes.OperatorID]*queue.DecodedSSVMessage)
+	}
+	p.msgs[key][signer] = msg
+}
+
+// Prepare keeps the duty, so its selection proofs can be reconstructed once they reach a quorum.
+func (p *selectionProofs) Prepare(duty *spectypes.Duty) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.duties[selectionProofsKey{role: duty.Type, slot: duty.Slot}] = duty
+}
+
+// Get returns the prepared duty of the given role and slot, if any, and its buffered messages.
+func (p *selectionProofs) Get(role spectypes.BeaconRole, slot phase0.Slot) (*spectypes.Duty, []*queue.DecodedSSVMessage) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := selectionProofsKey{role: role, slot: slot}
+	msgs := make([]*queue.DecodedSSVMessage, 0, len(p.msgs[key]))
+	for _, msg := range p.msgs[key] {
+		msgs = append(msgs, msg)
+	}
+	return p.duties[key], msgs
+}
+
+// Pop removes the prepared duty of the given role and slot, and removes and returns its messages.
+func (p *selectionProofs) Pop(role spectypes.BeaconRole, slot phase0.Slot) []*queue.DecodedSSVMessage {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := selectionProofsKey{role: role, slot: slot}
+	msgs := make([]*queue.DecodedSSVMessage, 0, len(p.msgs[key]))
+	for _, msg := range p.msgs[key] {
+		msgs = append(msgs, msg)
+	}
+	delete(p.msgs, key)
+	delete(p.duties, key)
+	return msgs
+}
+
+// Purge drops the messages and prepared duties of the slots before the given slot.
+func (p *selectionProofs) Purge(slot phase0.Slot) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.purge(slot)
+}
+
+func (p *selectionProofs) purge(slot phase0.Slot) {
+	for key := range p.msgs {
+		if key.slot < slot {
+			delete(p.msgs, key)
+		}
+	}
+	for key := range p.duties {
+		if key.slot < slot {
+			delete(p.duties, key)
+		}
+	}
+}
+
+// PrepareDuty signs and broadcasts the selection proofs of the duty ahead of its slot,
+// so the operators can reconstruct them before the duty starts.
+func (v *Validator) PrepareDuty(logger *zap.Logger, duty *spectypes.Duty) error {
+	dutyRunner, ok := v.DutyRunners[duty.Type].(runner.SelectionProofsRunner)
+	if !ok {
+		return errors.Errorf("no selection proofs for duty type %s", duty.Type.String())
+	}
+	if err := dutyRunner.BroadcastSelectionProofs(duty); err != nil {
+		return err
+	}
+	v.selectionProofs.Prepare(duty)
+	v.reconstructSelectionProofs(logger, duty.Type, duty.Slot)
+	return nil
+}
+
+// PurgeSelectionProofs drops the selection proofs which were buffered for the slots before the given slot.
+func (v *Validator) PurgeSelectionProofs(slot phase0.Slot) {
+	v.selectionProofs.Purge(slot)
+}
+
+// bufferEarlySelectionProofs buffers the message if it's a partial selection proof of an upcoming duty,
+// and returns true if the message shouldn't be queued.
+func (v *Validator) bufferEarlySelectionProofs(logger *zap.Logger, msg *queue.DecodedSSVMessage) bool {
+	if msg.MsgType != spectypes.SSVPartialSignatureMsgType {
+		return false
+	}
+	signedMsg, ok := msg.Body.(*spectypes.SignedPartialSignatureMessage)
+	if !ok {
+		return false
+	}
+	if signedMsg.Message.Type != spectypes.SelectionProofPartialSig && signedMsg.Message.Type != spectypes.ContributionProofs {
+		return false
+	}
+
+	role := msg.MsgID.GetRoleType()
+	dutyRunner := v.DutyRunners[role]
+	if dutyRunner == nil {
+		return false
+	}
+	beaconNetwork := dutyRunner.GetBaseRunner().BeaconNetwork
+	currentSlot := beaconNetwork.EstimatedCurrentSlot()
+	slot := signedMsg.Message.Slot
+	if slot <= currentSlot {
+		return false
+	}
+
+	if slot > currentSlot+phase0.Slot(earlySelectionProofsEpochs*beaconNetwork.SlotsPerEpoch()) {
+		logger.Debug("❗ dropping selection proofs too far ahead of their slot",
+			fields.Role(role), fields.Slot(slot), zap.Uint64("signer", signedMsg.Signer))
+		return true
+	}
+	if !v.isCommitteeMember(signedMsg.Signer) {
+		logger.Debug("❗ dropping selection proofs of an unknown signer",
+			fields.Role(role), fields.Slot(slot), zap.Uint64("signer", signedMsg.Signer))
+		return true
+	}
+
+	v.selectionProofs.Add(role, slot, signedMsg.Signer, msg, currentSlot)
+	v.reconstructSelectionProofs(logger, role, slot)
+	return true
+}
+
+// reconstructSelectionProofs reconstructs the selection proofs of a prepared duty once its buffered messages
+// reach a quorum, so the duty can skip pre-consensus when it starts.
+func (v *Validator) reconstructSelectionProofs(logger *zap.Logger, role spectypes.BeaconRole, slot phase0.Slot) {
+	duty, msgs := v.selectionProofs.Get(role, slot)
+	if duty == nil || uint64(len(msgs)) < v.Share.Quorum {
+		return
+	}
+	dutyRunner, ok := v.DutyRunners[role].(runner.SelectionProofsRunner)
+	if !ok {
+		return
+	}
+
+	signedMsgs := make([]*spectypes.SignedPartialSignatureMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		signedMsgs = append(signedMsgs, msg.Body.(*spectypes.SignedPartialSignatureMessage))
+	}
+	reconstructed, err := dutyRunner.ReconstructSelectionProofs(duty, signedMsgs)
+	if err != nil {
+		logger.Warn("could not reconstruct early selection proofs", fields.Role(role), fields.Slot(slot), zap.Error(err))
+		return
+	}
+	if reconstructed {
+		logger.Debug("🧩 reconstructed selection proofs ahead of the duty", fields.Role(role), fields.Slot(slot))
+	}
+}
+
+// replaySelectionProofs queues the selection proofs which were received ahead of the duty,
+// once its runner is ready to process them.
+func (v *Validator) replaySelectionProofs(logger *zap.Logger, duty *spectypes.Duty) {
+	msgs := v.selectionProofs.Pop(duty.Type, duty.Slot)
+	if len(msgs) == 0 {
+		return
+	}
+
+	v.mtx.RLock() // read v.Queues
+	defer v.mtx.RUnlock()
+
+	q, ok := v.Queues[duty.Type]
+	if !ok {
+		return
+	}
+	for _, msg := range msgs {
+		if pushed := q.Q.TryPush(msg); !pushed {
+			logger.Warn("❗ dropping early selection proofs because the queue is full")
+		}
+	}
+	logger.Debug("replayed early selection proofs", zap.Int("count", len(msgs)))
+}
+
+func (v *Validator) isCommitteeMember(operatorID spectypes.OperatorID) bool {
+	for _, operator := range v.Share.Committee {
+		if operator.OperatorID == operatorID {
+			return true
+		}
+	}
+	return false
+}
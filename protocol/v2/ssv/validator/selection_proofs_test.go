@@ -0,0 +1,44 @@
+package validator
+
+import (
+	"testing"
+
+	spectypes "github.com/bloxapp/ssv-spec/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bloxapp/ssv/protocol/v2/ssv/queue"
+)
+
+func TestSelectionProofs(t *testing.T) {
+	p := newSelectionProofs()
+	require.Empty(t, p.Pop(spectypes.BNRoleAggregator, 10))
+
+	msg1, msg2, msg3 := &queue.DecodedSSVMessage{}, &queue.DecodedSSVMessage{}, &queue.DecodedSSVMessage{}
+	p.Add(spectypes.BNRoleAggregator, 10, 1, msg1, 5)
+	p.Add(spectypes.BNRoleAggregator, 10, 2, msg2, 5)
+	p.Add(spectypes.BNRoleAggregator, 10, 2, msg3, 5)
+	p.Add(spectypes.BNRoleSyncCommitteeContribution, 10, 1, msg1, 5)
+
+	// messages are deduplicated by signer
+	require.ElementsMatch(t, []*queue.DecodedSSVMessage{msg1, msg3}, p.Pop(spectypes.BNRoleAggregator, 10))
+	require.Empty(t, p.Pop(spectypes.BNRoleAggregator, 10))
+
+	// messages of past slots are dropped
+	p.Add(spectypes.BNRoleAggregator, 20, 1, msg1, 11)
+	require.Empty(t, p.Pop(spectypes.BNRoleSyncCommitteeContribution, 10))
+	require.Equal(t, []*queue.DecodedSSVMessage{msg1}, p.Pop(spectypes.BNRoleAggregator, 20))
+
+	// prepared duties are kept along with their messages
+	duty := &spectypes.Duty{Type: spectypes.BNRoleAggregator, Slot: 30}
+	p.Prepare(duty)
+	p.Add(spectypes.BNRoleAggregator, 30, 1, msg1, 11)
+	prepared, msgs := p.Get(spectypes.BNRoleAggregator, 30)
+	require.Equal(t, duty, prepared)
+	require.Equal(t, []*queue.DecodedSSVMessage{msg1}, msgs)
+
+	// messages and duties of past slots are purged on slot ticks
+	p.Purge(31)
+	prepared, msgs = p.Get(spectypes.BNRoleAggregator, 30)
+	require.Nil(t, prepared)
+	require.Empty(t, msgs)
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/bloxapp/ssv-spec/p2p"
 	spectypes "github.com/bloxapp/ssv-spec/types"
 	"github.com/bloxapp/ssv/logging"
-	"github.com/bloxapp/ssv/protocol/v2/types"
 	"github.com/pkg/errors"
 
 	"go.uber.org/zap"
@@ -35,7 +34,7 @@ func (v *Validator) Start(logger *zap.Logger) (started bool, err error) {
 			logger.Warn("❗ share is missing", fields.Role(role))
 			continue
 		}
-		identifier := spectypes.NewMsgID(types.GetDefaultDomain(), r.GetBaseRunner().Share.ValidatorPubKey, role)
+		identifier := spectypes.NewMsgID(r.GetBaseRunner().Share.DomainType, r.GetBaseRunner().Share.ValidatorPubKey, role)
 		if ctrl := r.GetBaseRunner().QBFTController; ctrl != nil {
 			highestInstance, err := ctrl.LoadHighestInstance(identifier[:])
 			if err != nil {
@@ -61,6 +60,11 @@ func (v *Validator) Start(logger *zap.Logger) (started bool, err error) {
 	return true, nil
 }
 
+// State returns the current state of the validator.
+func (v *Validator) State() State {
+	return State(atomic.LoadUint32(&v.state))
+}
+
 // Stop stops a Validator.
 func (v *Validator) Stop() {
 	if atomic.CompareAndSwapUint32(&v.state, uint32(Started), uint32(NotStarted)) {
@@ -74,6 +78,20 @@ func (v *Validator) Stop() {
 	}
 }
 
+// Resync performs highest decided sync for all the roles of a started Validator,
+// full nodes then sync the missing decided history from the highest decided.
+func (v *Validator) Resync(logger *zap.Logger) error {
+	if v.State() != Started {
+		return errors.New("validator is not started")
+	}
+	logger = logger.Named(logging.NameValidator).With(fields.PubKey(v.Share.ValidatorPubKey))
+	for role := range v.DutyRunners {
+		identifier := spectypes.NewMsgID(v.Share.DomainType, v.Share.ValidatorPubKey, role)
+		go v.sync(logger.With(fields.Role(role)), identifier)
+	}
+	return nil
+}
+
 // sync performs highest decided sync
 func (v *Validator) sync(logger *zap.Logger, mid spectypes.MessageID) {
 	ctx, cancel := context.WithCancel(v.ctx)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	specqbft "github.com/bloxapp/ssv-spec/qbft"
 	spectypes "github.com/bloxapp/ssv-spec/types"
 	"github.com/cornelk/hashmap"
@@ -38,6 +39,13 @@ type Validator struct {
 	// dutyIDs is a map for logging a unique ID for a given duty
 	dutyIDs *hashmap.Map[spectypes.BeaconRole, string]
 
+	// selectionProofs buffers the partial selection proofs received ahead of their duty
+	selectionProofs *selectionProofs
+
+	// runningDuties holds the slots of the started duties which haven't finished yet
+	runningDuties   map[spectypes.BeaconRole]phase0.Slot
+	runningDutiesMu sync.Mutex
+
 	state uint32
 }
 
@@ -57,15 +65,28 @@ func NewValidator(pctx context.Context, cancel func(), options Options) *Validat
 		Queues:      make(map[spectypes.BeaconRole]queueContainer),
 		state:       uint32(NotStarted),
 		dutyIDs:     hashmap.New[spectypes.BeaconRole, string](),
+
+		selectionProofs: newSelectionProofs(),
+		runningDuties:   make(map[spectypes.BeaconRole]phase0.Slot),
 	}
 
 	for _, dutyRunner := range options.DutyRunners {
 		// Set timeout function.
 		dutyRunner.GetBaseRunner().TimeoutF = v.onTimeout
+		dutyRunner.GetBaseRunner().SelectionProofs = options.SelectionProofs
+		dutyRunner.GetBaseRunner().SignatureVerifier = options.SignatureVerifier
+
+		pubKey := options.SSVShare.ValidatorPubKey
+		dutyRunner.GetBaseRunner().DutyEndedF = v.dutyEnded
+		if options.DutyTracker != nil {
+			dutyRunner.GetBaseRunner().DutyFinishedF = func(role spectypes.BeaconRole, err error) {
+				options.DutyTracker.DutyFinished(pubKey, role, err)
+			}
+		}
 
 		// Setup the queue.
 		role := dutyRunner.GetBaseRunner().BeaconRoleType
-		msgID := spectypes.NewMsgID(types.GetDefaultDomain(), options.SSVShare.ValidatorPubKey, role).String()
+		msgID := spectypes.NewMsgID(options.SSVShare.DomainType, options.SSVShare.ValidatorPubKey, role).String()
 
 		v.Queues[role] = queueContainer{
 			Q: queue.WithMetrics(queue.New(options.QueueSize), queue.NewPrometheusMetrics(msgID)),
@@ -100,7 +121,44 @@ func (v *Validator) StartDuty(logger *zap.Logger, duty *spectypes.Duty) error {
 
 	logger.Info("ℹ️ starting duty processing")
 
-	return dutyRunner.StartNewDuty(logger, duty)
+	// The duty may end while it's started, e.g. with selection proofs which were reconstructed ahead of it.
+	v.dutyStarted(duty)
+	if err := dutyRunner.StartNewDuty(logger, duty); err != nil {
+		v.dutyEnded(duty.Type)
+		return err
+	}
+	v.replaySelectionProofs(logger, duty)
+	return nil
+}
+
+// RunningDuties returns the amount of started duties of the given slot or later which haven't finished yet.
+func (v *Validator) RunningDuties(since phase0.Slot) int {
+	v.runningDutiesMu.Lock()
+	defer v.runningDutiesMu.Unlock()
+
+	count := 0
+	for _, slot := range v.runningDuties {
+		if slot >= since {
+			count++
+		}
+	}
+	return count
+}
+
+func (v *Validator) dutyStarted(duty *spectypes.Duty) {
+	// Validator registrations don't report when they're finished.
+	if duty.Type == spectypes.BNRoleValidatorRegistration {
+		return
+	}
+	v.runningDutiesMu.Lock()
+	defer v.runningDutiesMu.Unlock()
+	v.runningDuties[duty.Type] = duty.Slot
+}
+
+func (v *Validator) dutyEnded(role spectypes.BeaconRole) {
+	v.runningDutiesMu.Lock()
+	defer v.runningDutiesMu.Unlock()
+	delete(v.runningDuties, role)
 }
 
 // ProcessMessage processes Network Message of all types
@@ -0,0 +1,29 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	spectypes "github.com/bloxapp/ssv-spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidator_RunningDuties(t *testing.T) {
+	v := &Validator{runningDuties: make(map[spectypes.BeaconRole]phase0.Slot)}
+	require.Zero(t, v.RunningDuties(0))
+
+	v.dutyStarted(&spectypes.Duty{Type: spectypes.BNRoleAttester, Slot: 10})
+	v.dutyStarted(&spectypes.Duty{Type: spectypes.BNRoleAggregator, Slot: 12})
+	v.dutyStarted(&spectypes.Duty{Type: spectypes.BNRoleValidatorRegistration, Slot: 12})
+	require.Equal(t, 2, v.RunningDuties(0))
+	require.Equal(t, 1, v.RunningDuties(11))
+
+	// a new duty of the same role replaces the previous one
+	v.dutyStarted(&spectypes.Duty{Type: spectypes.BNRoleAttester, Slot: 11})
+	require.Equal(t, 2, v.RunningDuties(11))
+
+	v.dutyEnded(spectypes.BNRoleAggregator)
+	require.Equal(t, 1, v.RunningDuties(0))
+	v.dutyEnded(spectypes.BNRoleAttester)
+	require.Zero(t, v.RunningDuties(0))
+}
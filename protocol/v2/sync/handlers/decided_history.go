@@ -13,9 +13,9 @@ import (
 	protocolp2p "github.com/bloxapp/ssv/protocol/v2/p2p"
 )
 
-// HistoryHandler handler for decided history protocol
-// TODO: add msg validation and report scores
-func HistoryHandler(logger *zap.Logger, storeMap *storage.QBFTStores, reporting protocolp2p.ValidationReporting, maxBatchSize int) protocolp2p.RequestHandler {
+// HistoryHandler handler for decided history protocol.
+// invalid requests are answered with StatusBadRequest and reported, so the sender gets penalized
+func HistoryHandler(logger *zap.Logger, storeMap *storage.QBFTStores, reporting protocolp2p.ValidationReporting, maxBatchSize int, validateID IdentifierValidator) protocolp2p.RequestHandler {
 	return func(msg *spectypes.SSVMessage) (*spectypes.SSVMessage, error) {
 		logger := logger.With(zap.String("msg_id", fmt.Sprintf("%x", msg.MsgID)))
 		sm := &message.SyncMessage{}
@@ -28,16 +28,16 @@ func HistoryHandler(logger *zap.Logger, storeMap *storage.QBFTStores, reporting
 			// not this protocol
 			// TODO: remove after v0
 			return nil, nil
+		} else if store, res, err := validateRequest(storeMap, validateID, msg, sm, 2); err != nil {
+			logger.Debug("❌ invalid request", zap.Error(err))
+			reporting.ReportValidation(logger, msg, res)
+			*sm = message.SyncMessage{Protocol: sm.Protocol, Status: message.StatusBadRequest}
 		} else {
 			items := int(sm.Params.Height[1] - sm.Params.Height[0])
 			if items > maxBatchSize {
 				sm.Params.Height[1] = sm.Params.Height[0] + specqbft.Height(maxBatchSize)
 			}
 			msgID := msg.GetID()
-			store := storeMap.Get(msgID.GetRoleType())
-			if store == nil {
-				return nil, errors.New(fmt.Sprintf("not storage found for type %s", msgID.GetRoleType().String()))
-			}
 			instances, err := store.GetInstancesInRange(msgID[:], sm.Params.Height[0], sm.Params.Height[1])
 			results := make([]*specqbft.SignedMessage, 0, len(instances))
 			for _, instance := range instances {
@@ -46,7 +46,7 @@ func HistoryHandler(logger *zap.Logger, storeMap *storage.QBFTStores, reporting
 			sm.UpdateResults(err, results...)
 		}
 
-		data, err := sm.Encode()
+		data, err := encodeResponse(sm, msg.Data)
 		if err != nil {
 			return nil, errors.Wrap(err, "could not encode result data")
 		}
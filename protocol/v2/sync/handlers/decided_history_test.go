@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	specqbft "github.com/bloxapp/ssv-spec/qbft"
+	spectypes "github.com/bloxapp/ssv-spec/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/bloxapp/ssv/ibft/storage"
+	"github.com/bloxapp/ssv/logging"
+	"github.com/bloxapp/ssv/networkconfig"
+	"github.com/bloxapp/ssv/protocol/v2/message"
+	protocolp2p "github.com/bloxapp/ssv/protocol/v2/p2p"
+	qbftstorage "github.com/bloxapp/ssv/protocol/v2/qbft/storage"
+	"github.com/bloxapp/ssv/storage/basedb"
+	"github.com/bloxapp/ssv/storage/kv"
+)
+
+type nopReporting struct{}
+
+func (nopReporting) ReportValidation(*zap.Logger, *spectypes.SSVMessage, protocolp2p.MsgValidationResult) {
+}
+
+func TestHistoryHandler_PrunedHeights(t *testing.T) {
+	logger := logging.TestLogger(t)
+	domain := spectypes.DomainType{0x0, 0x0, 0x5, 0x1}
+	mid := spectypes.NewMsgID(domain, make([]byte, 48), spectypes.BNRoleSyncCommittee)
+
+	// both nodes decided heights 1-50, but the pruned node only keeps the latest 10
+	newStores := func() *storage.QBFTStores {
+		db, err := kv.NewInMemory(logger, basedb.Options{})
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = db.Close() })
+
+		stores := storage.NewStoresFromRoles(db, spectypes.BNRoleSyncCommittee)
+		for h := specqbft.Height(1); h <= 50; h++ {
+			require.NoError(t, stores.Get(spectypes.BNRoleSyncCommittee).SaveHighestAndHistoricalInstance(&qbftstorage.StoredInstance{
+				State: &specqbft.State{ID: mid[:], Height: h, Decided: true},
+				DecidedMessage: &specqbft.SignedMessage{
+					Signature: []byte("sig"),
+					Signers:   []spectypes.OperatorID{1, 2, 3},
+					Message:   specqbft.Message{MsgType: specqbft.CommitMsgType, Height: h, Identifier: mid[:]},
+				},
+			}))
+		}
+		return stores
+	}
+	prunedStores, archiveStores := newStores(), newStores()
+	pruner := storage.NewPruner(storage.RetentionConfig{Heights: 10}, networkconfig.TestNetwork.Beacon, prunedStores)
+	pruned, err := pruner.Prune(context.Background(), logger)
+	require.NoError(t, err)
+	require.Equal(t, 40, pruned)
+
+	request := func(stores *storage.QBFTStores, from, to specqbft.Height) []specqbft.Height {
+		data, err := (&message.SyncMessage{
+			Protocol: message.DecidedHistoryType,
+			Params:   &message.SyncParams{Identifier: mid, Height: []specqbft.Height{from, to}},
+		}).Encode()
+		require.NoError(t, err)
+
+		handler := HistoryHandler(logger, stores, nopReporting{}, 25, nil)
+		res, err := handler(&spectypes.SSVMessage{MsgType: message.SSVSyncMsgType, MsgID: mid, Data: data})
+		require.NoError(t, err)
+
+		sm := &message.SyncMessage{}
+		require.NoError(t, sm.Decode(res.Data))
+		heights := make([]specqbft.Height, 0, len(sm.Data))
+		for _, decided := range sm.Data {
+			heights = append(heights, decided.Message.Height)
+		}
+		return heights
+	}
+
+	// pruned heights are no longer served by the pruned node, which only answers with the heights it kept
+	require.Empty(t, request(prunedStores, 1, 20))
+	require.Equal(t, []specqbft.Height{41, 42, 43, 44, 45}, request(prunedStores, 38, 45))
+
+	// a peer which keeps the full history serves the pruned heights
+	require.Len(t, request(archiveStores, 1, 20), 20)
+	require.Len(t, request(archiveStores, 38, 45), 8)
+}
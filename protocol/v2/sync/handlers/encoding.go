@@ -0,0 +1,14 @@
+package handlers
+
+import (
+	"github.com/bloxapp/ssv/protocol/v2/message"
+)
+
+// encodeResponse encodes the given response in the encoding of the request,
+// i.e. SSZ for SSZ encoded requests and JSON for legacy requests
+func encodeResponse(sm *message.SyncMessage, request []byte) ([]byte, error) {
+	if message.IsVersioned(request) {
+		return sm.EncodeSSZ(false)
+	}
+	return sm.Encode()
+}
@@ -1,8 +1,6 @@
 package handlers
 
 import (
-	"fmt"
-
 	spectypes "github.com/bloxapp/ssv-spec/types"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
@@ -13,9 +11,9 @@ import (
 	protocolp2p "github.com/bloxapp/ssv/protocol/v2/p2p"
 )
 
-// LastDecidedHandler handler for last-decided protocol
-// TODO: add msg validation and report scores
-func LastDecidedHandler(plogger *zap.Logger, storeMap *storage.QBFTStores, reporting protocolp2p.ValidationReporting) protocolp2p.RequestHandler {
+// LastDecidedHandler handler for last-decided protocol.
+// invalid requests are answered with StatusBadRequest and reported, so the sender gets penalized
+func LastDecidedHandler(plogger *zap.Logger, storeMap *storage.QBFTStores, reporting protocolp2p.ValidationReporting, validateID IdentifierValidator) protocolp2p.RequestHandler {
 	return func(msg *spectypes.SSVMessage) (*spectypes.SSVMessage, error) {
 		logger := plogger.With(fields.PubKey(msg.MsgID.GetPubKey()))
 		sm := &message.SyncMessage{}
@@ -28,12 +26,12 @@ func LastDecidedHandler(plogger *zap.Logger, storeMap *storage.QBFTStores, repor
 			// not this protocol
 			// TODO: remove after v0
 			return nil, nil
+		} else if store, res, err := validateRequest(storeMap, validateID, msg, sm, 0); err != nil {
+			logger.Debug("❌ invalid request", zap.Error(err))
+			reporting.ReportValidation(logger, msg, res)
+			*sm = message.SyncMessage{Protocol: sm.Protocol, Status: message.StatusBadRequest}
 		} else {
 			msgID := msg.GetID()
-			store := storeMap.Get(msgID.GetRoleType())
-			if store == nil {
-				return nil, errors.New(fmt.Sprintf("not storage found for type %s", msgID.GetRoleType().String()))
-			}
 			instance, err := store.GetHighestInstance(msgID[:])
 			if err != nil {
 				logger.Debug("❗ failed to get highest instance", zap.Error(err))
@@ -42,7 +40,7 @@ func LastDecidedHandler(plogger *zap.Logger, storeMap *storage.QBFTStores, repor
 			}
 		}
 
-		data, err := sm.Encode()
+		data, err := encodeResponse(sm, msg.Data)
 		if err != nil {
 			return nil, errors.Wrap(err, "could not encode result data")
 		}
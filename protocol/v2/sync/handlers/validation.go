@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"fmt"
+
+	spectypes "github.com/bloxapp/ssv-spec/types"
+	"github.com/pkg/errors"
+
+	"github.com/bloxapp/ssv/ibft/storage"
+	"github.com/bloxapp/ssv/protocol/v2/message"
+	protocolp2p "github.com/bloxapp/ssv/protocol/v2/p2p"
+	qbftstorage "github.com/bloxapp/ssv/protocol/v2/qbft/storage"
+)
+
+// IdentifierValidator checks whether the given identifier is of a known validator,
+// requests for unknown identifiers are rejected
+type IdentifierValidator func(mid spectypes.MessageID) error
+
+// validateRequest validates the given sync request and returns the store of the requested role.
+// heights is the expected amount of heights in the request params (0 to skip the check).
+// in case the request is invalid, the returned result is the severity that should be reported
+func validateRequest(
+	storeMap *storage.QBFTStores,
+	validateID IdentifierValidator,
+	msg *spectypes.SSVMessage,
+	sm *message.SyncMessage,
+	heights int,
+) (qbftstorage.QBFTStore, protocolp2p.MsgValidationResult, error) {
+	if sm.Params == nil {
+		return nil, protocolp2p.ValidationRejectMedium, errors.New("missing request params")
+	}
+	if sm.Params.Identifier != msg.MsgID {
+		return nil, protocolp2p.ValidationRejectMedium, errors.New("request identifier doesn't match message identifier")
+	}
+	if heights > 0 {
+		if len(sm.Params.Height) != heights {
+			return nil, protocolp2p.ValidationRejectMedium, fmt.Errorf("expected %d heights, got %d", heights, len(sm.Params.Height))
+		}
+		if sm.Params.Height[0] > sm.Params.Height[len(sm.Params.Height)-1] {
+			return nil, protocolp2p.ValidationRejectMedium, errors.New("invalid heights range")
+		}
+	}
+	msgID := msg.GetID()
+	store := storeMap.Get(msgID.GetRoleType())
+	if store == nil {
+		return nil, protocolp2p.ValidationRejectMedium, fmt.Errorf("unknown role %s", msgID.GetRoleType().String())
+	}
+	if validateID != nil {
+		if err := validateID(msgID); err != nil {
+			// might be a validator that was recently added or removed, therefore the low severity
+			return nil, protocolp2p.ValidationRejectLow, errors.Wrap(err, "unknown identifier")
+		}
+	}
+	return store, protocolp2p.ValidationAccept, nil
+}
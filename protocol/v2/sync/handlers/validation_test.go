@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"bytes"
+	"testing"
+
+	specqbft "github.com/bloxapp/ssv-spec/qbft"
+	spectypes "github.com/bloxapp/ssv-spec/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bloxapp/ssv/ibft/storage"
+	"github.com/bloxapp/ssv/logging"
+	"github.com/bloxapp/ssv/protocol/v2/message"
+	protocolp2p "github.com/bloxapp/ssv/protocol/v2/p2p"
+	"github.com/bloxapp/ssv/storage/basedb"
+	"github.com/bloxapp/ssv/storage/kv"
+)
+
+func TestValidateRequest(t *testing.T) {
+	logger := logging.TestLogger(t)
+	db, err := kv.NewInMemory(logger, basedb.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+	storeMap := storage.NewStoresFromRoles(db, spectypes.BNRoleAttester)
+
+	knownPK := make([]byte, 48)
+	validateID := func(mid spectypes.MessageID) error {
+		if string(mid.GetPubKey()) != string(knownPK) {
+			return errors.New("validator not found")
+		}
+		return nil
+	}
+	domain := spectypes.DomainType{0x0, 0x0, 0x5, 0x1}
+	mid := spectypes.NewMsgID(domain, knownPK, spectypes.BNRoleAttester)
+
+	unknownRole := spectypes.NewMsgID(domain, knownPK, spectypes.BNRoleProposer)
+	unknownValidator := spectypes.NewMsgID(domain, bytes.Repeat([]byte{0x1}, 48), spectypes.BNRoleAttester)
+
+	tests := []struct {
+		name     string
+		mid      spectypes.MessageID
+		params   *message.SyncParams
+		heights  int
+		expected protocolp2p.MsgValidationResult
+	}{
+		{"valid", mid, &message.SyncParams{Identifier: mid, Height: []specqbft.Height{1, 5}}, 2, protocolp2p.ValidationAccept},
+		{"valid w/o heights", mid, &message.SyncParams{Identifier: mid}, 0, protocolp2p.ValidationAccept},
+		{"missing params", mid, nil, 2, protocolp2p.ValidationRejectMedium},
+		{"identifier mismatch", mid, &message.SyncParams{Identifier: unknownRole, Height: []specqbft.Height{1, 5}}, 2, protocolp2p.ValidationRejectMedium},
+		{"missing heights", mid, &message.SyncParams{Identifier: mid, Height: []specqbft.Height{1}}, 2, protocolp2p.ValidationRejectMedium},
+		{"invalid range", mid, &message.SyncParams{Identifier: mid, Height: []specqbft.Height{5, 1}}, 2, protocolp2p.ValidationRejectMedium},
+		{"unknown role", unknownRole, &message.SyncParams{Identifier: unknownRole}, 0, protocolp2p.ValidationRejectMedium},
+		{"unknown validator", unknownValidator, &message.SyncParams{Identifier: unknownValidator}, 0, protocolp2p.ValidationRejectLow},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			msg := &spectypes.SSVMessage{MsgType: message.SSVSyncMsgType, MsgID: test.mid}
+			store, res, err := validateRequest(storeMap, validateID, msg, &message.SyncMessage{Params: test.params}, test.heights)
+			require.Equal(t, test.expected, res)
+			if test.expected == protocolp2p.ValidationAccept {
+				require.NoError(t, err)
+				require.NotNil(t, store)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
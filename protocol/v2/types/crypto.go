@@ -3,6 +3,8 @@ package types
 import (
 	"encoding/hex"
 
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	specqbft "github.com/bloxapp/ssv-spec/qbft"
 	specssv "github.com/bloxapp/ssv-spec/ssv"
 	spectypes "github.com/bloxapp/ssv-spec/types"
 	"github.com/herumi/bls-eth-go-binary/bls"
@@ -10,29 +12,18 @@ import (
 )
 
 // VerifyByOperators verifies signature by the provided operators
-// This is a copy of a function with the same name from the spec, except for it's use of
-// DeserializeBLSPublicKey function and bounded.CGO
+// This is a copy of a function with the same name from the spec, except that the signature
+// is verified in a batch with the other signatures of the slot of the given height.
 //
 // TODO: rethink this function and consider moving/refactoring it.
-func VerifyByOperators(s spectypes.Signature, data spectypes.MessageSignature, domain spectypes.DomainType, sigType spectypes.SignatureType, operators []*spectypes.Operator) error {
-	// decode sig
-	sign := &bls.Sign{}
-	if err := sign.Deserialize(s); err != nil {
-		return errors.Wrap(err, "failed to deserialize signature")
-	}
-
+func (v *SignatureVerifier) VerifyByOperators(s spectypes.Signature, data spectypes.MessageSignature, domain spectypes.DomainType, sigType spectypes.SignatureType, operators []*spectypes.Operator, height specqbft.Height) error {
 	// find operators
-	pks := make([]bls.PublicKey, 0)
+	pks := make([][]byte, 0, len(data.GetSigners()))
 	for _, id := range data.GetSigners() {
 		found := false
 		for _, n := range operators {
 			if id == n.GetID() {
-				pk, err := DeserializeBLSPublicKey(n.GetPublicKey())
-				if err != nil {
-					return errors.Wrap(err, "failed to deserialize public key")
-				}
-
-				pks = append(pks, pk)
+				pks = append(pks, n.GetPublicKey())
 				found = true
 			}
 		}
@@ -47,11 +38,9 @@ func VerifyByOperators(s spectypes.Signature, data spectypes.MessageSignature, d
 		return errors.Wrap(err, "could not compute signing root")
 	}
 
-	// verify
-	if res := sign.FastAggregateVerify(pks, computedRoot[:]); !res {
-		return errors.New("failed to verify signature")
-	}
-	return nil
+	// verify, batched by slot since the height of the instance of a duty is the slot of the duty
+	slot := phase0.Slot(height)
+	return v.Verify(slot, SignedRoot{Signature: s, Root: computedRoot, PublicKeys: pks})[0]
 }
 
 func ReconstructSignature(ps *specssv.PartialSigContainer, root [32]byte, validatorPubKey []byte) ([]byte, error) {
@@ -1,11 +1,15 @@
 package types
 
 import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	spectypes "github.com/bloxapp/ssv-spec/types"
 
 	"github.com/bloxapp/ssv/networkconfig"
 )
 
+// DomainAtSlotF returns the domain type that is active at the given slot, according to the fork schedule
+type DomainAtSlotF func(slot phase0.Slot) spectypes.DomainType
+
 // TODO: get rid of singleton, pass domain as a parameter
 var (
 	domain = networkconfig.Mainnet.Domain
@@ -0,0 +1,18 @@
+package types
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	metricsSignatureVerifications = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ssv_signature_verifications",
+		Help: "Verified signatures by how they were verified (cached, batch or single)",
+	}, []string{"method"})
+	metricsSignatureVerificationBatchSize = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "ssv_signature_verification_batch_size",
+		Help:    "Number of signatures verified in a batch",
+		Buckets: []float64{1, 2, 4, 8, 16, 32, 64, 128},
+	})
+)
@@ -0,0 +1,8 @@
+//go:build !race
+
+package types
+
+import "github.com/herumi/bls-eth-go-binary/bls"
+
+// multiVerify verifies the signatures over the concatenated 32-byte messages with randomized batch verification.
+var multiVerify = bls.MultiVerify
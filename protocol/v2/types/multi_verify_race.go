@@ -0,0 +1,19 @@
+//go:build race
+
+package types
+
+import "github.com/herumi/bls-eth-go-binary/bls"
+
+// multiVerify verifies the signatures over the concatenated 32-byte messages one by one,
+// since bls.MultiVerify converts uintptr to unsafe.Pointer, which fails the race detector's pointer checks.
+func multiVerify(sigs []bls.Sign, pubs []bls.PublicKey, concatenatedMsg []byte) bool {
+	if len(sigs) == 0 || len(pubs) != len(sigs) || len(concatenatedMsg) != len(sigs)*32 {
+		return false
+	}
+	for i := range sigs {
+		if !sigs[i].VerifyByte(&pubs[i], concatenatedMsg[i*32:(i+1)*32]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,205 @@
+package types
+
+import (
+	"crypto/sha256"
+	"runtime"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/pkg/errors"
+)
+
+const (
+	// maxVerificationBatchSize is the maximum number of signatures verified together.
+	maxVerificationBatchSize = 128
+	// verifiedSignaturesCacheSize is the number of verified signatures to remember.
+	verifiedSignaturesCacheSize = 65_536
+)
+
+// ErrInvalidSignature is returned for signatures which don't match their root and public keys.
+var ErrInvalidSignature = errors.New("failed to verify signature")
+
+// SignedRoot is a signature over a root by one or more public keys.
+type SignedRoot struct {
+	Signature  []byte
+	Root       [32]byte
+	PublicKeys [][]byte
+}
+
+type verifyRequest struct {
+	slot   phase0.Slot
+	sig    bls.Sign
+	pk     bls.PublicKey
+	root   [32]byte
+	result chan bool
+}
+
+// SignatureVerifier verifies signatures with randomized batch verification over a pool of workers.
+// Signatures are batched per slot, so that an invalid signature only slows down the verification
+// of the signatures of its own slot, which are then verified one by one.
+// Valid signatures are cached to skip verifying the same signature again.
+type SignatureVerifier struct {
+	requests     chan *verifyRequest
+	batches      chan []*verifyRequest
+	maxBatchSize int
+	verified     *lru.Cache[[32]byte, struct{}]
+}
+
+// NewDefaultSignatureVerifier creates a SignatureVerifier with a worker per CPU and starts its workers.
+// A node shares a single SignatureVerifier between the runners and the QBFT message validation,
+// so that signatures verified by either are only verified once.
+func NewDefaultSignatureVerifier() *SignatureVerifier {
+	return NewSignatureVerifier(runtime.NumCPU(), maxVerificationBatchSize, verifiedSignaturesCacheSize)
+}
+
+// NewSignatureVerifier creates a SignatureVerifier and starts its workers.
+func NewSignatureVerifier(workers, maxBatchSize, cacheSize int) *SignatureVerifier {
+	verified, err := lru.New[[32]byte, struct{}](cacheSize)
+	if err != nil {
+		panic(err)
+	}
+	v := &SignatureVerifier{
+		requests:     make(chan *verifyRequest, maxBatchSize),
+		batches:      make(chan []*verifyRequest),
+		maxBatchSize: maxBatchSize,
+		verified:     verified,
+	}
+	go v.dispatch()
+	for i := 0; i < workers; i++ {
+		go v.work()
+	}
+	return v
+}
+
+// Verify verifies the given signatures of the slot, aggregating the public keys of each signature,
+// and returns an error for each signature which is invalid or can't be deserialized.
+// Signatures of concurrent calls are verified together.
+func (v *SignatureVerifier) Verify(slot phase0.Slot, signedRoots ...SignedRoot) []error {
+	errs := make([]error, len(signedRoots))
+	keys := make([][32]byte, len(signedRoots))
+	requests := make([]*verifyRequest, len(signedRoots))
+	for i, signedRoot := range signedRoots {
+		keys[i] = verifiedSignatureKey(signedRoot)
+		if v.verified.Contains(keys[i]) {
+			metricsSignatureVerifications.WithLabelValues("cached").Inc()
+			continue
+		}
+		req, err := newVerifyRequest(slot, signedRoot)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		requests[i] = req
+		v.requests <- req
+	}
+
+	for i, req := range requests {
+		if req == nil {
+			continue
+		}
+		if !<-req.result {
+			errs[i] = ErrInvalidSignature
+			continue
+		}
+		v.verified.Add(keys[i], struct{}{})
+	}
+	return errs
+}
+
+// dispatch collects the pending requests into batches per slot, which grow while the workers are busy.
+func (v *SignatureVerifier) dispatch() {
+	for req := range v.requests {
+		batches := map[phase0.Slot][]*verifyRequest{req.slot: {req}}
+	collect:
+		for n := 1; n < v.maxBatchSize; n++ {
+			select {
+			case req := <-v.requests:
+				batches[req.slot] = append(batches[req.slot], req)
+			default:
+				break collect
+			}
+		}
+		for _, batch := range batches {
+			v.batches <- batch
+		}
+	}
+}
+
+func (v *SignatureVerifier) work() {
+	for batch := range v.batches {
+		verifyBatch(batch)
+	}
+}
+
+// verifyBatch verifies the batch at once, and falls back to verifying each signature if the batch is invalid.
+func verifyBatch(batch []*verifyRequest) {
+	metricsSignatureVerificationBatchSize.Observe(float64(len(batch)))
+
+	if len(batch) > 1 {
+		sigs := make([]bls.Sign, len(batch))
+		pks := make([]bls.PublicKey, len(batch))
+		msgs := make([]byte, 0, len(batch)*32)
+		for i, req := range batch {
+			sigs[i] = req.sig
+			pks[i] = req.pk
+			msgs = append(msgs, req.root[:]...)
+		}
+		if multiVerify(sigs, pks, msgs) {
+			metricsSignatureVerifications.WithLabelValues("batch").Add(float64(len(batch)))
+			for _, req := range batch {
+				req.result <- true
+			}
+			return
+		}
+	}
+
+	for _, req := range batch {
+		metricsSignatureVerifications.WithLabelValues("single").Inc()
+		// Copied since cgo calls can't take pointers into the request, which holds Go pointers.
+		sig, pk, root := req.sig, req.pk, req.root
+		req.result <- sig.VerifyByte(&pk, root[:])
+	}
+}
+
+func newVerifyRequest(slot phase0.Slot, signedRoot SignedRoot) (*verifyRequest, error) {
+	if len(signedRoot.PublicKeys) == 0 {
+		return nil, errors.New("no public keys")
+	}
+	sig := bls.Sign{}
+	if err := sig.Deserialize(signedRoot.Signature); err != nil {
+		return nil, errors.Wrap(err, "failed to deserialize signature")
+	}
+	// Aggregating the public keys is equivalent to FastAggregateVerify.
+	var aggregatedPK bls.PublicKey
+	for i, pkBytes := range signedRoot.PublicKeys {
+		pk, err := DeserializeBLSPublicKey(pkBytes)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to deserialize public key")
+		}
+		if i == 0 {
+			aggregatedPK = pk
+		} else {
+			aggregatedPK.Add(&pk)
+		}
+	}
+	return &verifyRequest{
+		slot:   slot,
+		sig:    sig,
+		pk:     aggregatedPK,
+		root:   signedRoot.Root,
+		result: make(chan bool, 1),
+	}, nil
+}
+
+func verifiedSignatureKey(signedRoot SignedRoot) [32]byte {
+	h := sha256.New()
+	h.Write(signedRoot.Signature)
+	h.Write(signedRoot.Root[:])
+	for _, pk := range signedRoot.PublicKeys {
+		h.Write(pk)
+	}
+	var key [32]byte
+	copy(key[:], h.Sum(nil))
+	return key
+}
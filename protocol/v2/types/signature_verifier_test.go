@@ -0,0 +1,64 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignatureVerifier(t *testing.T) {
+	require.NoError(t, bls.Init(bls.BLS12_381))
+	require.NoError(t, bls.SetETHmode(bls.EthModeDraft07))
+
+	const n = 40
+	keys := make([]bls.SecretKey, n)
+	signedRoots := make([]SignedRoot, n)
+	for i := range keys {
+		keys[i].SetByCSPRNG()
+		root := sha256.Sum256([]byte(fmt.Sprint(i)))
+		signedRoots[i] = SignedRoot{
+			Signature:  keys[i].SignByte(root[:]).Serialize(),
+			Root:       root,
+			PublicKeys: [][]byte{keys[i].GetPublicKey().Serialize()},
+		}
+	}
+
+	// an invalid signature fails only itself, also when batched with valid ones
+	invalid := signedRoots[3]
+	invalid.Root = sha256.Sum256([]byte("other"))
+
+	v := NewSignatureVerifier(2, 16, 100)
+	var wg sync.WaitGroup
+	for i := range signedRoots {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.Equal(t, []error{nil}, v.Verify(1, signedRoots[i]))
+		}()
+	}
+	errs := v.Verify(1, signedRoots[0], invalid, signedRoots[1])
+	wg.Wait()
+	require.Equal(t, []error{nil, ErrInvalidSignature, nil}, errs)
+	require.True(t, v.verified.Contains(verifiedSignatureKey(signedRoots[5])))
+	require.False(t, v.verified.Contains(verifiedSignatureKey(invalid)))
+
+	// signatures of multiple keys are verified against their aggregated public key
+	root := sha256.Sum256([]byte("aggregated"))
+	aggregated := keys[0].SignByte(root[:])
+	aggregated.Add(keys[1].SignByte(root[:]))
+	errs = v.Verify(2, SignedRoot{
+		Signature:  aggregated.Serialize(),
+		Root:       root,
+		PublicKeys: [][]byte{signedRoots[0].PublicKeys[0], signedRoots[1].PublicKeys[0]},
+	})
+	require.Equal(t, []error{nil}, errs)
+
+	// malformed signatures aren't verified
+	errs = v.Verify(2, SignedRoot{Signature: []byte{1, 2, 3}, Root: root, PublicKeys: signedRoots[0].PublicKeys})
+	require.ErrorContains(t, errs[0], "failed to deserialize signature")
+}
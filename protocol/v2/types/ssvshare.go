@@ -16,7 +16,7 @@ import (
 )
 
 const (
-	MaxPossibleShareSize = 1245
+	MaxPossibleShareSize = 1279
 	MaxAllowedShareSize  = MaxPossibleShareSize * 8 // Leaving some room for protocol updates and calculation mistakes.
 )
 
@@ -31,6 +31,10 @@ type Shares interface {
 	// List returns a list of shares, filtered by the given filters (if any).
 	List(txn basedb.Reader, filters ...SharesFilter) []*types.SSVShare
 
+	// Query returns the shares that match the given query using the in-memory indexes,
+	// filtered by the given filters (if any).
+	Query(txn basedb.Reader, query SharesQuery, filters ...SharesFilter) []*types.SSVShare
+
 	// Save saves the given shares.
 	Save(txn basedb.ReadWriter, shares ...*types.SSVShare) error
 
@@ -49,6 +53,7 @@ type sharesStorage struct {
 	db     basedb.Database
 	prefix []byte
 	shares map[string]*types.SSVShare
+	index  *sharesIndex
 	mu     sync.RWMutex
 }
 
@@ -56,6 +61,7 @@ func NewSharesStorage(logger *zap.Logger, db basedb.Database, prefix []byte) (Sh
 	storage := &sharesStorage{
 		logger: logger,
 		shares: make(map[string]*types.SSVShare),
+		index:  newSharesIndex(),
 		db:     db,
 		prefix: prefix,
 	}
@@ -76,7 +82,9 @@ func (s *sharesStorage) load() error {
 		if err := val.Decode(obj.Value); err != nil {
 			return fmt.Errorf("failed to deserialize share: %w", err)
 		}
-		s.shares[hex.EncodeToString(val.ValidatorPubKey)] = val
+		key := hexKey(val.ValidatorPubKey)
+		s.shares[key] = val
+		s.index.add(key, val)
 		return nil
 	})
 }
@@ -85,7 +93,7 @@ func (s *sharesStorage) Get(_ basedb.Reader, pubKey []byte) *types.SSVShare {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	return s.shares[hex.EncodeToString(pubKey)]
+	return s.shares[hexKey(pubKey)]
 }
 
 func (s *sharesStorage) List(_ basedb.Reader, filters ...SharesFilter) []*types.SSVShare {
@@ -97,14 +105,27 @@ func (s *sharesStorage) List(_ basedb.Reader, filters ...SharesFilter) []*types.
 	}
 
 	var shares []*types.SSVShare
-Shares:
 	for _, share := range s.shares {
-		for _, filter := range filters {
-			if !filter(share) {
-				continue Shares
-			}
+		if matchFilters(share, filters) {
+			shares = append(shares, share)
+		}
+	}
+	return shares
+}
+
+func (s *sharesStorage) Query(txn basedb.Reader, query SharesQuery, filters ...SharesFilter) []*types.SSVShare {
+	if query.Empty() {
+		return s.List(txn, filters...)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var shares []*types.SSVShare
+	for key := range s.index.lookup(query) {
+		if share := s.shares[key]; share != nil && matchFilters(share, filters) {
+			shares = append(shares, share)
 		}
-		shares = append(shares, share)
 	}
 	return shares
 }
@@ -128,8 +149,9 @@ func (s *sharesStorage) Save(rw basedb.ReadWriter, shares ...*types.SSVShare) er
 	}
 
 	for _, share := range shares {
-		key := hex.EncodeToString(share.ValidatorPubKey)
+		key := hexKey(share.ValidatorPubKey)
 		s.shares[key] = share
+		s.index.add(key, share)
 	}
 	return nil
 }
@@ -143,7 +165,9 @@ func (s *sharesStorage) Delete(rw basedb.ReadWriter, pubKey []byte) error {
 		return err
 	}
 
-	delete(s.shares, hex.EncodeToString(pubKey))
+	key := hexKey(pubKey)
+	delete(s.shares, key)
+	s.index.remove(key)
 	return nil
 }
 
@@ -177,9 +201,25 @@ func (s *sharesStorage) Drop() error {
 	}
 
 	s.shares = make(map[string]*types.SSVShare)
+	s.index = newSharesIndex()
 	return nil
 }
 
+// hexKey returns the key of the given public key in the in-memory maps
+func hexKey(pk []byte) string {
+	return hex.EncodeToString(pk)
+}
+
+// matchFilters returns whether the given share passes all the given filters
+func matchFilters(share *types.SSVShare, filters []SharesFilter) bool {
+	for _, filter := range filters {
+		if !filter(share) {
+			return false
+		}
+	}
+	return true
+}
+
 // storageKey builds share key using sharesPrefix & validator public key, e.g. "shares/0x00..01"
 func (s *sharesStorage) storageKey(pk []byte) []byte {
 	return bytes.Join([][]byte{sharesPrefix, pk}, []byte("/"))
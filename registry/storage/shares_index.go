@@ -0,0 +1,200 @@
+package storage
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	spectypes "github.com/bloxapp/ssv-spec/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/bloxapp/ssv/protocol/v2/types"
+)
+
+// SharesQuery selects shares by their indexed fields.
+// A share matches the query if it matches any of the values of every non-empty field,
+// an empty query matches all shares.
+type SharesQuery struct {
+	Owners []common.Address
+	// OperatorIDs matches shares whose committee includes any of the operators,
+	// unlike ByOperatorID which matches the operator of the share itself.
+	OperatorIDs []spectypes.OperatorID
+	ClusterIDs  [][]byte
+	PubKeys     [][]byte
+	Indices     []phase0.ValidatorIndex
+}
+
+// Empty returns whether the query has no conditions
+func (q SharesQuery) Empty() bool {
+	return len(q.Owners) == 0 && len(q.OperatorIDs) == 0 && len(q.ClusterIDs) == 0 &&
+		len(q.PubKeys) == 0 && len(q.Indices) == 0
+}
+
+// pubKeySet is a set of hex encoded validator public keys
+type pubKeySet map[string]struct{}
+
+// indexedFields are the values a share was indexed by, shares are updated in place
+// (e.g. by UpdateValidatorMetadata) so they are kept to remove the share from the right entries.
+type indexedFields struct {
+	owner     common.Address
+	operators []spectypes.OperatorID
+	clusterID string
+	index     phase0.ValidatorIndex
+	hasIndex  bool
+}
+
+// sharesIndex holds secondary indexes of the shares, it isn't thread-safe
+// and is guarded by the lock of sharesStorage.
+type sharesIndex struct {
+	byOwner    map[common.Address]pubKeySet
+	byOperator map[spectypes.OperatorID]pubKeySet
+	byCluster  map[string]pubKeySet
+	byIndex    map[phase0.ValidatorIndex]pubKeySet
+	fields     map[string]indexedFields
+}
+
+func newSharesIndex() *sharesIndex {
+	return &sharesIndex{
+		byOwner:    make(map[common.Address]pubKeySet),
+		byOperator: make(map[spectypes.OperatorID]pubKeySet),
+		byCluster:  make(map[string]pubKeySet),
+		byIndex:    make(map[phase0.ValidatorIndex]pubKeySet),
+		fields:     make(map[string]indexedFields),
+	}
+}
+
+// add indexes the given share, replacing its previous entries if it was already indexed
+func (idx *sharesIndex) add(key string, share *types.SSVShare) {
+	idx.remove(key)
+
+	f := indexedFields{
+		owner:     share.OwnerAddress,
+		operators: make([]spectypes.OperatorID, len(share.Committee)),
+	}
+	operatorIDs := make([]uint64, len(share.Committee))
+	for i, op := range share.Committee {
+		f.operators[i] = op.OperatorID
+		operatorIDs[i] = op.OperatorID
+	}
+	if clusterID, err := types.ComputeClusterIDHash(share.OwnerAddress.Bytes(), operatorIDs); err == nil {
+		f.clusterID = string(clusterID)
+	}
+	if share.HasBeaconMetadata() {
+		f.index = share.BeaconMetadata.Index
+		f.hasIndex = true
+	}
+
+	addKey(idx.byOwner, f.owner, key)
+	for _, id := range f.operators {
+		addKey(idx.byOperator, id, key)
+	}
+	if f.clusterID != "" {
+		addKey(idx.byCluster, f.clusterID, key)
+	}
+	if f.hasIndex {
+		addKey(idx.byIndex, f.index, key)
+	}
+	idx.fields[key] = f
+}
+
+// remove removes the share with the given key from the index
+func (idx *sharesIndex) remove(key string) {
+	f, ok := idx.fields[key]
+	if !ok {
+		return
+	}
+	removeKey(idx.byOwner, f.owner, key)
+	for _, id := range f.operators {
+		removeKey(idx.byOperator, id, key)
+	}
+	if f.clusterID != "" {
+		removeKey(idx.byCluster, f.clusterID, key)
+	}
+	if f.hasIndex {
+		removeKey(idx.byIndex, f.index, key)
+	}
+	delete(idx.fields, key)
+}
+
+// lookup returns the keys of the shares that match the given (non-empty) query
+func (idx *sharesIndex) lookup(q SharesQuery) pubKeySet {
+	var sets []pubKeySet
+	if len(q.Owners) > 0 {
+		sets = append(sets, union(idx.byOwner, q.Owners))
+	}
+	if len(q.OperatorIDs) > 0 {
+		sets = append(sets, union(idx.byOperator, q.OperatorIDs))
+	}
+	if len(q.ClusterIDs) > 0 {
+		clusterIDs := make([]string, len(q.ClusterIDs))
+		for i, id := range q.ClusterIDs {
+			clusterIDs[i] = string(id)
+		}
+		sets = append(sets, union(idx.byCluster, clusterIDs))
+	}
+	if len(q.PubKeys) > 0 {
+		keys := make(pubKeySet, len(q.PubKeys))
+		for _, pk := range q.PubKeys {
+			key := hexKey(pk)
+			if _, ok := idx.fields[key]; ok {
+				keys[key] = struct{}{}
+			}
+		}
+		sets = append(sets, keys)
+	}
+	if len(q.Indices) > 0 {
+		sets = append(sets, union(idx.byIndex, q.Indices))
+	}
+
+	// intersect, starting from the smallest set
+	smallest := 0
+	for i, set := range sets {
+		if len(set) < len(sets[smallest]) {
+			smallest = i
+		}
+	}
+	result := make(pubKeySet, len(sets[smallest]))
+Keys:
+	for key := range sets[smallest] {
+		for i, set := range sets {
+			if i == smallest {
+				continue
+			}
+			if _, ok := set[key]; !ok {
+				continue Keys
+			}
+		}
+		result[key] = struct{}{}
+	}
+	return result
+}
+
+func addKey[K comparable](m map[K]pubKeySet, k K, key string) {
+	set, ok := m[k]
+	if !ok {
+		set = make(pubKeySet)
+		m[k] = set
+	}
+	set[key] = struct{}{}
+}
+
+func removeKey[K comparable](m map[K]pubKeySet, k K, key string) {
+	set, ok := m[k]
+	if !ok {
+		return
+	}
+	delete(set, key)
+	if len(set) == 0 {
+		delete(m, k)
+	}
+}
+
+func union[K comparable](m map[K]pubKeySet, values []K) pubKeySet {
+	if len(values) == 1 {
+		return m[values[0]]
+	}
+	result := make(pubKeySet)
+	for _, v := range values {
+		for key := range m[v] {
+			result[key] = struct{}{}
+		}
+	}
+	return result
+}
@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	spectypes "github.com/bloxapp/ssv-spec/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/herumi/bls-eth-go-binary/bls"
@@ -103,6 +104,49 @@ func TestSaveAndGetValidatorStorage(t *testing.T) {
 	require.Nil(t, share)
 }
 
+func TestSharesStorage_Query(t *testing.T) {
+	logger := logging.TestLogger(t)
+	shareStorage, done := newShareStorageForTest(logger)
+	require.NotNil(t, shareStorage)
+	defer done()
+
+	threshold.Init()
+	sk := &bls.SecretKey{}
+	sk.SetByCSPRNG()
+	splitKeys, err := threshold.Create(sk.Serialize(), 3, 4)
+	require.NoError(t, err)
+
+	share1, _ := generateRandomValidatorShare(splitKeys)
+	share2, _ := generateRandomValidatorShare(splitKeys)
+	share2.OwnerAddress = common.HexToAddress("0x1")
+	share2.BeaconMetadata = nil
+	require.NoError(t, shareStorage.Save(nil, share1, share2))
+
+	clusterID, err := ssvtypes.ComputeClusterIDHash(share1.OwnerAddress.Bytes(), []uint64{1, 2, 3, 4})
+	require.NoError(t, err)
+
+	require.Len(t, shareStorage.Query(nil, SharesQuery{}), 2)
+	require.Len(t, shareStorage.Query(nil, SharesQuery{OperatorIDs: []spectypes.OperatorID{1, 5}}), 2)
+	require.Len(t, shareStorage.Query(nil, SharesQuery{OperatorIDs: []spectypes.OperatorID{5}}), 0)
+	require.Len(t, shareStorage.Query(nil, SharesQuery{OperatorIDs: []spectypes.OperatorID{1}}, ByActiveValidator()), 1)
+	require.Len(t, shareStorage.Query(nil, SharesQuery{ClusterIDs: [][]byte{clusterID}}), 1)
+	require.Len(t, shareStorage.Query(nil, SharesQuery{Owners: []common.Address{share2.OwnerAddress}, Indices: []phase0.ValidatorIndex{3}}), 0)
+	require.Len(t, shareStorage.Query(nil, SharesQuery{PubKeys: [][]byte{share2.ValidatorPubKey, {0x1}}}), 1)
+
+	// updated metadata is re-indexed
+	require.NoError(t, shareStorage.UpdateValidatorMetadata(hex.EncodeToString(share1.ValidatorPubKey), &beaconprotocol.ValidatorMetadata{Index: 10}))
+	require.NoError(t, shareStorage.UpdateValidatorMetadata(hex.EncodeToString(share2.ValidatorPubKey), &beaconprotocol.ValidatorMetadata{Index: 3}))
+	res := shareStorage.Query(nil, SharesQuery{Indices: []phase0.ValidatorIndex{3}})
+	require.Len(t, res, 1)
+	require.Equal(t, share2.ValidatorPubKey, res[0].ValidatorPubKey)
+	require.Len(t, shareStorage.Query(nil, SharesQuery{Indices: []phase0.ValidatorIndex{10}}), 1)
+
+	// deleted shares are removed from the indexes
+	require.NoError(t, shareStorage.Delete(nil, share1.ValidatorPubKey))
+	require.Len(t, shareStorage.Query(nil, SharesQuery{ClusterIDs: [][]byte{clusterID}}), 0)
+	require.Len(t, shareStorage.Query(nil, SharesQuery{OperatorIDs: []spectypes.OperatorID{1}}), 1)
+}
+
 func generateRandomValidatorShare(splitKeys map[uint64]*bls.SecretKey) (*ssvtypes.SSVShare, *bls.SecretKey) {
 	threshold.Init()
 
@@ -53,6 +53,7 @@ type Database interface {
 
 	// TODO: consider moving these functions into Reader and ReadWriter interfaces?
 	CountPrefix(prefix []byte) (int64, error)
+	ListKeys(prefix []byte, handler func(key []byte) error) error
 	DeletePrefix(prefix []byte) (int, error)
 	DropPrefix(prefix []byte) error
 	Update(fn func(Txn) error) error
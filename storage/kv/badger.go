@@ -199,6 +199,25 @@ func (b *BadgerDB) CountPrefix(prefix []byte) (int64, error) {
 	return res, err
 }
 
+// ListKeys iterates over the keys of a given collection without reading their values,
+// the prefix is trimmed from the keys that are passed to the handler
+func (b *BadgerDB) ListKeys(prefix []byte, handler func(key []byte) error) error {
+	return b.db.View(func(txn *badger.Txn) error {
+		opt := badger.DefaultIteratorOptions
+		opt.Prefix = prefix
+		opt.PrefetchValues = false
+		it := txn.NewIterator(opt)
+		defer it.Close()
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			key := it.Item().KeyCopy(nil)
+			if err := handler(key[len(prefix):]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 // DropPrefix cleans all items in a collection
 func (b *BadgerDB) DropPrefix(prefix []byte) error {
 	return b.db.DropPrefix(prefix)
@@ -167,6 +167,31 @@ func TestBadgerDb_SetMany(t *testing.T) {
 	}
 }
 
+func TestBadgerDb_ListKeys(t *testing.T) {
+	logger := logging.TestLogger(t)
+	db, err := NewInMemory(logger, basedb.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	prefix := []byte("prefix")
+	for i := uint64(0); i < 100; i++ {
+		require.NoError(t, db.Set(prefix, uInt64ToByteSlice(i), []byte("value")))
+	}
+	require.NoError(t, db.Set([]byte("other"), uInt64ToByteSlice(1), []byte("value")))
+
+	var keys [][]byte
+	require.NoError(t, db.ListKeys(prefix, func(key []byte) error {
+		keys = append(keys, key)
+		return nil
+	}))
+	require.Len(t, keys, 100)
+	for _, key := range keys {
+		_, found, err := db.Get(prefix, key)
+		require.NoError(t, err)
+		require.True(t, found)
+	}
+}
+
 func uInt64ToByteSlice(n uint64) []byte {
 	b := make([]byte, 8)
 	binary.LittleEndian.PutUint64(b, n)